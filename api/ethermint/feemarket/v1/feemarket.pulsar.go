@@ -23,6 +23,11 @@ var (
 	fd_Params_base_fee                    protoreflect.FieldDescriptor
 	fd_Params_min_gas_price               protoreflect.FieldDescriptor
 	fd_Params_min_gas_multiplier          protoreflect.FieldDescriptor
+	fd_Params_gas_input                   protoreflect.FieldDescriptor
+	fd_Params_base_fee_algorithm          protoreflect.FieldDescriptor
+	fd_Params_aimd                        protoreflect.FieldDescriptor
+	fd_Params_min_base_fee                protoreflect.FieldDescriptor
+	fd_Params_max_base_fee                protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -35,6 +40,11 @@ func init() {
 	fd_Params_base_fee = md_Params.Fields().ByName("base_fee")
 	fd_Params_min_gas_price = md_Params.Fields().ByName("min_gas_price")
 	fd_Params_min_gas_multiplier = md_Params.Fields().ByName("min_gas_multiplier")
+	fd_Params_gas_input = md_Params.Fields().ByName("gas_input")
+	fd_Params_base_fee_algorithm = md_Params.Fields().ByName("base_fee_algorithm")
+	fd_Params_aimd = md_Params.Fields().ByName("aimd")
+	fd_Params_min_base_fee = md_Params.Fields().ByName("min_base_fee")
+	fd_Params_max_base_fee = md_Params.Fields().ByName("max_base_fee")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -144,6 +154,36 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.GasInput != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.GasInput))
+		if !f(fd_Params_gas_input, value) {
+			return
+		}
+	}
+	if x.BaseFeeAlgorithm != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.BaseFeeAlgorithm))
+		if !f(fd_Params_base_fee_algorithm, value) {
+			return
+		}
+	}
+	if x.Aimd != nil {
+		value := protoreflect.ValueOfMessage(x.Aimd.ProtoReflect())
+		if !f(fd_Params_aimd, value) {
+			return
+		}
+	}
+	if x.MinBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MinBaseFee)
+		if !f(fd_Params_min_base_fee, value) {
+			return
+		}
+	}
+	if x.MaxBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseFee)
+		if !f(fd_Params_max_base_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -173,6 +213,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinGasPrice != ""
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		return x.MinGasMultiplier != ""
+	case "ethermint.feemarket.v1.Params.gas_input":
+		return x.GasInput != 0
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		return x.BaseFeeAlgorithm != 0
+	case "ethermint.feemarket.v1.Params.aimd":
+		return x.Aimd != nil
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		return x.MinBaseFee != ""
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		return x.MaxBaseFee != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -203,6 +253,16 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinGasPrice = ""
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = ""
+	case "ethermint.feemarket.v1.Params.gas_input":
+		x.GasInput = 0
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		x.BaseFeeAlgorithm = 0
+	case "ethermint.feemarket.v1.Params.aimd":
+		x.Aimd = nil
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		x.MinBaseFee = ""
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		x.MaxBaseFee = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -240,6 +300,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		value := x.MinGasMultiplier
 		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.Params.gas_input":
+		value := x.GasInput
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		value := x.BaseFeeAlgorithm
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ethermint.feemarket.v1.Params.aimd":
+		value := x.Aimd
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		value := x.MinBaseFee
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		value := x.MaxBaseFee
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -274,6 +349,16 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinGasPrice = value.Interface().(string)
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = value.Interface().(string)
+	case "ethermint.feemarket.v1.Params.gas_input":
+		x.GasInput = (GasInput)(value.Enum())
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		x.BaseFeeAlgorithm = (BaseFeeAlgorithm)(value.Enum())
+	case "ethermint.feemarket.v1.Params.aimd":
+		x.Aimd = value.Message().Interface().(*AIMDParams)
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		x.MinBaseFee = value.Interface().(string)
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		x.MaxBaseFee = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -294,6 +379,11 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ethermint.feemarket.v1.Params.aimd":
+		if x.Aimd == nil {
+			x.Aimd = new(AIMDParams)
+		}
+		return protoreflect.ValueOfMessage(x.Aimd.ProtoReflect())
 	case "ethermint.feemarket.v1.Params.no_base_fee":
 		panic(fmt.Errorf("field no_base_fee of message ethermint.feemarket.v1.Params is not mutable"))
 	case "ethermint.feemarket.v1.Params.base_fee_change_denominator":
@@ -308,6 +398,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field min_gas_price of message ethermint.feemarket.v1.Params is not mutable"))
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		panic(fmt.Errorf("field min_gas_multiplier of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.gas_input":
+		panic(fmt.Errorf("field gas_input of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		panic(fmt.Errorf("field base_fee_algorithm of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		panic(fmt.Errorf("field min_base_fee of message ethermint.feemarket.v1.Params is not mutable"))
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		panic(fmt.Errorf("field max_base_fee of message ethermint.feemarket.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -335,6 +433,17 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "ethermint.feemarket.v1.Params.min_gas_multiplier":
 		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.Params.gas_input":
+		return protoreflect.ValueOfEnum(0)
+	case "ethermint.feemarket.v1.Params.base_fee_algorithm":
+		return protoreflect.ValueOfEnum(0)
+	case "ethermint.feemarket.v1.Params.aimd":
+		m := new(AIMDParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ethermint.feemarket.v1.Params.min_base_fee":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.Params.max_base_fee":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.Params"))
@@ -428,6 +537,24 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.GasInput != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasInput))
+		}
+		if x.BaseFeeAlgorithm != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFeeAlgorithm))
+		}
+		if x.Aimd != nil {
+			l = options.Size(x.Aimd)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -457,6 +584,44 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxBaseFee) > 0 {
+			i -= len(x.MaxBaseFee)
+			copy(dAtA[i:], x.MaxBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseFee)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.MinBaseFee) > 0 {
+			i -= len(x.MinBaseFee)
+			copy(dAtA[i:], x.MinBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBaseFee)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Aimd != nil {
+			encoded, err := options.Marshal(x.Aimd)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.BaseFeeAlgorithm != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeAlgorithm))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.GasInput != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasInput))
+			i--
+			dAtA[i] = 0x48
+		}
 		if len(x.MinGasMultiplier) > 0 {
 			i -= len(x.MinGasMultiplier)
 			copy(dAtA[i:], x.MinGasMultiplier)
@@ -725,6 +890,144 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MinGasMultiplier = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasInput", wireType)
+				}
+				x.GasInput = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasInput |= GasInput(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+				}
+				x.BaseFeeAlgorithm = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aimd", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Aimd == nil {
+					x.Aimd = &AIMDParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Aimd); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -760,116 +1063,2079 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Copyright Tharsis Labs Ltd.(Evmos)
-// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+var (
+	md_AIMDParams                   protoreflect.MessageDescriptor
+	fd_AIMDParams_window            protoreflect.FieldDescriptor
+	fd_AIMDParams_alpha             protoreflect.FieldDescriptor
+	fd_AIMDParams_beta              protoreflect.FieldDescriptor
+	fd_AIMDParams_gamma             protoreflect.FieldDescriptor
+	fd_AIMDParams_min_learning_rate protoreflect.FieldDescriptor
+	fd_AIMDParams_max_learning_rate protoreflect.FieldDescriptor
+)
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: ethermint/feemarket/v1/feemarket.proto
+func init() {
+	file_ethermint_feemarket_v1_feemarket_proto_init()
+	md_AIMDParams = File_ethermint_feemarket_v1_feemarket_proto.Messages().ByName("AIMDParams")
+	fd_AIMDParams_window = md_AIMDParams.Fields().ByName("window")
+	fd_AIMDParams_alpha = md_AIMDParams.Fields().ByName("alpha")
+	fd_AIMDParams_beta = md_AIMDParams.Fields().ByName("beta")
+	fd_AIMDParams_gamma = md_AIMDParams.Fields().ByName("gamma")
+	fd_AIMDParams_min_learning_rate = md_AIMDParams.Fields().ByName("min_learning_rate")
+	fd_AIMDParams_max_learning_rate = md_AIMDParams.Fields().ByName("max_learning_rate")
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+var _ protoreflect.Message = (*fastReflection_AIMDParams)(nil)
 
-// Params defines the feemarket module parameters
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_AIMDParams AIMDParams
 
-	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
-	NoBaseFee bool `protobuf:"varint,1,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
-	// base_fee_change_denominator bounds the amount the base fee can change
-	// between blocks.
-	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
-	// elasticity_multiplier bounds the maximum gas limit an EIP-1559 block may
-	// have.
-	ElasticityMultiplier uint32 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
-	// enable_height defines at which block height the base fee calculation is enabled.
-	EnableHeight int64 `protobuf:"varint,5,opt,name=enable_height,json=enableHeight,proto3" json:"enable_height,omitempty"`
-	// base_fee for EIP-1559 blocks.
-	BaseFee string `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
-	// min_gas_price defines the minimum gas price value for cosmos and eth transactions
-	MinGasPrice string `protobuf:"bytes,7,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
-	// min_gas_multiplier bounds the minimum gas used to be charged
-	// to senders based on gas limit
-	MinGasMultiplier string `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3" json:"min_gas_multiplier,omitempty"`
+func (x *AIMDParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AIMDParams)(x)
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[0]
+func (x *AIMDParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_AIMDParams_messageType fastReflection_AIMDParams_messageType
+var _ protoreflect.MessageType = fastReflection_AIMDParams_messageType{}
 
-func (*Params) ProtoMessage() {}
+type fastReflection_AIMDParams_messageType struct{}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_AIMDParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AIMDParams)(nil)
 }
-
-func (x *Params) GetNoBaseFee() bool {
-	if x != nil {
-		return x.NoBaseFee
-	}
-	return false
+func (x fastReflection_AIMDParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_AIMDParams)
 }
-
-func (x *Params) GetBaseFeeChangeDenominator() uint32 {
-	if x != nil {
-		return x.BaseFeeChangeDenominator
-	}
-	return 0
+func (x fastReflection_AIMDParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AIMDParams
 }
 
-func (x *Params) GetElasticityMultiplier() uint32 {
-	if x != nil {
-		return x.ElasticityMultiplier
-	}
-	return 0
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AIMDParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_AIMDParams
 }
 
-func (x *Params) GetEnableHeight() int64 {
-	if x != nil {
-		return x.EnableHeight
-	}
-	return 0
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AIMDParams) Type() protoreflect.MessageType {
+	return _fastReflection_AIMDParams_messageType
 }
 
-func (x *Params) GetBaseFee() string {
-	if x != nil {
-		return x.BaseFee
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AIMDParams) New() protoreflect.Message {
+	return new(fastReflection_AIMDParams)
 }
 
-func (x *Params) GetMinGasPrice() string {
-	if x != nil {
-		return x.MinGasPrice
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AIMDParams) Interface() protoreflect.ProtoMessage {
+	return (*AIMDParams)(x)
 }
 
-func (x *Params) GetMinGasMultiplier() string {
-	if x != nil {
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AIMDParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_AIMDParams_window, value) {
+			return
+		}
+	}
+	if x.Alpha != "" {
+		value := protoreflect.ValueOfString(x.Alpha)
+		if !f(fd_AIMDParams_alpha, value) {
+			return
+		}
+	}
+	if x.Beta != "" {
+		value := protoreflect.ValueOfString(x.Beta)
+		if !f(fd_AIMDParams_beta, value) {
+			return
+		}
+	}
+	if x.Gamma != "" {
+		value := protoreflect.ValueOfString(x.Gamma)
+		if !f(fd_AIMDParams_gamma, value) {
+			return
+		}
+	}
+	if x.MinLearningRate != "" {
+		value := protoreflect.ValueOfString(x.MinLearningRate)
+		if !f(fd_AIMDParams_min_learning_rate, value) {
+			return
+		}
+	}
+	if x.MaxLearningRate != "" {
+		value := protoreflect.ValueOfString(x.MaxLearningRate)
+		if !f(fd_AIMDParams_max_learning_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AIMDParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.window":
+		return x.Window != uint64(0)
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		return x.Alpha != ""
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		return x.Beta != ""
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		return x.Gamma != ""
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		return x.MinLearningRate != ""
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		return x.MaxLearningRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AIMDParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.window":
+		x.Window = uint64(0)
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		x.Alpha = ""
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		x.Beta = ""
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		x.Gamma = ""
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		x.MinLearningRate = ""
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		x.MaxLearningRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AIMDParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		value := x.Alpha
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		value := x.Beta
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		value := x.Gamma
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		value := x.MinLearningRate
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		value := x.MaxLearningRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AIMDParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.window":
+		x.Window = value.Uint()
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		x.Alpha = value.Interface().(string)
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		x.Beta = value.Interface().(string)
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		x.Gamma = value.Interface().(string)
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		x.MinLearningRate = value.Interface().(string)
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		x.MaxLearningRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AIMDParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.window":
+		panic(fmt.Errorf("field window of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		panic(fmt.Errorf("field alpha of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		panic(fmt.Errorf("field beta of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		panic(fmt.Errorf("field gamma of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		panic(fmt.Errorf("field min_learning_rate of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		panic(fmt.Errorf("field max_learning_rate of message ethermint.feemarket.v1.AIMDParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AIMDParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.AIMDParams.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.feemarket.v1.AIMDParams.alpha":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.AIMDParams.beta":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.AIMDParams.gamma":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.AIMDParams.min_learning_rate":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.AIMDParams.max_learning_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.AIMDParams"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.AIMDParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AIMDParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.feemarket.v1.AIMDParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AIMDParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AIMDParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AIMDParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AIMDParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AIMDParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		l = len(x.Alpha)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Beta)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Gamma)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinLearningRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxLearningRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AIMDParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxLearningRate) > 0 {
+			i -= len(x.MaxLearningRate)
+			copy(dAtA[i:], x.MaxLearningRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxLearningRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MinLearningRate) > 0 {
+			i -= len(x.MinLearningRate)
+			copy(dAtA[i:], x.MinLearningRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinLearningRate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Gamma) > 0 {
+			i -= len(x.Gamma)
+			copy(dAtA[i:], x.Gamma)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Gamma)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Beta) > 0 {
+			i -= len(x.Beta)
+			copy(dAtA[i:], x.Beta)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Beta)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Alpha) > 0 {
+			i -= len(x.Alpha)
+			copy(dAtA[i:], x.Alpha)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Alpha)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AIMDParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AIMDParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AIMDParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Alpha = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Beta = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Gamma = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinLearningRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxLearningRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BaseFeeInputs                    protoreflect.MessageDescriptor
+	fd_BaseFeeInputs_height             protoreflect.FieldDescriptor
+	fd_BaseFeeInputs_base_fee_algorithm protoreflect.FieldDescriptor
+	fd_BaseFeeInputs_gas_input          protoreflect.FieldDescriptor
+	fd_BaseFeeInputs_parent_gas_wanted  protoreflect.FieldDescriptor
+	fd_BaseFeeInputs_parent_gas_used    protoreflect.FieldDescriptor
+	fd_BaseFeeInputs_gas_limit          protoreflect.FieldDescriptor
+	fd_BaseFeeInputs_gas_target         protoreflect.FieldDescriptor
+	fd_BaseFeeInputs_parent_base_fee    protoreflect.FieldDescriptor
+	fd_BaseFeeInputs_base_fee           protoreflect.FieldDescriptor
+	fd_BaseFeeInputs_learning_rate      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_feemarket_v1_feemarket_proto_init()
+	md_BaseFeeInputs = File_ethermint_feemarket_v1_feemarket_proto.Messages().ByName("BaseFeeInputs")
+	fd_BaseFeeInputs_height = md_BaseFeeInputs.Fields().ByName("height")
+	fd_BaseFeeInputs_base_fee_algorithm = md_BaseFeeInputs.Fields().ByName("base_fee_algorithm")
+	fd_BaseFeeInputs_gas_input = md_BaseFeeInputs.Fields().ByName("gas_input")
+	fd_BaseFeeInputs_parent_gas_wanted = md_BaseFeeInputs.Fields().ByName("parent_gas_wanted")
+	fd_BaseFeeInputs_parent_gas_used = md_BaseFeeInputs.Fields().ByName("parent_gas_used")
+	fd_BaseFeeInputs_gas_limit = md_BaseFeeInputs.Fields().ByName("gas_limit")
+	fd_BaseFeeInputs_gas_target = md_BaseFeeInputs.Fields().ByName("gas_target")
+	fd_BaseFeeInputs_parent_base_fee = md_BaseFeeInputs.Fields().ByName("parent_base_fee")
+	fd_BaseFeeInputs_base_fee = md_BaseFeeInputs.Fields().ByName("base_fee")
+	fd_BaseFeeInputs_learning_rate = md_BaseFeeInputs.Fields().ByName("learning_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_BaseFeeInputs)(nil)
+
+type fastReflection_BaseFeeInputs BaseFeeInputs
+
+func (x *BaseFeeInputs) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BaseFeeInputs)(x)
+}
+
+func (x *BaseFeeInputs) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BaseFeeInputs_messageType fastReflection_BaseFeeInputs_messageType
+var _ protoreflect.MessageType = fastReflection_BaseFeeInputs_messageType{}
+
+type fastReflection_BaseFeeInputs_messageType struct{}
+
+func (x fastReflection_BaseFeeInputs_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BaseFeeInputs)(nil)
+}
+func (x fastReflection_BaseFeeInputs_messageType) New() protoreflect.Message {
+	return new(fastReflection_BaseFeeInputs)
+}
+func (x fastReflection_BaseFeeInputs_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BaseFeeInputs
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BaseFeeInputs) Descriptor() protoreflect.MessageDescriptor {
+	return md_BaseFeeInputs
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BaseFeeInputs) Type() protoreflect.MessageType {
+	return _fastReflection_BaseFeeInputs_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BaseFeeInputs) New() protoreflect.Message {
+	return new(fastReflection_BaseFeeInputs)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BaseFeeInputs) Interface() protoreflect.ProtoMessage {
+	return (*BaseFeeInputs)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BaseFeeInputs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_BaseFeeInputs_height, value) {
+			return
+		}
+	}
+	if x.BaseFeeAlgorithm != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.BaseFeeAlgorithm))
+		if !f(fd_BaseFeeInputs_base_fee_algorithm, value) {
+			return
+		}
+	}
+	if x.GasInput != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.GasInput))
+		if !f(fd_BaseFeeInputs_gas_input, value) {
+			return
+		}
+	}
+	if x.ParentGasWanted != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParentGasWanted)
+		if !f(fd_BaseFeeInputs_parent_gas_wanted, value) {
+			return
+		}
+	}
+	if x.ParentGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParentGasUsed)
+		if !f(fd_BaseFeeInputs_parent_gas_used, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_BaseFeeInputs_gas_limit, value) {
+			return
+		}
+	}
+	if x.GasTarget != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasTarget)
+		if !f(fd_BaseFeeInputs_gas_target, value) {
+			return
+		}
+	}
+	if x.ParentBaseFee != "" {
+		value := protoreflect.ValueOfString(x.ParentBaseFee)
+		if !f(fd_BaseFeeInputs_parent_base_fee, value) {
+			return
+		}
+	}
+	if x.BaseFee != "" {
+		value := protoreflect.ValueOfString(x.BaseFee)
+		if !f(fd_BaseFeeInputs_base_fee, value) {
+			return
+		}
+	}
+	if x.LearningRate != "" {
+		value := protoreflect.ValueOfString(x.LearningRate)
+		if !f(fd_BaseFeeInputs_learning_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BaseFeeInputs) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeInputs.height":
+		return x.Height != int64(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee_algorithm":
+		return x.BaseFeeAlgorithm != 0
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_input":
+		return x.GasInput != 0
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_wanted":
+		return x.ParentGasWanted != uint64(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_used":
+		return x.ParentGasUsed != uint64(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_limit":
+		return x.GasLimit != uint64(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_target":
+		return x.GasTarget != uint64(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_base_fee":
+		return x.ParentBaseFee != ""
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee":
+		return x.BaseFee != ""
+	case "ethermint.feemarket.v1.BaseFeeInputs.learning_rate":
+		return x.LearningRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeInputs"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeInputs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BaseFeeInputs) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeInputs.height":
+		x.Height = int64(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee_algorithm":
+		x.BaseFeeAlgorithm = 0
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_input":
+		x.GasInput = 0
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_wanted":
+		x.ParentGasWanted = uint64(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_used":
+		x.ParentGasUsed = uint64(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_limit":
+		x.GasLimit = uint64(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_target":
+		x.GasTarget = uint64(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_base_fee":
+		x.ParentBaseFee = ""
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee":
+		x.BaseFee = ""
+	case "ethermint.feemarket.v1.BaseFeeInputs.learning_rate":
+		x.LearningRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeInputs"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeInputs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BaseFeeInputs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeInputs.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee_algorithm":
+		value := x.BaseFeeAlgorithm
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_input":
+		value := x.GasInput
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_wanted":
+		value := x.ParentGasWanted
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_used":
+		value := x.ParentGasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_target":
+		value := x.GasTarget
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_base_fee":
+		value := x.ParentBaseFee
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee":
+		value := x.BaseFee
+		return protoreflect.ValueOfString(value)
+	case "ethermint.feemarket.v1.BaseFeeInputs.learning_rate":
+		value := x.LearningRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeInputs"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeInputs does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BaseFeeInputs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeInputs.height":
+		x.Height = value.Int()
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee_algorithm":
+		x.BaseFeeAlgorithm = (BaseFeeAlgorithm)(value.Enum())
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_input":
+		x.GasInput = (GasInput)(value.Enum())
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_wanted":
+		x.ParentGasWanted = value.Uint()
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_used":
+		x.ParentGasUsed = value.Uint()
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_limit":
+		x.GasLimit = value.Uint()
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_target":
+		x.GasTarget = value.Uint()
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_base_fee":
+		x.ParentBaseFee = value.Interface().(string)
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee":
+		x.BaseFee = value.Interface().(string)
+	case "ethermint.feemarket.v1.BaseFeeInputs.learning_rate":
+		x.LearningRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeInputs"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeInputs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BaseFeeInputs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeInputs.height":
+		panic(fmt.Errorf("field height of message ethermint.feemarket.v1.BaseFeeInputs is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee_algorithm":
+		panic(fmt.Errorf("field base_fee_algorithm of message ethermint.feemarket.v1.BaseFeeInputs is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_input":
+		panic(fmt.Errorf("field gas_input of message ethermint.feemarket.v1.BaseFeeInputs is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_wanted":
+		panic(fmt.Errorf("field parent_gas_wanted of message ethermint.feemarket.v1.BaseFeeInputs is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_used":
+		panic(fmt.Errorf("field parent_gas_used of message ethermint.feemarket.v1.BaseFeeInputs is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message ethermint.feemarket.v1.BaseFeeInputs is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_target":
+		panic(fmt.Errorf("field gas_target of message ethermint.feemarket.v1.BaseFeeInputs is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_base_fee":
+		panic(fmt.Errorf("field parent_base_fee of message ethermint.feemarket.v1.BaseFeeInputs is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee":
+		panic(fmt.Errorf("field base_fee of message ethermint.feemarket.v1.BaseFeeInputs is not mutable"))
+	case "ethermint.feemarket.v1.BaseFeeInputs.learning_rate":
+		panic(fmt.Errorf("field learning_rate of message ethermint.feemarket.v1.BaseFeeInputs is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeInputs"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeInputs does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BaseFeeInputs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.feemarket.v1.BaseFeeInputs.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee_algorithm":
+		return protoreflect.ValueOfEnum(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_input":
+		return protoreflect.ValueOfEnum(0)
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_wanted":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.feemarket.v1.BaseFeeInputs.gas_target":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.feemarket.v1.BaseFeeInputs.parent_base_fee":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.BaseFeeInputs.base_fee":
+		return protoreflect.ValueOfString("")
+	case "ethermint.feemarket.v1.BaseFeeInputs.learning_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.feemarket.v1.BaseFeeInputs"))
+		}
+		panic(fmt.Errorf("message ethermint.feemarket.v1.BaseFeeInputs does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BaseFeeInputs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.feemarket.v1.BaseFeeInputs", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BaseFeeInputs) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BaseFeeInputs) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BaseFeeInputs) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BaseFeeInputs) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BaseFeeInputs)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.BaseFeeAlgorithm != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFeeAlgorithm))
+		}
+		if x.GasInput != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasInput))
+		}
+		if x.ParentGasWanted != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParentGasWanted))
+		}
+		if x.ParentGasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParentGasUsed))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.GasTarget != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasTarget))
+		}
+		l = len(x.ParentBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LearningRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BaseFeeInputs)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LearningRate) > 0 {
+			i -= len(x.LearningRate)
+			copy(dAtA[i:], x.LearningRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LearningRate)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.BaseFee) > 0 {
+			i -= len(x.BaseFee)
+			copy(dAtA[i:], x.BaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ParentBaseFee) > 0 {
+			i -= len(x.ParentBaseFee)
+			copy(dAtA[i:], x.ParentBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ParentBaseFee)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.GasTarget != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasTarget))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ParentGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParentGasUsed))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ParentGasWanted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParentGasWanted))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.GasInput != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasInput))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BaseFeeAlgorithm != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeAlgorithm))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BaseFeeInputs)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BaseFeeInputs: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BaseFeeInputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+				}
+				x.BaseFeeAlgorithm = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasInput", wireType)
+				}
+				x.GasInput = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasInput |= GasInput(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParentGasWanted", wireType)
+				}
+				x.ParentGasWanted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParentGasWanted |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParentGasUsed", wireType)
+				}
+				x.ParentGasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParentGasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
+				}
+				x.GasTarget = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasTarget |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParentBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParentBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LearningRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: ethermint/feemarket/v1/feemarket.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GasInput defines the parent block gas value used as input for the base fee
+// calculation.
+type GasInput int32
+
+const (
+	// GAS_INPUT_GAS_WANTED uses the block gas wanted, bounded by the min gas
+	// multiplier
+	GasInput_GAS_INPUT_GAS_WANTED GasInput = 0
+	// GAS_INPUT_GAS_USED uses the gas consumed by the block transactions
+	GasInput_GAS_INPUT_GAS_USED GasInput = 1
+)
+
+// Enum value maps for GasInput.
+var (
+	GasInput_name = map[int32]string{
+		0: "GAS_INPUT_GAS_WANTED",
+		1: "GAS_INPUT_GAS_USED",
+	}
+	GasInput_value = map[string]int32{
+		"GAS_INPUT_GAS_WANTED": 0,
+		"GAS_INPUT_GAS_USED":   1,
+	}
+)
+
+func (x GasInput) Enum() *GasInput {
+	p := new(GasInput)
+	*p = x
+	return p
+}
+
+func (x GasInput) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GasInput) Descriptor() protoreflect.EnumDescriptor {
+	return file_ethermint_feemarket_v1_feemarket_proto_enumTypes[0].Descriptor()
+}
+
+func (GasInput) Type() protoreflect.EnumType {
+	return &file_ethermint_feemarket_v1_feemarket_proto_enumTypes[0]
+}
+
+func (x GasInput) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GasInput.Descriptor instead.
+func (GasInput) EnumDescriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
+}
+
+// BaseFeeAlgorithm defines the algorithm used to calculate the base fee.
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_EIP1559 adjusts the base fee with the fixed change
+	// denominator of EIP-1559
+	BaseFeeAlgorithm_BASE_FEE_ALGORITHM_EIP1559 BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_AIMD adjusts the base fee with a learning rate that is
+	// updated based on the block utilization over a sliding window of blocks
+	BaseFeeAlgorithm_BASE_FEE_ALGORITHM_AIMD BaseFeeAlgorithm = 1
+)
+
+// Enum value maps for BaseFeeAlgorithm.
+var (
+	BaseFeeAlgorithm_name = map[int32]string{
+		0: "BASE_FEE_ALGORITHM_EIP1559",
+		1: "BASE_FEE_ALGORITHM_AIMD",
+	}
+	BaseFeeAlgorithm_value = map[string]int32{
+		"BASE_FEE_ALGORITHM_EIP1559": 0,
+		"BASE_FEE_ALGORITHM_AIMD":    1,
+	}
+)
+
+func (x BaseFeeAlgorithm) Enum() *BaseFeeAlgorithm {
+	p := new(BaseFeeAlgorithm)
+	*p = x
+	return p
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BaseFeeAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_ethermint_feemarket_v1_feemarket_proto_enumTypes[1].Descriptor()
+}
+
+func (BaseFeeAlgorithm) Type() protoreflect.EnumType {
+	return &file_ethermint_feemarket_v1_feemarket_proto_enumTypes[1]
+}
+
+func (x BaseFeeAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BaseFeeAlgorithm.Descriptor instead.
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{1}
+}
+
+// Params defines the feemarket module parameters
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
+	NoBaseFee bool `protobuf:"varint,1,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
+	// base_fee_change_denominator bounds the amount the base fee can change
+	// between blocks.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// elasticity_multiplier bounds the maximum gas limit an EIP-1559 block may
+	// have.
+	ElasticityMultiplier uint32 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
+	// enable_height defines at which block height the base fee calculation is enabled.
+	EnableHeight int64 `protobuf:"varint,5,opt,name=enable_height,json=enableHeight,proto3" json:"enable_height,omitempty"`
+	// base_fee for EIP-1559 blocks.
+	BaseFee string `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// min_gas_price defines the minimum gas price value for cosmos and eth transactions
+	MinGasPrice string `protobuf:"bytes,7,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+	// min_gas_multiplier bounds the minimum gas used to be charged
+	// to senders based on gas limit
+	MinGasMultiplier string `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3" json:"min_gas_multiplier,omitempty"`
+	// gas_input defines the parent block gas value used as input for the base
+	// fee calculation.
+	GasInput GasInput `protobuf:"varint,9,opt,name=gas_input,json=gasInput,proto3,enum=ethermint.feemarket.v1.GasInput" json:"gas_input,omitempty"`
+	// base_fee_algorithm defines the algorithm used to calculate the base fee.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,10,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=ethermint.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// aimd defines the parameters of the AIMD base fee algorithm.
+	Aimd *AIMDParams `protobuf:"bytes,11,opt,name=aimd,proto3" json:"aimd,omitempty"`
+	// min_base_fee defines the lower bound of the calculated base fee.
+	MinBaseFee string `protobuf:"bytes,12,opt,name=min_base_fee,json=minBaseFee,proto3" json:"min_base_fee,omitempty"`
+	// max_base_fee defines the upper bound of the calculated base fee. A zero
+	// value disables the upper bound.
+	MaxBaseFee string `protobuf:"bytes,13,opt,name=max_base_fee,json=maxBaseFee,proto3" json:"max_base_fee,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetNoBaseFee() bool {
+	if x != nil {
+		return x.NoBaseFee
+	}
+	return false
+}
+
+func (x *Params) GetBaseFeeChangeDenominator() uint32 {
+	if x != nil {
+		return x.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (x *Params) GetElasticityMultiplier() uint32 {
+	if x != nil {
+		return x.ElasticityMultiplier
+	}
+	return 0
+}
+
+func (x *Params) GetEnableHeight() int64 {
+	if x != nil {
+		return x.EnableHeight
+	}
+	return 0
+}
+
+func (x *Params) GetBaseFee() string {
+	if x != nil {
+		return x.BaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetMinGasPrice() string {
+	if x != nil {
+		return x.MinGasPrice
+	}
+	return ""
+}
+
+func (x *Params) GetMinGasMultiplier() string {
+	if x != nil {
 		return x.MinGasMultiplier
 	}
 	return ""
 }
 
+func (x *Params) GetGasInput() GasInput {
+	if x != nil {
+		return x.GasInput
+	}
+	return GasInput_GAS_INPUT_GAS_WANTED
+}
+
+func (x *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if x != nil {
+		return x.BaseFeeAlgorithm
+	}
+	return BaseFeeAlgorithm_BASE_FEE_ALGORITHM_EIP1559
+}
+
+func (x *Params) GetAimd() *AIMDParams {
+	if x != nil {
+		return x.Aimd
+	}
+	return nil
+}
+
+func (x *Params) GetMinBaseFee() string {
+	if x != nil {
+		return x.MinBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseFee() string {
+	if x != nil {
+		return x.MaxBaseFee
+	}
+	return ""
+}
+
+// AIMDParams defines the parameters of the additive increase multiplicative
+// decrease (AIMD) base fee algorithm.
+type AIMDParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window defines the number of blocks used to compute the average block
+	// utilization.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// alpha defines the amount the learning rate is increased by when the
+	// average block utilization is outside of the gamma bounds.
+	Alpha string `protobuf:"bytes,2,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	// beta defines the factor the learning rate is multiplied by when the
+	// average block utilization is within the gamma bounds.
+	Beta string `protobuf:"bytes,3,opt,name=beta,proto3" json:"beta,omitempty"`
+	// gamma defines the average block utilization bounds [gamma, 1 - gamma]
+	// within which the learning rate is decreased.
+	Gamma string `protobuf:"bytes,4,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	// min_learning_rate defines the lower bound of the learning rate.
+	MinLearningRate string `protobuf:"bytes,5,opt,name=min_learning_rate,json=minLearningRate,proto3" json:"min_learning_rate,omitempty"`
+	// max_learning_rate defines the upper bound of the learning rate.
+	MaxLearningRate string `protobuf:"bytes,6,opt,name=max_learning_rate,json=maxLearningRate,proto3" json:"max_learning_rate,omitempty"`
+}
+
+func (x *AIMDParams) Reset() {
+	*x = AIMDParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AIMDParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIMDParams) ProtoMessage() {}
+
+// Deprecated: Use AIMDParams.ProtoReflect.Descriptor instead.
+func (*AIMDParams) Descriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AIMDParams) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *AIMDParams) GetAlpha() string {
+	if x != nil {
+		return x.Alpha
+	}
+	return ""
+}
+
+func (x *AIMDParams) GetBeta() string {
+	if x != nil {
+		return x.Beta
+	}
+	return ""
+}
+
+func (x *AIMDParams) GetGamma() string {
+	if x != nil {
+		return x.Gamma
+	}
+	return ""
+}
+
+func (x *AIMDParams) GetMinLearningRate() string {
+	if x != nil {
+		return x.MinLearningRate
+	}
+	return ""
+}
+
+func (x *AIMDParams) GetMaxLearningRate() string {
+	if x != nil {
+		return x.MaxLearningRate
+	}
+	return ""
+}
+
+// BaseFeeInputs defines the inputs used to calculate the base fee of a block.
+type BaseFeeInputs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the block height the base fee was calculated for.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee_algorithm is the algorithm used to calculate the base fee.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,2,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=ethermint.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// gas_input is the parent block gas value used as input.
+	GasInput GasInput `protobuf:"varint,3,opt,name=gas_input,json=gasInput,proto3,enum=ethermint.feemarket.v1.GasInput" json:"gas_input,omitempty"`
+	// parent_gas_wanted is the gas wanted of the parent block.
+	ParentGasWanted uint64 `protobuf:"varint,4,opt,name=parent_gas_wanted,json=parentGasWanted,proto3" json:"parent_gas_wanted,omitempty"`
+	// parent_gas_used is the gas used of the parent block.
+	ParentGasUsed uint64 `protobuf:"varint,5,opt,name=parent_gas_used,json=parentGasUsed,proto3" json:"parent_gas_used,omitempty"`
+	// gas_limit is the block gas limit.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// gas_target is the block gas target.
+	GasTarget uint64 `protobuf:"varint,7,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+	// parent_base_fee is the base fee of the parent block.
+	ParentBaseFee string `protobuf:"bytes,8,opt,name=parent_base_fee,json=parentBaseFee,proto3" json:"parent_base_fee,omitempty"`
+	// base_fee is the calculated base fee.
+	BaseFee string `protobuf:"bytes,9,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// learning_rate is the learning rate used by the AIMD algorithm. It is zero
+	// for the EIP-1559 algorithm.
+	LearningRate string `protobuf:"bytes,10,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`
+}
+
+func (x *BaseFeeInputs) Reset() {
+	*x = BaseFeeInputs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_feemarket_v1_feemarket_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaseFeeInputs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseFeeInputs) ProtoMessage() {}
+
+// Deprecated: Use BaseFeeInputs.ProtoReflect.Descriptor instead.
+func (*BaseFeeInputs) Descriptor() ([]byte, []int) {
+	return file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BaseFeeInputs) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BaseFeeInputs) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if x != nil {
+		return x.BaseFeeAlgorithm
+	}
+	return BaseFeeAlgorithm_BASE_FEE_ALGORITHM_EIP1559
+}
+
+func (x *BaseFeeInputs) GetGasInput() GasInput {
+	if x != nil {
+		return x.GasInput
+	}
+	return GasInput_GAS_INPUT_GAS_WANTED
+}
+
+func (x *BaseFeeInputs) GetParentGasWanted() uint64 {
+	if x != nil {
+		return x.ParentGasWanted
+	}
+	return 0
+}
+
+func (x *BaseFeeInputs) GetParentGasUsed() uint64 {
+	if x != nil {
+		return x.ParentGasUsed
+	}
+	return 0
+}
+
+func (x *BaseFeeInputs) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *BaseFeeInputs) GetGasTarget() uint64 {
+	if x != nil {
+		return x.GasTarget
+	}
+	return 0
+}
+
+func (x *BaseFeeInputs) GetParentBaseFee() string {
+	if x != nil {
+		return x.ParentBaseFee
+	}
+	return ""
+}
+
+func (x *BaseFeeInputs) GetBaseFee() string {
+	if x != nil {
+		return x.BaseFee
+	}
+	return ""
+}
+
+func (x *BaseFeeInputs) GetLearningRate() string {
+	if x != nil {
+		return x.LearningRate
+	}
+	return ""
+}
+
 var File_ethermint_feemarket_v1_feemarket_proto protoreflect.FileDescriptor
 
 var file_ethermint_feemarket_v1_feemarket_proto_rawDesc = []byte{
@@ -879,7 +3145,7 @@ var file_ethermint_feemarket_v1_feemarket_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
 	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x03, 0x0a, 0x06, 0x50, 0x61,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdd, 0x06, 0x0a, 0x06, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
 	0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x42, 0x61, 0x73,
 	0x65, 0x46, 0x65, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
@@ -906,25 +3172,125 @@ var file_ethermint_feemarket_v1_feemarket_proto_rawDesc = []byte{
 	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
 	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
 	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x65,
-	0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42,
-	0xdb, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0e,
-	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x65, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x46, 0x58, 0xaa, 0x02, 0x16, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
-	0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22,
-	0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x18, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a,
-	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x67,
+	0x61, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e,
+	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x46, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x10, 0x62,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
+	0x49, 0x0a, 0x04, 0x61, 0x69, 0x6d, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x49, 0x4d, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x11, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x04, 0x41, 0x49, 0x4d, 0x44, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x61, 0x69, 0x6d, 0x64, 0x12, 0x4a, 0x0a, 0x0c, 0x6d, 0x69,
+	0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x42,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x78,
+	0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x22, 0x8e, 0x03, 0x0a, 0x0a, 0x41, 0x49,
+	0x4d, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x3e, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x12, 0x3c, 0x0a, 0x04, 0x62, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x62, 0x65, 0x74, 0x61, 0x12, 0x3e,
+	0x0a, 0x05, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x12, 0x54,
+	0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4c, 0x65,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x22, 0xb4, 0x04, 0x0a, 0x0d, 0x42,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65,
+	0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x10, 0x62, 0x61, 0x73, 0x65,
+	0x46, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x3d, 0x0a, 0x09,
+	0x67, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x52, 0x08, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x77, 0x61, 0x6e, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x61,
+	0x73, 0x57, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x67, 0x61, 0x73, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x67, 0x61, 0x73, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x50, 0x0a, 0x0f, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x43, 0x0a,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
+	0x65, 0x2a, 0x6e, 0x0a, 0x08, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2f, 0x0a,
+	0x14, 0x47, 0x41, 0x53, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x47, 0x41, 0x53, 0x5f, 0x57,
+	0x41, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x15, 0x8a, 0x9d, 0x20, 0x11, 0x47, 0x61, 0x73,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x47, 0x61, 0x73, 0x57, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x2b,
+	0x0a, 0x12, 0x47, 0x41, 0x53, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x47, 0x41, 0x53, 0x5f,
+	0x55, 0x53, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x13, 0x8a, 0x9d, 0x20, 0x0f, 0x47, 0x61, 0x73, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x2a, 0x8c, 0x01, 0x0a, 0x10, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x3b, 0x0a, 0x1a, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46,
+	0x45, 0x45, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x45, 0x49, 0x50,
+	0x31, 0x35, 0x35, 0x39, 0x10, 0x00, 0x1a, 0x1b, 0x8a, 0x9d, 0x20, 0x17, 0x42, 0x61, 0x73, 0x65,
+	0x46, 0x65, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x45, 0x49, 0x50, 0x31,
+	0x35, 0x35, 0x39, 0x12, 0x35, 0x0a, 0x17, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x5f,
+	0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x41, 0x49, 0x4d, 0x44, 0x10, 0x01,
+	0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x41, 0x49, 0x4d, 0x44, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x42, 0xdb, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42,
+	0x0e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x66, 0x65,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x46, 0x58, 0xaa, 0x02, 0x16, 0x45,
+	0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x22, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x3a,
+	0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -939,16 +3305,26 @@ func file_ethermint_feemarket_v1_feemarket_proto_rawDescGZIP() []byte {
 	return file_ethermint_feemarket_v1_feemarket_proto_rawDescData
 }
 
-var file_ethermint_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ethermint_feemarket_v1_feemarket_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ethermint_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_ethermint_feemarket_v1_feemarket_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: ethermint.feemarket.v1.Params
+	(GasInput)(0),         // 0: ethermint.feemarket.v1.GasInput
+	(BaseFeeAlgorithm)(0), // 1: ethermint.feemarket.v1.BaseFeeAlgorithm
+	(*Params)(nil),        // 2: ethermint.feemarket.v1.Params
+	(*AIMDParams)(nil),    // 3: ethermint.feemarket.v1.AIMDParams
+	(*BaseFeeInputs)(nil), // 4: ethermint.feemarket.v1.BaseFeeInputs
 }
 var file_ethermint_feemarket_v1_feemarket_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: ethermint.feemarket.v1.Params.gas_input:type_name -> ethermint.feemarket.v1.GasInput
+	1, // 1: ethermint.feemarket.v1.Params.base_fee_algorithm:type_name -> ethermint.feemarket.v1.BaseFeeAlgorithm
+	3, // 2: ethermint.feemarket.v1.Params.aimd:type_name -> ethermint.feemarket.v1.AIMDParams
+	1, // 3: ethermint.feemarket.v1.BaseFeeInputs.base_fee_algorithm:type_name -> ethermint.feemarket.v1.BaseFeeAlgorithm
+	0, // 4: ethermint.feemarket.v1.BaseFeeInputs.gas_input:type_name -> ethermint.feemarket.v1.GasInput
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_ethermint_feemarket_v1_feemarket_proto_init() }
@@ -969,19 +3345,44 @@ func file_ethermint_feemarket_v1_feemarket_proto_init() {
 				return nil
 			}
 		}
+		file_ethermint_feemarket_v1_feemarket_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AIMDParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ethermint_feemarket_v1_feemarket_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BaseFeeInputs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ethermint_feemarket_v1_feemarket_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_ethermint_feemarket_v1_feemarket_proto_goTypes,
 		DependencyIndexes: file_ethermint_feemarket_v1_feemarket_proto_depIdxs,
+		EnumInfos:         file_ethermint_feemarket_v1_feemarket_proto_enumTypes,
 		MessageInfos:      file_ethermint_feemarket_v1_feemarket_proto_msgTypes,
 	}.Build()
 	File_ethermint_feemarket_v1_feemarket_proto = out.File
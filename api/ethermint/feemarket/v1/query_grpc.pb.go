@@ -22,9 +22,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/ethermint.feemarket.v1.Query/Params"
-	Query_BaseFee_FullMethodName  = "/ethermint.feemarket.v1.Query/BaseFee"
-	Query_BlockGas_FullMethodName = "/ethermint.feemarket.v1.Query/BlockGas"
+	Query_Params_FullMethodName        = "/ethermint.feemarket.v1.Query/Params"
+	Query_BaseFee_FullMethodName       = "/ethermint.feemarket.v1.Query/BaseFee"
+	Query_BlockGas_FullMethodName      = "/ethermint.feemarket.v1.Query/BlockGas"
+	Query_BaseFeeInputs_FullMethodName = "/ethermint.feemarket.v1.Query/BaseFeeInputs"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +38,9 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BaseFeeInputs queries the inputs used to calculate the base fee of the
+	// last blocks.
+	BaseFeeInputs(ctx context.Context, in *QueryBaseFeeInputsRequest, opts ...grpc.CallOption) (*QueryBaseFeeInputsResponse, error)
 }
 
 type queryClient struct {
@@ -74,6 +78,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BaseFeeInputs(ctx context.Context, in *QueryBaseFeeInputsRequest, opts ...grpc.CallOption) (*QueryBaseFeeInputsResponse, error) {
+	out := new(QueryBaseFeeInputsResponse)
+	err := c.cc.Invoke(ctx, Query_BaseFeeInputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -84,6 +97,9 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BaseFeeInputs queries the inputs used to calculate the base fee of the
+	// last blocks.
+	BaseFeeInputs(context.Context, *QueryBaseFeeInputsRequest) (*QueryBaseFeeInputsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -100,6 +116,9 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (UnimplementedQueryServer) BaseFeeInputs(context.Context, *QueryBaseFeeInputsRequest) (*QueryBaseFeeInputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeInputs not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -167,6 +186,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseFeeInputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeInputs(ctx, req.(*QueryBaseFeeInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -186,6 +223,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BaseFeeInputs",
+			Handler:    _Query_BaseFeeInputs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -12,6 +12,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -34,6 +35,69 @@ func ValidateMsg(
 	)
 }
 
+// ValidateAuthorizationList validates the authorization list of an EIP-7702
+// set code transaction. It is a no-op for other transaction types. It checks
+// the following requirements:
+// - the Prague rules MUST be enabled
+// - the authorization list MUST NOT be empty
+// - the chain id of each authorization MUST be 0 or the chain id of the network
+// - the authority of each authorization MUST be recoverable from its signature
+//
+// NOTE: authorizations that are invalid against the state (e.g. nonce
+// mismatch) are skipped during the execution instead of failing the tx.
+func ValidateAuthorizationList(txData evmtypes.TxData, chainID *big.Int, isPrague bool) error {
+	setCodeTx, ok := txData.(*evmtypes.SetCodeTx)
+	if !ok {
+		return nil
+	}
+
+	if !isPrague {
+		return errorsmod.Wrap(evmtypes.ErrSetCodeTxNotEnabled, "prague rules are not enabled")
+	}
+
+	if len(setCodeTx.Authorizations) == 0 {
+		return errorsmod.Wrap(evmtypes.ErrInvalidAuthorization, "empty authorization list")
+	}
+
+	for i, auth := range setCodeTx.Authorizations {
+		if err := auth.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid authorization %d", i)
+		}
+
+		authChainID := auth.ChainID.BigInt()
+		if authChainID.Sign() != 0 && (chainID == nil || authChainID.Cmp(chainID) != 0) {
+			return errorsmod.Wrapf(
+				evmtypes.ErrInvalidAuthorization,
+				"invalid chain id for authorization %d; expected 0 or %s, got %s", i, chainID, authChainID,
+			)
+		}
+
+		if _, err := auth.Authority(); err != nil {
+			return errorsmod.Wrapf(evmtypes.ErrInvalidAuthorization, "invalid signature for authorization %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateInitCodeSize validates the initcode size of contract creation
+// transactions as per EIP-3860. It is a no-op before the Shanghai fork and
+// for transactions that are not contract creations.
+func ValidateInitCodeSize(txData evmtypes.TxData, isShanghai bool) error {
+	if !isShanghai || txData.GetTo() != nil {
+		return nil
+	}
+
+	if size := len(txData.GetData()); size > vm.MaxInitCodeSize {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"%s: code size %d, limit %d", vm.ErrMaxInitCodeSizeExceeded, size, vm.MaxInitCodeSize,
+		)
+	}
+
+	return nil
+}
+
 // checkDisabledCreateCall checks if the transaction is a contract creation or call,
 // and if those actions are disabled through governance.
 func checkDisabledCreateCall(
@@ -89,6 +153,9 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 	}
 
+	// NOTE: the fee payer and granter are not covered by the Ethereum signature,
+	// so the fees of the eth tx are always paid by the sender. Fee allowances
+	// are only available to EVM users through the feegrant precompile.
 	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
 	}
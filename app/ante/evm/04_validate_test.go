@@ -7,15 +7,21 @@ import (
 	"math/big"
 
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/evmos/evmos/v20/app/ante/evm"
+	"github.com/evmos/evmos/v20/encoding"
 	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	"github.com/evmos/evmos/v20/types"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -200,6 +206,154 @@ func (suite *EvmAnteTestSuite) TestValidateMsg() {
 	}
 }
 
+func (suite *EvmAnteTestSuite) TestValidateInitCodeSize() {
+	keyring := testkeyring.New(1)
+
+	testCases := []struct {
+		name          string
+		txArgs        evmtypes.EvmTxArgs
+		isShanghai    bool
+		expectedError error
+	}{
+		{
+			name:       "success: contract creation at the initcode size limit",
+			txArgs:     evmtypes.EvmTxArgs{Input: make([]byte, vm.MaxInitCodeSize)},
+			isShanghai: true,
+		},
+		{
+			name:          "fail: contract creation above the initcode size limit",
+			txArgs:        evmtypes.EvmTxArgs{Input: make([]byte, vm.MaxInitCodeSize+1)},
+			isShanghai:    true,
+			expectedError: vm.ErrMaxInitCodeSizeExceeded,
+		},
+		{
+			name:       "success: contract creation above the initcode size limit before Shanghai",
+			txArgs:     evmtypes.EvmTxArgs{Input: make([]byte, vm.MaxInitCodeSize+1)},
+			isShanghai: false,
+		},
+		{
+			name: "success: call with data above the initcode size limit",
+			txArgs: evmtypes.EvmTxArgs{
+				To:    func() *common.Address { addr := keyring.GetAddr(0); return &addr }(),
+				Input: make([]byte, vm.MaxInitCodeSize+1),
+			},
+			isShanghai: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			txData, err := tc.txArgs.ToTxData()
+			suite.Require().NoError(err)
+
+			err = evm.ValidateInitCodeSize(txData, tc.isShanghai)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *EvmAnteTestSuite) TestValidateAuthorizationList() {
+	keyring := testkeyring.New(1)
+	chainID := big.NewInt(9001)
+	target := keyring.GetAddr(0)
+
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	signAuth := func(authChainID *big.Int) ethutils.SetCodeAuthorization {
+		auth, err := ethutils.SignSetCode(key, ethutils.SetCodeAuthorization{
+			ChainID: authChainID,
+			Address: target,
+			Nonce:   1,
+		})
+		suite.Require().NoError(err)
+		return auth
+	}
+
+	testCases := []struct {
+		name          string
+		txData        evmtypes.TxData
+		notPrague     bool
+		expectedError error
+	}{
+		{
+			name:   "success: not a set code tx",
+			txData: &evmtypes.DynamicFeeTx{},
+		},
+		{
+			name:      "success: not a set code tx before prague",
+			txData:    &evmtypes.DynamicFeeTx{},
+			notPrague: true,
+		},
+		{
+			name: "fail: set code tx before prague",
+			txData: &evmtypes.SetCodeTx{
+				Authorizations: evmtypes.NewAuthorizationList([]ethutils.SetCodeAuthorization{signAuth(chainID)}),
+			},
+			notPrague:     true,
+			expectedError: evmtypes.ErrSetCodeTxNotEnabled,
+		},
+		{
+			name:          "fail: empty authorization list",
+			txData:        &evmtypes.SetCodeTx{},
+			expectedError: evmtypes.ErrInvalidAuthorization,
+		},
+		{
+			name: "success: authorization for the chain",
+			txData: &evmtypes.SetCodeTx{
+				Authorizations: evmtypes.NewAuthorizationList([]ethutils.SetCodeAuthorization{signAuth(chainID)}),
+			},
+		},
+		{
+			name: "success: authorization for any chain",
+			txData: &evmtypes.SetCodeTx{
+				Authorizations: evmtypes.NewAuthorizationList([]ethutils.SetCodeAuthorization{signAuth(common.Big0)}),
+			},
+		},
+		{
+			name: "fail: authorization for another chain",
+			txData: &evmtypes.SetCodeTx{
+				Authorizations: evmtypes.NewAuthorizationList([]ethutils.SetCodeAuthorization{
+					signAuth(chainID), signAuth(big.NewInt(1)),
+				}),
+			},
+			expectedError: evmtypes.ErrInvalidAuthorization,
+		},
+		{
+			name: "fail: invalid authorization signature",
+			txData: &evmtypes.SetCodeTx{
+				Authorizations: evmtypes.NewAuthorizationList([]ethutils.SetCodeAuthorization{
+					func() ethutils.SetCodeAuthorization {
+						auth := signAuth(chainID)
+						auth.V = 2
+						return auth
+					}(),
+				}),
+			},
+			expectedError: evmtypes.ErrInvalidAuthorization,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := evm.ValidateAuthorizationList(tc.txData, chainID, !tc.notPrague)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func getTxByType(typeTx string, recipient common.Address) evmtypes.EvmTxArgs {
 	switch typeTx {
 	case "call":
@@ -285,3 +439,65 @@ func (suite *EvmAnteTestSuite) TestCheckTxFee() {
 		}
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestValidateTxFeePayerAndGranter() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithChainID(suite.chainID),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	encodingConfig := encoding.MakeConfig()
+	account := keyring.GetAccAddr(0)
+
+	testCases := []struct {
+		name          string
+		malleate      func(txBuilder client.TxBuilder)
+		expectedError error
+	}{
+		{
+			name:     "success: no fee payer nor granter",
+			malleate: func(client.TxBuilder) {},
+		},
+		{
+			name: "fail: fee payer set",
+			malleate: func(txBuilder client.TxBuilder) {
+				txBuilder.SetFeePayer(account)
+			},
+			expectedError: errortypes.ErrInvalidRequest,
+		},
+		{
+			name: "fail: fee granter set",
+			malleate: func(txBuilder client.TxBuilder) {
+				txBuilder.SetFeeGranter(account)
+			},
+			expectedError: errortypes.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			recipient := common.HexToAddress("0x1")
+			msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:  evmtypes.GetEthChainConfig().ChainID,
+				GasLimit: 21000,
+				GasPrice: big.NewInt(1),
+				To:       &recipient,
+				Amount:   big.NewInt(1),
+			})
+
+			txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+			_, err := msg.BuildTx(txBuilder, unitNetwork.GetBaseDenom())
+			suite.Require().NoError(err)
+			tc.malleate(txBuilder)
+
+			_, err = evm.ValidateTx(txBuilder.GetTx())
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
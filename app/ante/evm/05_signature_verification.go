@@ -31,7 +31,7 @@ func NewEthSigVerificationDecorator(ek EVMKeeper) EthSigVerificationDecorator {
 // won't see the error message.
 func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	evmParams := esvd.evmKeeper.GetParams(ctx)
-	ethCfg := evmtypes.GetEthChainConfig()
+	ethCfg := evmParams.EthereumConfig(evmtypes.GetEthChainConfig())
 	blockNum := big.NewInt(ctx.BlockHeight())
 	signer := ethtypes.MakeSigner(ethCfg, blockNum)
 	allowUnprotectedTxs := evmParams.GetAllowUnprotectedTxs()
@@ -65,6 +65,27 @@ func SignatureVerification(
 	signer ethtypes.Signer,
 	allowUnprotectedTxs bool,
 ) error {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+
+	// EIP-7702 set code transactions are always replay protected, since the
+	// chain id is part of the signed payload.
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		sender, err := setCodeTx.GetSender(signer.ChainID())
+		if err != nil {
+			return errorsmod.Wrapf(
+				errortypes.ErrorInvalidSigner,
+				"couldn't retrieve sender address from the ethereum transaction: %s",
+				err.Error(),
+			)
+		}
+
+		msg.From = sender.Hex()
+		return nil
+	}
+
 	ethTx := msg.AsTransaction()
 
 	if !allowUnprotectedTxs && !ethTx.Protected() {
@@ -0,0 +1,101 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm_test
+
+import (
+	"math/big"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v20/app/ante/evm"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *EvmAnteTestSuite) TestSignatureVerificationSetCodeTx() {
+	chainID := big.NewInt(9001)
+
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	auth, err := ethutils.SignSetCode(key, ethutils.SetCodeAuthorization{
+		ChainID: chainID,
+		Address: common.HexToAddress("0x1"),
+		Nonce:   1,
+	})
+	suite.Require().NoError(err)
+
+	// signSetCodeTx returns a msg with a set code tx signed for the given
+	// chain ID, optionally modifying the signature values before packing it
+	signSetCodeTx := func(txChainID *big.Int, malleate func(tx *ethutils.SetCodeTx)) *evmtypes.MsgEthereumTx {
+		ethTx := &ethutils.SetCodeTx{
+			ChainID:   txChainID,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(1),
+			Gas:       100_000,
+			To:        sender,
+			Value:     common.Big0,
+			AuthList:  []ethutils.SetCodeAuthorization{auth},
+		}
+		sigHash := ethTx.SigHash()
+		sig, err := crypto.Sign(sigHash[:], key)
+		suite.Require().NoError(err)
+		ethTx.R = new(big.Int).SetBytes(sig[:32])
+		ethTx.S = new(big.Int).SetBytes(sig[32:64])
+		ethTx.V = new(big.Int).SetUint64(uint64(sig[64]))
+		if malleate != nil {
+			malleate(ethTx)
+		}
+
+		txData, err := evmtypes.NewSetCodeTx(ethTx)
+		suite.Require().NoError(err)
+		anyData, err := evmtypes.PackTxData(txData)
+		suite.Require().NoError(err)
+		return &evmtypes.MsgEthereumTx{Data: anyData}
+	}
+
+	testCases := []struct {
+		name          string
+		msg           *evmtypes.MsgEthereumTx
+		expectedError error
+	}{
+		{
+			name: "success: signed for the chain",
+			msg:  signSetCodeTx(chainID, nil),
+		},
+		{
+			name:          "fail: signed for another chain",
+			msg:           signSetCodeTx(big.NewInt(1), nil),
+			expectedError: errortypes.ErrorInvalidSigner,
+		},
+		{
+			name: "fail: invalid signature",
+			msg: signSetCodeTx(chainID, func(tx *ethutils.SetCodeTx) {
+				tx.V = big.NewInt(2)
+			}),
+			expectedError: errortypes.ErrorInvalidSigner,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			signer := ethtypes.LatestSignerForChainID(chainID)
+
+			// set code txs are always replay protected
+			err := evm.SignatureVerification(tc.msg, signer, false)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+				suite.Require().Empty(tc.msg.From)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sender.Hex(), tc.msg.From)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/keeper"
 	"github.com/evmos/evmos/v20/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
@@ -16,17 +17,19 @@ import (
 // VerifyAccountBalance checks that the account balance is greater than the total transaction cost.
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This method will fail if:
-// - from address is NOT an EOA
+// - from address is NOT an EOA or an EOA with an EIP-7702 code delegation
 // - account balance is lower than the transaction cost
 func VerifyAccountBalance(
 	ctx sdk.Context,
 	accountKeeper evmtypes.AccountKeeper,
+	evmKeeper EVMKeeper,
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
 ) error {
-	// Only EOA are allowed to send transactions.
-	if account != nil && account.IsContract() {
+	// Only EOA are allowed to send transactions. EOA that delegated their
+	// code execution through EIP-7702 are still allowed.
+	if account != nil && account.IsContract() && !isDelegatedAccount(ctx, evmKeeper, account) {
 		return errorsmod.Wrapf(
 			errortypes.ErrInvalidType,
 			"the sender is not EOA: address %s", from,
@@ -45,3 +48,11 @@ func VerifyAccountBalance(
 
 	return nil
 }
+
+// isDelegatedAccount returns true if the code of the given account is an
+// EIP-7702 delegation designator.
+func isDelegatedAccount(ctx sdk.Context, evmKeeper EVMKeeper, account *statedb.Account) bool {
+	code := evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
+	_, ok := vm.ParseDelegation(code)
+	return ok
+}
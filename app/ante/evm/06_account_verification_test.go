@@ -111,6 +111,7 @@ func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
 			err = evm.VerifyAccountBalance(
 				unitNetwork.GetContext(),
 				unitNetwork.App.AccountKeeper,
+				unitNetwork.App.EvmKeeper,
 				statedbAccount,
 				senderKey.Addr,
 				txData,
@@ -32,7 +32,7 @@ func NewGasWantedDecorator(
 }
 
 func (gwd GasWantedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	ethCfg := evmtypes.GetEthChainConfig()
+	ethCfg := gwd.evmKeeper.GetParams(ctx).EthereumConfig(evmtypes.GetEthChainConfig())
 
 	blockHeight := big.NewInt(ctx.BlockHeight())
 	isLondon := ethCfg.IsLondon(blockHeight)
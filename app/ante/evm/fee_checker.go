@@ -23,7 +23,7 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
-func NewDynamicFeeChecker(fmk FeeMarketKeeper) authante.TxFeeChecker {
+func NewDynamicFeeChecker(ek EVMParamsKeeper, fmk FeeMarketKeeper) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
 		if !ok {
@@ -35,18 +35,19 @@ func NewDynamicFeeChecker(fmk FeeMarketKeeper) authante.TxFeeChecker {
 			return checkTxFeeWithValidatorMinGasPrices(ctx, feeTx)
 		}
 
-		return feeChecker(ctx, fmk, feeTx)
+		return feeChecker(ctx, ek, fmk, feeTx)
 	}
 }
 
 // feeChecker returns the effective fee and priority for a given transaction.
 func feeChecker(
 	ctx sdk.Context,
+	ek EVMParamsKeeper,
 	k FeeMarketKeeper,
 	feeTx sdk.FeeTx,
 ) (sdk.Coins, int64, error) {
 	denom := types.GetEVMCoinDenom()
-	ethConfig := types.GetEthChainConfig()
+	ethConfig := ek.GetParams(ctx).EthereumConfig(types.GetEthChainConfig())
 
 	if !types.IsLondon(ethConfig, ctx.BlockHeight()) {
 		// london hardfork is not enabled: fallback to min-gas-prices logic
@@ -19,7 +19,16 @@ import (
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
 )
 
-var _ evm.FeeMarketKeeper = MockFeemarketKeeper{}
+var (
+	_ evm.EVMParamsKeeper = MockEVMParamsKeeper{}
+	_ evm.FeeMarketKeeper = MockFeemarketKeeper{}
+)
+
+type MockEVMParamsKeeper struct{}
+
+func (m MockEVMParamsKeeper) GetParams(_ sdk.Context) evmtypes.Params {
+	return evmtypes.DefaultParams()
+}
 
 type MockFeemarketKeeper struct {
 	BaseFee math.LegacyDec
@@ -249,7 +258,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			} else {
 				cfg.LondonBlock = big.NewInt(0)
 			}
-			fees, priority, err := evm.NewDynamicFeeChecker(tc.keeper)(tc.ctx, tc.buildTx())
+			fees, priority, err := evm.NewDynamicFeeChecker(MockEVMParamsKeeper{}, tc.keeper)(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
@@ -35,6 +35,12 @@ type EVMKeeper interface { //nolint: revive
 	GetMinGasPrice(ctx sdk.Context) math.LegacyDec
 }
 
+// EVMParamsKeeper defines the expected keeper interface used to get the EVM
+// params on the fee checker
+type EVMParamsKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
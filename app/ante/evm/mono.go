@@ -83,7 +83,7 @@ func NewMonoDecoratorUtils(
 	ek EVMKeeper,
 ) (*DecoratorUtils, error) {
 	evmParams := ek.GetParams(ctx)
-	ethCfg := evmtypes.GetEthChainConfig()
+	ethCfg := evmParams.EthereumConfig(evmtypes.GetEthChainConfig())
 	blockHeight := big.NewInt(ctx.BlockHeight())
 	rules := ethCfg.Rules(blockHeight, true)
 	baseFee := ek.GetBaseFee(ctx)
@@ -127,7 +127,6 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// accounts.
 	accountExpenses := make(map[string]*EthVestingExpenseTracker)
 
-	ethCfg := evmtypes.GetEthChainConfig()
 	baseDenom := evmtypes.GetEVMCoinDenom()
 
 	var txFeeInfo *txtypes.Fee
@@ -152,6 +151,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	if err != nil {
 		return ctx, err
 	}
+	ethCfg := decUtils.EvmParams.EthereumConfig(evmtypes.GetEthChainConfig())
 
 	msgs := tx.GetMsgs()
 	if msgs == nil {
@@ -182,7 +182,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			}
 		}
 
-		if txData.TxType() == ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
+		if (txData.TxType() == ethtypes.DynamicFeeTxType || txData.TxType() == evmtypes.SetCodeTxType) && decUtils.BaseFee != nil {
 			// If the base fee is not empty, we compute the effective gas price
 			// according to current base fee price. The gas limit is specified
 			// by the user, while the price is given by the minimum between the
@@ -206,6 +206,18 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		if err := ValidateAuthorizationList(
+			txData,
+			decUtils.Signer.ChainID(),
+			decUtils.EvmParams.IsPrague(ctx.BlockHeight()),
+		); err != nil {
+			return ctx, err
+		}
+
+		if err := ValidateInitCodeSize(txData, decUtils.Rules.IsShanghai); err != nil {
+			return ctx, err
+		}
+
 		// 5. signature verification
 		if err := SignatureVerification(
 			ethMsg,
@@ -226,6 +238,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		if err := VerifyAccountBalance(
 			ctx,
 			md.accountKeeper,
+			md.evmKeeper,
 			account,
 			fromAddr,
 			txData,
@@ -280,6 +293,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			decUtils.BaseFee,
 			decUtils.Rules.IsHomestead,
 			decUtils.Rules.IsIstanbul,
+			decUtils.Rules.IsShanghai,
 			ctx.IsCheckTx(),
 		)
 		if err != nil {
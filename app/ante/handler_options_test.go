@@ -169,7 +169,7 @@ func TestValidateHandlerOptions(t *testing.T) {
 				SignModeHandler:        nw.GetEncodingConfig().TxConfig.SignModeHandler(),
 				SigGasConsumer:         ante.SigVerificationGasConsumer,
 				MaxTxGasWanted:         40000000,
-				TxFeeChecker:           ethante.NewDynamicFeeChecker(nw.App.FeeMarketKeeper),
+				TxFeeChecker:           ethante.NewDynamicFeeChecker(nw.App.EvmKeeper, nw.App.FeeMarketKeeper),
 			},
 			true,
 		},
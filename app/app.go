@@ -112,6 +112,9 @@ import (
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
 
 	ica "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
@@ -142,6 +145,9 @@ import (
 	ethante "github.com/evmos/evmos/v20/app/ante/evm"
 	"github.com/evmos/evmos/v20/app/post"
 	v20 "github.com/evmos/evmos/v20/app/upgrades/v20"
+	v21 "github.com/evmos/evmos/v20/app/upgrades/v21"
+	epochsprecompile "github.com/evmos/evmos/v20/precompiles/epochs"
+	icaprecompile "github.com/evmos/evmos/v20/precompiles/ica"
 	srvflags "github.com/evmos/evmos/v20/server/flags"
 	"github.com/evmos/evmos/v20/x/erc20"
 	erc20keeper "github.com/evmos/evmos/v20/x/erc20/keeper"
@@ -155,6 +161,10 @@ import (
 	vestingkeeper "github.com/evmos/evmos/v20/x/vesting/keeper"
 	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
 
+	"github.com/evmos/evmos/v20/x/ibc/callbacks"
+	ibccallbackskeeper "github.com/evmos/evmos/v20/x/ibc/callbacks/keeper"
+	ibccallbackstypes "github.com/evmos/evmos/v20/x/ibc/callbacks/types"
+
 	// NOTE: override ICS20 keeper to support IBC transfers of ERC20 tokens
 	"github.com/evmos/evmos/v20/x/ibc/transfer"
 	transferkeeper "github.com/evmos/evmos/v20/x/ibc/transfer/keeper"
@@ -243,11 +253,13 @@ type Evmos struct {
 	FeeGrantKeeper        feegrantkeeper.Keeper
 	AuthzKeeper           authzkeeper.Keeper
 	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	ICAControllerKeeper   icacontrollerkeeper.Keeper
 	ICAHostKeeper         icahostkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        transferkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	RateLimitKeeper       ratelimitkeeper.Keeper
+	IBCCallbacksKeeper    ibccallbackskeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -363,6 +375,7 @@ func NewEvmos(
 
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
@@ -426,7 +439,8 @@ func NewEvmos(
 		stakingKeeper,
 		authAddr,
 	)
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper).
+		SetBankKeeper(app.BankKeeper)
 	app.UpgradeKeeper = *upgradekeeper.NewKeeper(skipUpgradeHeights, runtime.NewKVStoreService(keys[upgradetypes.StoreKey]), appCodec, homePath, app.BaseApp, authAddr)
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(runtime.NewKVStoreService(keys[authzkeeper.StoreKey]), appCodec, app.MsgServiceRouter(), app.AccountKeeper)
@@ -505,6 +519,11 @@ func NewEvmos(
 		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
 	)
 
+	app.IBCCallbacksKeeper = ibccallbackskeeper.NewKeeper(
+		keys[ibccallbackstypes.StoreKey],
+		app.EvmKeeper,
+	)
+
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
 		app.RateLimitKeeper, // ICS4 Wrapper: ratelimit IBC middleware
@@ -514,11 +533,12 @@ func NewEvmos(
 		authAddr,
 	)
 
-	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName))
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
 			app.InflationKeeper.Hooks(),
+			epochsprecompile.NewEpochHooks(epochsKeeper, app.EvmKeeper),
 		),
 	)
 
@@ -528,6 +548,19 @@ func NewEvmos(
 		),
 	)
 
+	// Create the app.ICAControllerKeeper before the static precompiles, since
+	// it's used by the ICA precompile
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec, app.keys[icacontrollertypes.StoreKey],
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper,
+		bApp.MsgServiceRouter(),
+		authAddr,
+	)
+
 	// We call this after setting the hooks to ensure that the hooks are set on the keeper
 	evmKeeper.WithStaticPrecompiles(
 		evmkeeper.NewAvailableStaticPrecompiles(
@@ -539,9 +572,15 @@ func NewEvmos(
 			app.AuthzKeeper,
 			app.TransferKeeper,
 			app.IBCKeeper.ChannelKeeper,
+			app.IBCCallbacksKeeper,
 			app.GovKeeper,
 			app.SlashingKeeper,
 			app.EvidenceKeeper,
+			app.FeeGrantKeeper,
+			app.ICAControllerKeeper,
+			app.EpochsKeeper,
+			app.InflationKeeper,
+			appCodec,
 		),
 	)
 
@@ -565,16 +604,28 @@ func NewEvmos(
 	// create host IBC module
 	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
 
+	// create controller IBC stack with the ICA precompile callbacks as the
+	// underlying authentication module of the accounts registered from the EVM
+	var icaControllerStack porttypes.IBCModule
+	icaControllerStack = icaprecompile.NewIBCModule(app.EvmKeeper)
+	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.ICAControllerKeeper)
+
 	/*
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
+			- IBC Callbacks Middleware
 			- ERC-20 Middleware
-		 	- Recovery Middleware
+		 	- Rate Limit Middleware
 			- IBC Transfer
 
 		SendPacket, since it is originating from the application to core IBC:
-		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
+		 	transferKeeper.SendPacket -> ratelimit.SendPacket -> channel.SendPacket
+
+		AcknowledgementPacket and TimeoutPacket, the callbacks middleware calls back the
+		contract that sent the packet through the ICS-20 precompile after the packet is
+		processed by the rest of the stack:
+			channel.OnAcknowledgementPacket -> callbacks.OnAcknowledgementPacket -> erc20.OnAcknowledgementPacket -> ...
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
 			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
@@ -586,10 +637,12 @@ func NewEvmos(
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = callbacks.NewIBCMiddleware(app.IBCCallbacksKeeper, transferStack, ibccallbackstypes.DefaultMaxCallbackGas)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
 		AddRoute(ibctransfertypes.ModuleName, transferStack)
 
@@ -633,10 +686,11 @@ func NewEvmos(
 
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
-		ica.NewAppModule(nil, &app.ICAHostKeeper),
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		transferModule,
 		ibctm.NewAppModule(),
 		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
+		callbacks.NewAppModule(app.IBCCallbacksKeeper),
 		// Ethermint app modules
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmtypes.ModuleName)),
 		feemarket.NewAppModule(app.FeeMarketKeeper, app.GetSubspace(feemarkettypes.ModuleName)),
@@ -735,6 +789,7 @@ func NewEvmos(
 		erc20types.ModuleName,
 		epochstypes.ModuleName,
 		ratelimittypes.ModuleName,
+		ibccallbackstypes.ModuleName,
 	)
 
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -868,7 +923,7 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
-		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper, app.FeeMarketKeeper),
 	}
 
 	if err := options.Validate(); err != nil {
@@ -1180,6 +1235,7 @@ func initParamsKeeper(
 	keyTable.RegisterParamSet(&ibcconnectiontypes.Params{})
 	paramsKeeper.Subspace(ibcexported.ModuleName).WithKeyTable(keyTable)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName).WithKeyTable(ibctransfertypes.ParamKeyTable())
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
 	paramsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
 	// FIX: do we need a keytable?
 	paramsKeeper.Subspace(ratelimittypes.ModuleName)
@@ -1203,6 +1259,18 @@ func (app *Evmos) setupUpgradeHandlers() {
 		),
 	)
 
+	// v21 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v21.UpgradeName,
+		v21.CreateUpgradeHandler(
+			app.mm, app.configurator,
+			app.ICAControllerKeeper,
+			app.EpochsKeeper,
+			app.EvmKeeper,
+			app.FeeMarketKeeper,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1215,19 +1283,19 @@ func (app *Evmos) setupUpgradeHandlers() {
 		return
 	}
 
-	// var storeUpgrades *storetypes.StoreUpgrades
-
-	// switch upgradeInfo.Name {
-	// case v191.UpgradeName:
-	// 	storeUpgrades = &storetypes.StoreUpgrades{
-	// 		Added: []string{ratelimittypes.ModuleName},
-	// 	}
-	// default:
-	// // no-op
-	// }
-
-	// if storeUpgrades != nil {
-	// 	// configure store loader that checks if version == upgradeHeight and applies store upgrades
-	// 	app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
-	// }
+	var storeUpgrades *storetypes.StoreUpgrades
+
+	switch upgradeInfo.Name {
+	case v21.UpgradeName:
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{icacontrollertypes.StoreKey, ibccallbackstypes.StoreKey},
+		}
+	default:
+		// no-op
+	}
+
+	if storeUpgrades != nil {
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
+	}
 }
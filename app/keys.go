@@ -19,6 +19,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -26,6 +27,7 @@ import (
 	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+	ibccallbackstypes "github.com/evmos/evmos/v20/x/ibc/callbacks/types"
 	inflationtypes "github.com/evmos/evmos/v20/x/inflation/v1/types"
 	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
 )
@@ -47,9 +49,11 @@ func StoreKeys() (
 		// ibc keys
 		ibcexported.StoreKey, ibctransfertypes.StoreKey,
 		// ica keys
-		icahosttypes.StoreKey,
+		icacontrollertypes.StoreKey, icahosttypes.StoreKey,
 		// ibc rate-limit keys
 		ratelimittypes.StoreKey,
+		// ibc callbacks keys
+		ibccallbackstypes.StoreKey,
 		// ethermint keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
 		// evmos keys
@@ -0,0 +1,83 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v21
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/ethereum/go-ethereum/common"
+	epochskeeper "github.com/evmos/evmos/v20/x/epochs/keeper"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
+	evmkeeper "github.com/evmos/evmos/v20/x/evm/keeper"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	feemarketkeeper "github.com/evmos/evmos/v20/x/feemarket/keeper"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v21
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
+	epochsKeeper epochskeeper.Keeper,
+	ek *evmkeeper.Keeper,
+	fmk feemarketkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		// The ICA controller submodule is added to the already existing ICA module,
+		// so its genesis is not initialized by the module migrations.
+		logger.Info("setting ICA controller params")
+		icaControllerKeeper.SetParams(ctx, icacontrollertypes.DefaultParams())
+
+		// The epochs module parameters didn't exist before, so they are
+		// initialized with the default values without any hook contract.
+		logger.Info("setting epochs params")
+		if err := epochsKeeper.SetParams(ctx, epochstypes.DefaultParams()); err != nil {
+			return nil, err
+		}
+
+		logger.Info("enabling ICA, erc20 module and epochs precompiles")
+		if err := ek.EnableStaticPrecompiles(
+			ctx,
+			common.HexToAddress(evmtypes.ICAPrecompileAddress),
+			common.HexToAddress(evmtypes.ERC20ModulePrecompileAddress),
+			common.HexToAddress(evmtypes.EpochsPrecompileAddress),
+		); err != nil {
+			return nil, err
+		}
+
+		// The Shanghai, Cancun and Prague rules are enabled from the upgrade
+		// height, so that the blocks executed before the upgrade keep their rules.
+		logger.Info("enabling Shanghai, Cancun and Prague rules")
+		evmParams := ek.GetParams(ctx)
+		forkBlock := sdkmath.NewInt(ctx.BlockHeight())
+		evmParams.ShanghaiBlock = &forkBlock
+		evmParams.CancunBlock = &forkBlock
+		evmParams.PragueBlock = &forkBlock
+		if err := ek.SetParams(ctx, evmParams); err != nil {
+			return nil, err
+		}
+
+		// The AIMD base fee algorithm parameters didn't exist before, so they
+		// are initialized with the default values. This allows governance to
+		// switch the base fee algorithm without setting them explicitly.
+		logger.Info("setting AIMD base fee algorithm params")
+		feeMarketParams := fmk.GetParams(ctx)
+		feeMarketParams.AIMD = feemarkettypes.DefaultAIMDParams()
+		if err := fmk.SetParams(ctx, feeMarketParams); err != nil {
+			return nil, err
+		}
+
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
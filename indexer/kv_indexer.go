@@ -3,6 +3,7 @@
 package indexer
 
 import (
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -15,17 +16,49 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
-	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixBlockBloom = 3
+	KeyPrefixTxLogs     = 4
+	KeyPrefixBloomBits  = 5
+	KeyPrefixMeta       = 6
+	KeyPrefixAddressTx  = 7
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// AddressTxKeyLength is the length of address-tx key
+	AddressTxKeyLength = 1 + common.AddressLength + 8 + 8 + 1
+
+	// maxBatchOps is the max number of operations written on a single batch
+	// when deleting the entries of a range of blocks
+	maxBatchOps = 10000
+)
+
+// Roles of an address in an indexed eth tx
+const (
+	AddressRoleFrom    byte = 1
+	AddressRoleTo      byte = 2
+	AddressRoleCreated byte = 3
+)
+
+// Keys of the indexer metadata entries
+var (
+	// KeyFirstLogsBlock is the key of the first block with indexed logs
+	KeyFirstLogsBlock = []byte{KeyPrefixMeta, 1}
+	// KeyLastLogsBlock is the key of the last block with indexed logs
+	KeyLastLogsBlock = []byte{KeyPrefixMeta, 2}
+	// KeyBloomSections is the key of the number of indexed bloom bits sections
+	KeyBloomSections = []byte{KeyPrefixMeta, 3}
 )
 
 var _ evmostypes.EVMTxIndexer = &KVIndexer{}
@@ -35,89 +68,73 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+	// indexAddresses enables the indexing of the eth txs by address
+	indexAddresses bool
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
 }
 
-// IndexBlock index all the eth txs in a block through the following steps:
-// - Iterates over all of the Txs in Block
-// - Parses eth Tx infos from cosmos-sdk events for every TxResult
-// - Iterates over all the messages of the Tx
-// - Builds and stores a indexer.TxResult based on parsed events for every message
+// WithAddressIndex sets whether the eth txs are also indexed by the sender,
+// recipient and created contract addresses.
+func (kv *KVIndexer) WithAddressIndex(enabled bool) *KVIndexer {
+	kv.indexAddresses = enabled
+	return kv
+}
+
+// IndexBlock index all the eth txs in a block, storing the indexer.TxResult
+// and the logs of every eth tx.
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Header.Height
 
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-		if !rpctypes.TxSucessOrExpectedFailure(result) {
-			continue
+	// bloom of all the logs emitted in the block
+	var bloom ethtypes.Bloom
+	for _, tx := range parseBlockTxs(kv.clientCtx, kv.logger, block, txResults) {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, tx.hash, &tx.result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
-
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
-			continue
+		if kv.indexAddresses {
+			if err := saveTxAddresses(batch, tx.msg, tx.hash, &tx.result); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
 		}
 
-		if !isEthTx(tx) {
+		if tx.logs == nil {
 			continue
 		}
-
-		txs, err := rpctypes.ParseTxResult(result, tx)
-		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
-			continue
+		txLogs := evmtypes.TransactionLogs{Hash: tx.hash.Hex(), Logs: tx.logs}
+		if err := saveTxLogs(kv.clientCtx.Codec, batch, height, tx.result.EthTxIndex, &txLogs); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
+		for _, log := range txLogs.Logs {
+			addLogToBloom(&bloom, log)
+		}
+	}
 
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := common.HexToHash(ethMsg.Hash)
-
-			txResult := evmostypes.TxResult{
-				Height:     height,
-				TxIndex:    uint32(txIndex),  //nolint:gosec
-				MsgIndex:   uint32(msgIndex), //nolint:gosec
-				EthTxIndex: ethTxIndex,
-			}
-			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-			} else {
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
-
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
-			}
+	if bloom != (ethtypes.Bloom{}) {
+		if err := batch.Set(BlockBloomKey(height), bloom.Bytes()); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set block bloom", height)
 		}
 	}
+	if err := kv.setLogsRange(batch, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
+
+	// index the bloom bits once all the blocks of a section are indexed
+	if uint64(height+1)%params.BloomBitsBlocks == 0 { // #nosec G115 -- block height is positive
+		section := uint64(height) / params.BloomBitsBlocks // #nosec G115 -- block height is positive
+		if err := kv.indexBloomBits(section); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, bloom bits section %d", height, section)
+		}
+	}
 	return nil
 }
 
@@ -159,6 +176,369 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*evmo
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetTxHashesByAddress returns the hashes of the eth txs sent from or to the
+// given address, or creating it, within the [fromBlock, toBlock] range in
+// ascending order. The first `offset` txs are skipped and at most `limit` txs
+// are returned, a zero limit returns all of them.
+func (kv *KVIndexer) GetTxHashesByAddress(
+	address common.Address,
+	fromBlock, toBlock int64,
+	offset, limit uint64,
+) ([]common.Hash, error) {
+	if !kv.indexAddresses {
+		return nil, errors.New("address index is disabled")
+	}
+	if fromBlock > toBlock {
+		return nil, fmt.Errorf("invalid block range: from block %d is greater than to block %d", fromBlock, toBlock)
+	}
+
+	start := AddressTxKey(address, fromBlock, 0, 0)
+	end := AddressTxKey(address, toBlock+1, 0, 0)
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetTxHashesByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	hashes := []common.Hash{}
+	var last common.Hash
+	for ; it.Valid(); it.Next() {
+		hash := common.BytesToHash(it.Value())
+		// the txs from and to the same address are indexed under both roles
+		if hash == last {
+			continue
+		}
+		last = hash
+
+		if offset > 0 {
+			offset--
+			continue
+		}
+		hashes = append(hashes, hash)
+		if limit > 0 && uint64(len(hashes)) == limit {
+			break
+		}
+	}
+	return hashes, it.Error()
+}
+
+// GetBlockBloom returns the bloom of the logs emitted by the eth txs of the
+// given block. It returns an error if the logs of the block are not indexed.
+func (kv *KVIndexer) GetBlockBloom(blockNumber int64) (ethtypes.Bloom, error) {
+	if err := kv.checkLogsIndexed(blockNumber); err != nil {
+		return ethtypes.Bloom{}, err
+	}
+	bz, err := kv.db.Get(BlockBloomKey(blockNumber))
+	if err != nil {
+		return ethtypes.Bloom{}, errorsmod.Wrapf(err, "GetBlockBloom %d", blockNumber)
+	}
+	// the empty blooms are not stored
+	return ethtypes.BytesToBloom(bz), nil
+}
+
+// GetLogsByBlock returns the logs emitted by the eth txs of the given block,
+// grouped by tx. It returns an error if the logs of the block are not indexed.
+func (kv *KVIndexer) GetLogsByBlock(blockNumber int64) ([][]*ethtypes.Log, error) {
+	if err := kv.checkLogsIndexed(blockNumber); err != nil {
+		return nil, err
+	}
+
+	start := TxLogsKey(blockNumber, 0)
+	end := TxLogsKey(blockNumber+1, 0)
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetLogsByBlock %d", blockNumber)
+	}
+	defer it.Close()
+
+	blockLogs := [][]*ethtypes.Log{}
+	for ; it.Valid(); it.Next() {
+		var txLogs evmtypes.TransactionLogs
+		if err := kv.clientCtx.Codec.Unmarshal(it.Value(), &txLogs); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogsByBlock %d", blockNumber)
+		}
+		blockLogs = append(blockLogs, txLogs.EthLogs())
+	}
+	return blockLogs, it.Error()
+}
+
+// GetBloomBits returns the compressed bit vector of the given bloom bit for
+// all the blocks of the given section. The blocks of the section are set on
+// the bit vector in order, starting from the most significant bit.
+func (kv *KVIndexer) GetBloomBits(section uint64, bit uint) ([]byte, error) {
+	key := BloomBitsKey(section, bit)
+	// the empty bit vectors are stored as empty values
+	found, err := kv.db.Has(key)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBloomBits %d %d", section, bit)
+	}
+	if !found {
+		return nil, fmt.Errorf("bloom bits section not indexed: %d", section)
+	}
+	bz, err := kv.db.Get(key)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBloomBits %d %d", section, bit)
+	}
+	return bitutil.DecompressBytes(bz, int(params.BloomBitsBlocks/8))
+}
+
+// BloomStatus returns the number of blocks of each bloom bits section and the
+// number of indexed sections.
+func (kv *KVIndexer) BloomStatus() (uint64, uint64) {
+	bz, err := kv.db.Get(KeyBloomSections)
+	if err != nil || len(bz) != 8 {
+		return params.BloomBitsBlocks, 0
+	}
+	return params.BloomBitsBlocks, sdk.BigEndianToUint64(bz)
+}
+
+// DeleteBlocks removes the indexed entries of all the blocks within the
+// [from, to] range, including the bloom bits of every section overlapping the
+// range. The bloom bits of a partially deleted section can't be rebuilt without
+// the deleted blocks, so the logs of its remaining blocks are filtered with
+// their block blooms instead. The range of blocks with indexed logs is shrunk
+// if the deleted blocks are on its edges, the blocks deleted in the middle of
+// it need to be indexed again.
+func (kv *KVIndexer) DeleteBlocks(from, to int64) error {
+	if from > to {
+		return fmt.Errorf("invalid block range: from block %d is greater than to block %d", from, to)
+	}
+
+	// the tx results are deleted along with the tx index entries
+	deleted, err := kv.deleteRange(TxIndexKey(from, 0), TxIndexKey(to+1, 0), func(key, value []byte) [][]byte {
+		return [][]byte{key, TxHashKey(common.BytesToHash(value))}
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "DeleteBlocks %d %d, tx results", from, to)
+	}
+	kv.logger.Info("deleted tx results", "from", from, "to", to, "count", deleted/2)
+
+	ranges := [][2][]byte{
+		{TxLogsKey(from, 0), TxLogsKey(to+1, 0)},
+		{BlockBloomKey(from), BlockBloomKey(to + 1)},
+	}
+	// sections overlapping the range, including the partially deleted ones
+	firstSection := uint64(from) / params.BloomBitsBlocks //nolint:gosec // G115
+	endSection := uint64(to)/params.BloomBitsBlocks + 1   //nolint:gosec // G115
+	ranges = append(ranges, [2][]byte{BloomBitsKey(firstSection, 0), BloomBitsKey(endSection, 0)})
+	for _, r := range ranges {
+		if _, err := kv.deleteRange(r[0], r[1], func(key, _ []byte) [][]byte {
+			return [][]byte{key}
+		}); err != nil {
+			return errorsmod.Wrapf(err, "DeleteBlocks %d %d", from, to)
+		}
+	}
+
+	// the address index is keyed by address first, so all of it is scanned
+	deleted, err = kv.deleteRange([]byte{KeyPrefixAddressTx}, []byte{KeyPrefixAddressTx + 1}, func(key, _ []byte) [][]byte {
+		if len(key) != AddressTxKeyLength {
+			return nil
+		}
+		height := int64(sdk.BigEndianToUint64(key[1+common.AddressLength : 1+common.AddressLength+8])) //nolint:gosec // G115
+		if height < from || height > to {
+			return nil
+		}
+		return [][]byte{key}
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "DeleteBlocks %d %d, address index", from, to)
+	}
+	kv.logger.Info("deleted address index entries", "from", from, "to", to, "count", deleted)
+
+	return kv.shrinkLogsRange(from, to)
+}
+
+// VerifyBlock checks the indexed entries of the given block against the ones
+// built from the given block results, returning an error that describes the
+// first mismatch found. The logs are only checked if they are indexed.
+func (kv *KVIndexer) VerifyBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Header.Height
+	expected := NewKVIndexer(dbm.NewMemDB(), kv.logger, kv.clientCtx)
+	if err := expected.IndexBlock(block, txResults); err != nil {
+		return err
+	}
+
+	// the logs are only compared if they are indexed
+	checkLogs := kv.checkLogsIndexed(height) == nil
+	return verifyIndexedBlock(expected, kv, height, checkLogs)
+}
+
+// checkLogsIndexed returns an error if the logs of the given block are not
+// indexed.
+func (kv *KVIndexer) checkLogsIndexed(blockNumber int64) error {
+	first, err := loadHeight(kv.db, KeyFirstLogsBlock)
+	if err != nil {
+		return err
+	}
+	last, err := loadHeight(kv.db, KeyLastLogsBlock)
+	if err != nil {
+		return err
+	}
+	if first == -1 || blockNumber < first || blockNumber > last {
+		return fmt.Errorf("logs not indexed, block: %d", blockNumber)
+	}
+	return nil
+}
+
+// setLogsRange records the given block in the range of blocks with indexed
+// logs.
+func (kv *KVIndexer) setLogsRange(batch dbm.Batch, height int64) error {
+	first, err := loadHeight(kv.db, KeyFirstLogsBlock)
+	if err != nil {
+		return err
+	}
+	if first == -1 || height < first {
+		if err := batch.Set(KeyFirstLogsBlock, sdk.Uint64ToBigEndian(uint64(height))); err != nil { //nolint:gosec // G115
+			return errorsmod.Wrap(err, "set first logs block")
+		}
+	}
+	last, err := loadHeight(kv.db, KeyLastLogsBlock)
+	if err != nil {
+		return err
+	}
+	if last == -1 || height > last {
+		if err := batch.Set(KeyLastLogsBlock, sdk.Uint64ToBigEndian(uint64(height))); err != nil { //nolint:gosec // G115
+			return errorsmod.Wrap(err, "set last logs block")
+		}
+	}
+	return nil
+}
+
+// shrinkLogsRange removes the given blocks from the edges of the range of
+// blocks with indexed logs.
+func (kv *KVIndexer) shrinkLogsRange(from, to int64) error {
+	first, err := loadHeight(kv.db, KeyFirstLogsBlock)
+	if err != nil {
+		return err
+	}
+	last, err := loadHeight(kv.db, KeyLastLogsBlock)
+	if err != nil {
+		return err
+	}
+	if first == -1 || to < first || from > last {
+		return nil
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	switch {
+	case from <= first && to >= last:
+		if err := batch.Delete(KeyFirstLogsBlock); err != nil {
+			return errorsmod.Wrap(err, "delete first logs block")
+		}
+		if err := batch.Delete(KeyLastLogsBlock); err != nil {
+			return errorsmod.Wrap(err, "delete last logs block")
+		}
+	case from <= first:
+		if err := batch.Set(KeyFirstLogsBlock, sdk.Uint64ToBigEndian(uint64(to+1))); err != nil { //nolint:gosec // G115
+			return errorsmod.Wrap(err, "set first logs block")
+		}
+	case to >= last:
+		if err := batch.Set(KeyLastLogsBlock, sdk.Uint64ToBigEndian(uint64(from-1))); err != nil { //nolint:gosec // G115
+			return errorsmod.Wrap(err, "set last logs block")
+		}
+	}
+	return batch.Write()
+}
+
+// deleteRange deletes the keys returned by the filter for every entry within
+// the [start, end) range, writing at most maxBatchOps deletions per batch. It
+// returns the number of deleted keys.
+func (kv *KVIndexer) deleteRange(start, end []byte, filter func(key, value []byte) [][]byte) (int, error) {
+	deleted := 0
+	for {
+		// the entries are collected before deleting them, since the db can't
+		// be written while being iterated
+		it, err := kv.db.Iterator(start, end)
+		if err != nil {
+			return deleted, err
+		}
+		var keys [][]byte
+		for ; it.Valid() && len(keys) < maxBatchOps; it.Next() {
+			key := append([]byte{}, it.Key()...)
+			keys = append(keys, filter(key, it.Value())...)
+			// continue right after the current key on the next round
+			start = append(key, 0)
+		}
+		done := !it.Valid()
+		err = it.Error()
+		it.Close()
+		if err != nil {
+			return deleted, err
+		}
+
+		if len(keys) > 0 {
+			batch := kv.db.NewBatch()
+			for _, key := range keys {
+				if err := batch.Delete(key); err != nil {
+					batch.Close()
+					return deleted, err
+				}
+			}
+			err := batch.Write()
+			batch.Close()
+			if err != nil {
+				return deleted, err
+			}
+			deleted += len(keys)
+		}
+		if done {
+			return deleted, nil
+		}
+	}
+}
+
+// indexBloomBits builds and stores the bloom bits of the given section from
+// the blooms of its blocks. The section is skipped if any of its blocks is not
+// indexed.
+func (kv *KVIndexer) indexBloomBits(section uint64) error {
+	start := section * params.BloomBitsBlocks
+	// the genesis block has no logs
+	if err := kv.checkLogsIndexed(int64(max(start, 1))); err != nil { //nolint:gosec // G115
+		kv.logger.Debug("skipping bloom bits section", "section", section, "err", err)
+		return nil
+	}
+
+	generator, err := bloombits.NewGenerator(uint(params.BloomBitsBlocks))
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < params.BloomBitsBlocks; i++ {
+		bz, err := kv.db.Get(BlockBloomKey(int64(start + i))) //nolint:gosec // G115
+		if err != nil {
+			return err
+		}
+		if err := generator.AddBloom(uint(i), ethtypes.BytesToBloom(bz)); err != nil {
+			return err
+		}
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		bits, err := generator.Bitset(bit)
+		if err != nil {
+			return err
+		}
+		compressed := bitutil.CompressBytes(bits)
+		if compressed == nil {
+			compressed = []byte{}
+		}
+		if err := batch.Set(BloomBitsKey(section, bit), compressed); err != nil {
+			return errorsmod.Wrap(err, "set bloom bits key")
+		}
+	}
+
+	if _, sections := kv.BloomStatus(); section >= sections {
+		if err := batch.Set(KeyBloomSections, sdk.Uint64ToBigEndian(section+1)); err != nil {
+			return errorsmod.Wrap(err, "set bloom sections")
+		}
+	}
+	return batch.Write()
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -171,6 +551,36 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// BlockBloomKey returns the key for db entry: `block number -> block bloom`
+func BlockBloomKey(blockNumber int64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115
+	return append([]byte{KeyPrefixBlockBloom}, bz...)
+}
+
+// TxLogsKey returns the key for db entry: `(block number, tx index) -> tx logs`
+func TxLogsKey(blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115
+	return append(append([]byte{KeyPrefixTxLogs}, bz1...), bz2...)
+}
+
+// AddressTxKey returns the key for db entry: `(address, block number, tx index, role) -> tx hash`
+func AddressTxKey(address common.Address, blockNumber int64, txIndex int32, role byte) []byte {
+	key := make([]byte, 0, AddressTxKeyLength)
+	key = append(key, KeyPrefixAddressTx)
+	key = append(key, address.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115
+	key = append(key, sdk.Uint64ToBigEndian(uint64(txIndex))...)     //nolint:gosec // G115
+	return append(key, role)
+}
+
+// BloomBitsKey returns the key for db entry: `(section, bit) -> compressed bit vector`
+func BloomBitsKey(section uint64, bit uint) []byte {
+	bz1 := sdk.Uint64ToBigEndian(section)
+	bz2 := sdk.Uint64ToBigEndian(uint64(bit))
+	return append(append([]byte{KeyPrefixBloomBits}, bz1...), bz2...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -222,6 +632,69 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveTxAddresses index the tx hash by the sender, the recipient and the
+// created contract addresses into the kv db batch
+func saveTxAddresses(batch dbm.Batch, msg *evmtypes.MsgEthereumTx, txHash common.Hash, txResult *evmostypes.TxResult) error {
+	tx := msg.AsTransaction()
+	if tx == nil {
+		return errors.New("invalid tx data")
+	}
+
+	from, err := ethTxSender(msg)
+	if err != nil {
+		return errorsmod.Wrap(err, "recover tx sender")
+	}
+
+	if err := batch.Set(AddressTxKey(from, txResult.Height, txResult.EthTxIndex, AddressRoleFrom), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set address-tx key")
+	}
+	if to := tx.To(); to != nil {
+		if err := batch.Set(AddressTxKey(*to, txResult.Height, txResult.EthTxIndex, AddressRoleTo), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set address-tx key")
+		}
+		return nil
+	}
+	// the contract is not created by the failed txs
+	if txResult.Failed {
+		return nil
+	}
+	contract := crypto.CreateAddress(from, tx.Nonce())
+	if err := batch.Set(AddressTxKey(contract, txResult.Height, txResult.EthTxIndex, AddressRoleCreated), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set address-tx key")
+	}
+	return nil
+}
+
+// saveTxLogs index the logs of a tx into the kv db batch
+func saveTxLogs(codec codec.Codec, batch dbm.Batch, height int64, ethTxIndex int32, txLogs *evmtypes.TransactionLogs) error {
+	bz := codec.MustMarshal(txLogs)
+	if err := batch.Set(TxLogsKey(height, ethTxIndex), bz); err != nil {
+		return errorsmod.Wrap(err, "set tx-logs key")
+	}
+	return nil
+}
+
+// addLogToBloom adds the address and topics of the log to the bloom
+func addLogToBloom(bloom *ethtypes.Bloom, log *evmtypes.Log) {
+	bloom.Add(common.HexToAddress(log.Address).Bytes())
+	for _, topic := range log.Topics {
+		bloom.Add(common.HexToHash(topic).Bytes())
+	}
+}
+
+// loadHeight loads the block number stored under the given key, returns -1
+// if it's not found
+func loadHeight(db dbm.DB, key []byte) (int64, error) {
+	bz, err := db.Get(key)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "load height %x", key)
+	}
+	if len(bz) != 8 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil // #nosec G115
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -1,6 +1,7 @@
 package indexer_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v20/indexer"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
@@ -186,3 +189,288 @@ func TestKVIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestKVIndexerLogs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{Nonce: 0, To: &to, Amount: big.NewInt(1000), GasLimit: 30000})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), evmostypes.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	topic := common.BigToHash(big.NewInt(42))
+	txLog := types.Log{
+		Address:     to.Hex(),
+		Topics:      []string{topic.Hex()},
+		Data:        []byte{0x01},
+		BlockNumber: 1,
+		TxHash:      txHash.Hex(),
+	}
+	logBz, err := json.Marshal(&txLog)
+	require.NoError(t, err)
+
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "25000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: to.Hex()},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: types.AttributeKeyTxLog, Value: string(logBz)},
+				}},
+			},
+		},
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+	// logs are not indexed yet
+	_, err = idxer.GetLogsByBlock(1)
+	require.Error(t, err)
+
+	require.NoError(t, idxer.IndexBlock(block, blockResult))
+
+	logs, err := idxer.GetLogsByBlock(1)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Len(t, logs[0], 1)
+	require.Equal(t, to, logs[0][0].Address)
+	require.Equal(t, []common.Hash{topic}, logs[0][0].Topics)
+	require.Equal(t, txHash, logs[0][0].TxHash)
+
+	bloom, err := idxer.GetBlockBloom(1)
+	require.NoError(t, err)
+	require.True(t, ethtypes.BloomLookup(bloom, to))
+	require.True(t, ethtypes.BloomLookup(bloom, topic))
+
+	_, err = idxer.GetBlockBloom(2)
+	require.Error(t, err)
+
+	// complete the first bloom bits section with empty blocks
+	sectionSize, sections := idxer.BloomStatus()
+	require.Equal(t, params.BloomBitsBlocks, sectionSize)
+	require.Equal(t, uint64(0), sections)
+	for height := int64(2); height < int64(sectionSize); height++ {
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: height}}, nil))
+	}
+	_, sections = idxer.BloomStatus()
+	require.Equal(t, uint64(1), sections)
+
+	emptyBloom, err := idxer.GetBlockBloom(2)
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.Bloom{}, emptyBloom)
+
+	// the block 1 has the bits of the log address set
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		bits, err := idxer.GetBloomBits(0, bit)
+		require.NoError(t, err)
+		require.Len(t, bits, int(sectionSize/8))
+
+		byteIndex := ethtypes.BloomByteLength - 1 - bit/8
+		bitSet := bloom[byteIndex]&(1<<(bit%8)) != 0
+		require.Equal(t, bitSet, bits[0]&(1<<6) != 0, "bit %d", bit)
+	}
+
+	_, err = idxer.GetBloomBits(1, 0)
+	require.Error(t, err)
+}
+
+func TestKVIndexerAddresses(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	to := common.BigToAddress(big.NewInt(1))
+	block1, result1, transferHash := buildEthTxBlock(t, clientCtx, priv, 1, &types.EvmTxArgs{Nonce: 0, To: &to, Amount: big.NewInt(1000), GasLimit: 21000})
+	block2, result2, selfHash := buildEthTxBlock(t, clientCtx, priv, 2, &types.EvmTxArgs{Nonce: 1, To: &from, Amount: big.NewInt(1), GasLimit: 21000})
+	block3, result3, createHash := buildEthTxBlock(t, clientCtx, priv, 3, &types.EvmTxArgs{Nonce: 2, Input: []byte{0x00}, GasLimit: 60000})
+	contract := crypto.CreateAddress(from, 2)
+
+	// the address index is disabled by default
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.IndexBlock(block1, result1))
+	_, err = idxer.GetTxHashesByAddress(from, 0, 3, 0, 0)
+	require.Error(t, err)
+
+	idxer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx).WithAddressIndex(true)
+	require.NoError(t, idxer.IndexBlock(block1, result1))
+	require.NoError(t, idxer.IndexBlock(block2, result2))
+	require.NoError(t, idxer.IndexBlock(block3, result3))
+
+	testCases := []struct {
+		name      string
+		address   common.Address
+		fromBlock int64
+		toBlock   int64
+		offset    uint64
+		limit     uint64
+		expHashes []common.Hash
+	}{
+		{"sender, all txs", from, 0, 3, 0, 0, []common.Hash{transferHash, selfHash, createHash}},
+		{"sender, block range", from, 2, 2, 0, 0, []common.Hash{selfHash}},
+		{"sender, paginated", from, 0, 3, 1, 1, []common.Hash{selfHash}},
+		{"sender, offset out of range", from, 0, 3, 3, 0, []common.Hash{}},
+		{"recipient", to, 0, 3, 0, 0, []common.Hash{transferHash}},
+		{"created contract", contract, 0, 3, 0, 0, []common.Hash{createHash}},
+		{"unknown address", common.BigToAddress(big.NewInt(2)), 0, 3, 0, 0, []common.Hash{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hashes, err := idxer.GetTxHashesByAddress(tc.address, tc.fromBlock, tc.toBlock, tc.offset, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHashes, hashes)
+		})
+	}
+
+	_, err = idxer.GetTxHashesByAddress(from, 3, 2, 0, 0)
+	require.Error(t, err)
+}
+
+func TestKVIndexerDeleteAndVerify(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	to := common.BigToAddress(big.NewInt(1))
+	blocks := make([]*cmttypes.Block, 3)
+	results := make([][]*abci.ExecTxResult, 3)
+	hashes := make([]common.Hash, 3)
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx).WithAddressIndex(true)
+	for i := range blocks {
+		blocks[i], results[i], hashes[i] = buildEthTxBlock(t, clientCtx, priv, int64(i+1), &types.EvmTxArgs{
+			Nonce: uint64(i), To: &to, Amount: big.NewInt(1), GasLimit: 21000,
+		})
+		require.NoError(t, idxer.IndexBlock(blocks[i], results[i]))
+		require.NoError(t, idxer.VerifyBlock(blocks[i], results[i]))
+	}
+
+	// the gas used doesn't match the indexed one
+	results[0][0].GasUsed = 22000
+	require.Error(t, idxer.VerifyBlock(blocks[0], results[0]))
+
+	require.Error(t, idxer.DeleteBlocks(3, 2))
+	require.NoError(t, idxer.DeleteBlocks(2, 3))
+
+	_, err = idxer.GetByTxHash(hashes[1])
+	require.Error(t, err)
+	_, err = idxer.GetByBlockAndIndex(3, 0)
+	require.Error(t, err)
+	_, err = idxer.GetLogsByBlock(2)
+	require.Error(t, err)
+	_, err = idxer.GetLogsByBlock(1)
+	require.NoError(t, err)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), last)
+
+	txHashes, err := idxer.GetTxHashesByAddress(from, 0, 3, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hashes[0]}, txHashes)
+
+	// the deleted block is reported as missing until indexed again
+	require.Error(t, idxer.VerifyBlock(blocks[1], results[1]))
+	require.NoError(t, idxer.IndexBlock(blocks[1], results[1]))
+	require.NoError(t, idxer.VerifyBlock(blocks[1], results[1]))
+	_, err = idxer.GetLogsByBlock(2)
+	require.NoError(t, err)
+}
+
+func TestKVIndexerDeleteBloomBits(t *testing.T) {
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+
+	// index the first two bloom bits sections
+	sectionSize, _ := idxer.BloomStatus()
+	for height := int64(1); height < int64(2*sectionSize); height++ {
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: height}}, nil))
+	}
+	_, sections := idxer.BloomStatus()
+	require.Equal(t, uint64(2), sections)
+
+	// pruning up to the middle of the first section deletes its bloom bits
+	require.NoError(t, idxer.DeleteBlocks(1, int64(sectionSize/2)))
+	_, err := idxer.GetBloomBits(0, 0)
+	require.Error(t, err)
+	_, err = idxer.GetBloomBits(1, 0)
+	require.NoError(t, err)
+
+	// deleting a single block in the middle of the second section deletes its bloom bits
+	height := int64(sectionSize + sectionSize/2)
+	require.NoError(t, idxer.DeleteBlocks(height, height))
+	_, err = idxer.GetBloomBits(1, 0)
+	require.Error(t, err)
+
+	// the bloom bits are rebuilt once the section blocks are indexed again
+	for ; height < int64(2*sectionSize); height++ {
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: height}}, nil))
+	}
+	_, err = idxer.GetBloomBits(1, 0)
+	require.NoError(t, err)
+}
+
+// buildEthTxBlock builds a block of the given height with a single successful
+// eth tx signed by the given key.
+func buildEthTxBlock(
+	t *testing.T,
+	clientCtx client.Context,
+	priv *ethsecp256k1.PrivKey,
+	height int64,
+	args *types.EvmTxArgs,
+) (*cmttypes.Block, []*abci.ExecTxResult, common.Hash) {
+	tx := types.NewTx(args)
+	tx.From = common.BytesToAddress(priv.PubKey().Address().Bytes()).Hex()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), utiltx.NewSigner(priv)))
+	txHash := tx.AsTransaction().Hash()
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), evmostypes.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+	}
+	return block, blockResult, txHash
+}
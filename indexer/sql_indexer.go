@@ -0,0 +1,526 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	evmostypes "github.com/evmos/evmos/v20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+
+	// register the postgres driver
+	_ "github.com/lib/pq"
+	// register the sqlite3 driver used by default
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// DefaultSQLDriver is the database/sql driver used by default by the SQL indexer.
+const DefaultSQLDriver = "sqlite3"
+
+// transferEventTopic is the topic of the ERC-20 Transfer(address,address,uint256) event
+var transferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// sqlSchema defines the normalized tables of the SQL indexer. The addresses
+// and hashes are stored as lower case hex strings and the amounts as decimal
+// strings, so the schema is portable across SQL databases.
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		height BIGINT PRIMARY KEY,
+		hash TEXT NOT NULL,
+		time BIGINT NOT NULL,
+		bloom TEXT NOT NULL,
+		eth_tx_count INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS transactions (
+		hash TEXT PRIMARY KEY,
+		height BIGINT NOT NULL,
+		tx_index INTEGER NOT NULL,
+		msg_index INTEGER NOT NULL,
+		eth_tx_index INTEGER NOT NULL,
+		from_address TEXT NOT NULL,
+		to_address TEXT,
+		contract_address TEXT,
+		value TEXT NOT NULL,
+		nonce BIGINT NOT NULL,
+		gas_limit BIGINT NOT NULL,
+		gas_used BIGINT NOT NULL,
+		cumulative_gas_used BIGINT NOT NULL,
+		failed BOOLEAN NOT NULL
+	)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS transactions_block_idx ON transactions (height, eth_tx_index)`,
+	`CREATE INDEX IF NOT EXISTS transactions_from_idx ON transactions (from_address, height)`,
+	`CREATE INDEX IF NOT EXISTS transactions_to_idx ON transactions (to_address, height)`,
+	`CREATE INDEX IF NOT EXISTS transactions_contract_idx ON transactions (contract_address, height)`,
+	`CREATE TABLE IF NOT EXISTS logs (
+		height BIGINT NOT NULL,
+		eth_tx_index INTEGER NOT NULL,
+		position INTEGER NOT NULL,
+		log_index INTEGER NOT NULL,
+		tx_hash TEXT NOT NULL,
+		block_hash TEXT NOT NULL,
+		address TEXT NOT NULL,
+		topic0 TEXT,
+		topic1 TEXT,
+		topic2 TEXT,
+		topic3 TEXT,
+		data TEXT NOT NULL,
+		PRIMARY KEY (height, eth_tx_index, position)
+	)`,
+	`CREATE INDEX IF NOT EXISTS logs_address_idx ON logs (address, height)`,
+	`CREATE INDEX IF NOT EXISTS logs_topic0_idx ON logs (topic0, height)`,
+	`CREATE TABLE IF NOT EXISTS token_transfers (
+		height BIGINT NOT NULL,
+		eth_tx_index INTEGER NOT NULL,
+		position INTEGER NOT NULL,
+		tx_hash TEXT NOT NULL,
+		token TEXT NOT NULL,
+		from_address TEXT NOT NULL,
+		to_address TEXT NOT NULL,
+		value TEXT NOT NULL,
+		PRIMARY KEY (height, eth_tx_index, position)
+	)`,
+	`CREATE INDEX IF NOT EXISTS token_transfers_token_idx ON token_transfers (token, height)`,
+	`CREATE INDEX IF NOT EXISTS token_transfers_from_idx ON token_transfers (from_address, height)`,
+	`CREATE INDEX IF NOT EXISTS token_transfers_to_idx ON token_transfers (to_address, height)`,
+}
+
+var _ evmostypes.EVMTxIndexer = &SQLIndexer{}
+
+// SQLIndexer implements a eth tx indexer on a SQL database, storing the
+// blocks, txs, logs and token transfers on normalized tables. It works with
+// any database/sql driver, the queries use the `?` placeholders, which are
+// rewritten to the `$n` ones for the postgres drivers.
+type SQLIndexer struct {
+	db        *sql.DB
+	logger    log.Logger
+	clientCtx client.Context
+	// dollarPlaceholders is true if the driver uses the `$n` placeholders
+	dollarPlaceholders bool
+}
+
+// NewSQLIndexer creates the SQLIndexer, creating the tables of the indexer if
+// they don't exist.
+func NewSQLIndexer(db *sql.DB, driverName string, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	idxer := &SQLIndexer{
+		db:                 db,
+		logger:             logger,
+		clientCtx:          clientCtx,
+		dollarPlaceholders: driverName == "postgres" || driverName == "pgx",
+	}
+	for _, stmt := range sqlSchema {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, errorsmod.Wrap(err, "create sql indexer schema")
+		}
+	}
+	return idxer, nil
+}
+
+// IndexBlock index all the eth txs in a block, along with their logs and the
+// ERC-20 token transfers. The existing rows of the block are replaced.
+func (idx *SQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Header.Height
+	blockHash := common.BytesToHash(block.Hash())
+
+	dbTx, err := idx.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, begin", height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck // no-op after commit
+
+	for _, table := range []string{"blocks", "transactions", "logs", "token_transfers"} {
+		if _, err := dbTx.Exec(idx.rebind("DELETE FROM "+table+" WHERE height = ?"), height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, delete %s", height, table)
+		}
+	}
+
+	// bloom of all the logs emitted in the block
+	var bloom ethtypes.Bloom
+	txs := parseBlockTxs(idx.clientCtx, idx.logger, block, txResults)
+	for _, tx := range txs {
+		if err := idx.insertTx(dbTx, tx); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+		for position, log := range tx.logs {
+			if err := idx.insertLog(dbTx, tx, position, log); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+			addLogToBloom(&bloom, log)
+		}
+	}
+
+	if _, err := dbTx.Exec(
+		idx.rebind("INSERT INTO blocks (height, hash, time, bloom, eth_tx_count) VALUES (?, ?, ?, ?, ?)"),
+		height, blockHash.Hex(), block.Header.Time.Unix(), hexutil.Encode(bloom.Bytes()), len(txs),
+	); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, insert block", height)
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (idx *SQLIndexer) LastIndexedBlock() (int64, error) {
+	var height sql.NullInt64
+	if err := idx.db.QueryRow("SELECT MAX(height) FROM blocks").Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (idx *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	var height sql.NullInt64
+	if err := idx.db.QueryRow("SELECT MIN(height) FROM blocks").Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "FirstIndexedBlock")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// DeleteBlocks deletes the indexed rows of the blocks in the [from, to] range,
+// so they can be indexed again.
+func (idx *SQLIndexer) DeleteBlocks(from, to int64) error {
+	if from > to {
+		return fmt.Errorf("invalid block range: from block %d is greater than to block %d", from, to)
+	}
+
+	dbTx, err := idx.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "DeleteBlocks %d %d, begin", from, to)
+	}
+	defer dbTx.Rollback() //nolint:errcheck // no-op after commit
+
+	for _, table := range []string{"blocks", "transactions", "logs", "token_transfers"} {
+		res, err := dbTx.Exec(idx.rebind("DELETE FROM "+table+" WHERE height >= ? AND height <= ?"), from, to)
+		if err != nil {
+			return errorsmod.Wrapf(err, "DeleteBlocks %d %d, %s", from, to, table)
+		}
+		if deleted, err := res.RowsAffected(); err == nil {
+			idx.logger.Info("deleted rows", "table", table, "from", from, "to", to, "count", deleted)
+		}
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "DeleteBlocks %d %d, commit", from, to)
+	}
+	return nil
+}
+
+// VerifyBlock checks the indexed rows of the given block against the ones
+// built from the given block results, returning an error that describes the
+// first mismatch found.
+func (idx *SQLIndexer) VerifyBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	// the expected rows are indexed on an in-memory database, which only
+	// exists while its single connection is open
+	memDB, err := sql.Open(DefaultSQLDriver, ":memory:")
+	if err != nil {
+		return err
+	}
+	defer memDB.Close()
+	memDB.SetMaxOpenConns(1)
+
+	expected, err := NewSQLIndexer(memDB, DefaultSQLDriver, idx.logger, idx.clientCtx)
+	if err != nil {
+		return err
+	}
+	if err := expected.IndexBlock(block, txResults); err != nil {
+		return err
+	}
+	return verifyIndexedBlock(expected, idx, block.Header.Height, true)
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (idx *SQLIndexer) GetByTxHash(hash common.Hash) (*evmostypes.TxResult, error) {
+	txResult, err := idx.queryTxResult("hash = ?", hash.Hex())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return txResult, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (idx *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*evmostypes.TxResult, error) {
+	txResult, err := idx.queryTxResult("height = ? AND eth_tx_index = ?", blockNumber, txIndex)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return txResult, nil
+}
+
+// GetBlockBloom returns the bloom of the logs emitted by the eth txs of the
+// given block. It returns an error if the block is not indexed.
+func (idx *SQLIndexer) GetBlockBloom(blockNumber int64) (ethtypes.Bloom, error) {
+	var bloom string
+	err := idx.db.QueryRow(idx.rebind("SELECT bloom FROM blocks WHERE height = ?"), blockNumber).Scan(&bloom)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ethtypes.Bloom{}, fmt.Errorf("logs not indexed, block: %d", blockNumber)
+	}
+	if err != nil {
+		return ethtypes.Bloom{}, errorsmod.Wrapf(err, "GetBlockBloom %d", blockNumber)
+	}
+	bz, err := hexutil.Decode(bloom)
+	if err != nil {
+		return ethtypes.Bloom{}, errorsmod.Wrapf(err, "GetBlockBloom %d", blockNumber)
+	}
+	return ethtypes.BytesToBloom(bz), nil
+}
+
+// GetLogsByBlock returns the logs emitted by the successful eth txs of the
+// given block, grouped by tx. It returns an error if the block is not indexed.
+func (idx *SQLIndexer) GetLogsByBlock(blockNumber int64) ([][]*ethtypes.Log, error) {
+	if _, err := idx.GetBlockBloom(blockNumber); err != nil {
+		return nil, err
+	}
+
+	rows, err := idx.db.Query(idx.rebind(
+		`SELECT t.eth_tx_index, l.log_index, l.tx_hash, l.block_hash, l.address, l.topic0, l.topic1, l.topic2, l.topic3, l.data
+		FROM transactions t LEFT JOIN logs l ON l.height = t.height AND l.eth_tx_index = t.eth_tx_index
+		WHERE t.height = ? AND t.failed = ?
+		ORDER BY t.eth_tx_index, l.position`,
+	), blockNumber, false)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetLogsByBlock %d", blockNumber)
+	}
+	defer rows.Close()
+
+	blockLogs := [][]*ethtypes.Log{}
+	lastTxIndex := int64(-1)
+	for rows.Next() {
+		var (
+			ethTxIndex                     int64
+			logIndex                       sql.NullInt64
+			txHash, blockHash, address     sql.NullString
+			topic0, topic1, topic2, topic3 sql.NullString
+			data                           sql.NullString
+		)
+		if err := rows.Scan(&ethTxIndex, &logIndex, &txHash, &blockHash, &address, &topic0, &topic1, &topic2, &topic3, &data); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogsByBlock %d", blockNumber)
+		}
+		if ethTxIndex != lastTxIndex {
+			blockLogs = append(blockLogs, []*ethtypes.Log{})
+			lastTxIndex = ethTxIndex
+		}
+		// the txs without logs have no joined rows
+		if !logIndex.Valid {
+			continue
+		}
+
+		log := &ethtypes.Log{
+			Address:     common.HexToAddress(address.String),
+			Topics:      []common.Hash{},
+			BlockNumber: uint64(blockNumber), //nolint:gosec // G115
+			TxHash:      common.HexToHash(txHash.String),
+			TxIndex:     uint(ethTxIndex), //nolint:gosec // G115
+			BlockHash:   common.HexToHash(blockHash.String),
+			Index:       uint(logIndex.Int64), //nolint:gosec // G115
+		}
+		for _, topic := range []sql.NullString{topic0, topic1, topic2, topic3} {
+			if topic.Valid {
+				log.Topics = append(log.Topics, common.HexToHash(topic.String))
+			}
+		}
+		if log.Data, err = hexutil.Decode(data.String); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogsByBlock %d", blockNumber)
+		}
+		blockLogs[len(blockLogs)-1] = append(blockLogs[len(blockLogs)-1], log)
+	}
+	return blockLogs, rows.Err()
+}
+
+// GetBloomBits returns an error since the bloom bits are not indexed by the
+// SQL indexer, the logs are filtered with the block blooms instead.
+func (idx *SQLIndexer) GetBloomBits(section uint64, _ uint) ([]byte, error) {
+	return nil, fmt.Errorf("bloom bits section not indexed: %d", section)
+}
+
+// BloomStatus returns the number of blocks of each bloom bits section and the
+// number of indexed sections, which is always zero.
+func (idx *SQLIndexer) BloomStatus() (uint64, uint64) {
+	return params.BloomBitsBlocks, 0
+}
+
+// GetTxHashesByAddress returns the hashes of the eth txs sent from or to the
+// given address, or creating it, within the [fromBlock, toBlock] range in
+// ascending order. The first `offset` txs are skipped and at most `limit` txs
+// are returned, a zero limit returns all of them.
+func (idx *SQLIndexer) GetTxHashesByAddress(
+	address common.Address,
+	fromBlock, toBlock int64,
+	offset, limit uint64,
+) ([]common.Hash, error) {
+	if fromBlock > toBlock {
+		return nil, fmt.Errorf("invalid block range: from block %d is greater than to block %d", fromBlock, toBlock)
+	}
+
+	addr := hexAddress(address)
+	query := `SELECT hash FROM transactions
+		WHERE height >= ? AND height <= ? AND (from_address = ? OR to_address = ? OR contract_address = ?)
+		ORDER BY height, eth_tx_index`
+	if limit > 0 || offset > 0 {
+		// the offset requires a limit on some databases
+		if limit == 0 || limit > math.MaxInt64 {
+			limit = math.MaxInt64
+		}
+		query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+	}
+
+	rows, err := idx.db.Query(idx.rebind(query), fromBlock, toBlock, addr, addr, addr)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetTxHashesByAddress %s", address.Hex())
+	}
+	defer rows.Close()
+
+	hashes := []common.Hash{}
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetTxHashesByAddress %s", address.Hex())
+		}
+		hashes = append(hashes, common.HexToHash(hash))
+	}
+	return hashes, rows.Err()
+}
+
+// insertTx inserts the row of the eth tx
+func (idx *SQLIndexer) insertTx(dbTx *sql.Tx, tx *blockTx) error {
+	ethTx := tx.msg.AsTransaction()
+	if ethTx == nil {
+		return errors.New("invalid tx data")
+	}
+	from, err := ethTxSender(tx.msg)
+	if err != nil {
+		return errorsmod.Wrap(err, "recover tx sender")
+	}
+
+	var to, contract sql.NullString
+	if ethTx.To() != nil {
+		to = sql.NullString{String: hexAddress(*ethTx.To()), Valid: true}
+	} else if !tx.result.Failed {
+		// the contract is not created by the failed txs
+		contract = sql.NullString{String: hexAddress(crypto.CreateAddress(from, ethTx.Nonce())), Valid: true}
+	}
+
+	_, err = dbTx.Exec(idx.rebind(
+		`INSERT INTO transactions (hash, height, tx_index, msg_index, eth_tx_index, from_address, to_address, contract_address,
+		value, nonce, gas_limit, gas_used, cumulative_gas_used, failed)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+	),
+		tx.hash.Hex(), tx.result.Height, tx.result.TxIndex, tx.result.MsgIndex, tx.result.EthTxIndex,
+		hexAddress(from), to, contract, ethTx.Value().String(), int64(ethTx.Nonce()), int64(ethTx.Gas()), //nolint:gosec // G115
+		int64(tx.result.GasUsed), int64(tx.result.CumulativeGasUsed), tx.result.Failed, //nolint:gosec // G115
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "insert tx")
+	}
+	return nil
+}
+
+// insertLog inserts the row of the log and the token transfer it records, if any
+func (idx *SQLIndexer) insertLog(dbTx *sql.Tx, tx *blockTx, position int, log *evmtypes.Log) error {
+	topics := make([]sql.NullString, 4)
+	for i, topic := range log.Topics {
+		if i >= len(topics) {
+			break
+		}
+		topics[i] = sql.NullString{String: common.HexToHash(topic).Hex(), Valid: true}
+	}
+
+	_, err := dbTx.Exec(idx.rebind(
+		`INSERT INTO logs (height, eth_tx_index, position, log_index, tx_hash, block_hash, address, topic0, topic1, topic2, topic3, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+	),
+		tx.result.Height, tx.result.EthTxIndex, position, int64(log.Index), tx.hash.Hex(), common.HexToHash(log.BlockHash).Hex(), //nolint:gosec // G115
+		hexAddress(common.HexToAddress(log.Address)), topics[0], topics[1], topics[2], topics[3], hexutil.Encode(log.Data),
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "insert log")
+	}
+
+	// ERC-20 transfers, the ERC-721 ones have the token id as an indexed topic
+	if len(log.Topics) != 3 || common.HexToHash(log.Topics[0]) != transferEventTopic || len(log.Data) != 32 {
+		return nil
+	}
+	_, err = dbTx.Exec(idx.rebind(
+		`INSERT INTO token_transfers (height, eth_tx_index, position, tx_hash, token, from_address, to_address, value)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+	),
+		tx.result.Height, tx.result.EthTxIndex, position, tx.hash.Hex(), hexAddress(common.HexToAddress(log.Address)),
+		hexAddress(common.HexToAddress(log.Topics[1])), hexAddress(common.HexToAddress(log.Topics[2])),
+		new(big.Int).SetBytes(log.Data).String(),
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "insert token transfer")
+	}
+	return nil
+}
+
+// queryTxResult returns the indexer.TxResult of the tx matching the given condition
+func (idx *SQLIndexer) queryTxResult(condition string, args ...interface{}) (*evmostypes.TxResult, error) {
+	var (
+		txResult                   evmostypes.TxResult
+		gasUsed, cumulativeGasUsed int64
+	)
+	err := idx.db.QueryRow(
+		idx.rebind("SELECT height, tx_index, msg_index, eth_tx_index, gas_used, cumulative_gas_used, failed FROM transactions WHERE "+condition),
+		args...,
+	).Scan(&txResult.Height, &txResult.TxIndex, &txResult.MsgIndex, &txResult.EthTxIndex, &gasUsed, &cumulativeGasUsed, &txResult.Failed)
+	if err != nil {
+		return nil, err
+	}
+	txResult.GasUsed = uint64(gasUsed)                     //nolint:gosec // G115
+	txResult.CumulativeGasUsed = uint64(cumulativeGasUsed) //nolint:gosec // G115
+	return &txResult, nil
+}
+
+// rebind rewrites the `?` placeholders of the query for the driver
+func (idx *SQLIndexer) rebind(query string) string {
+	if !idx.dollarPlaceholders {
+		return query
+	}
+	var sb strings.Builder
+	n := 0
+	for _, c := range query {
+		if c == '?' {
+			n++
+			sb.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// hexAddress returns the lower case hex encoding of the address
+func hexAddress(address common.Address) string {
+	return strings.ToLower(address.Hex())
+}
@@ -0,0 +1,179 @@
+package indexer_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v20/indexer"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	evmostypes "github.com/evmos/evmos/v20/types"
+	"github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLIndexer(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db, err := sql.Open(indexer.DefaultSQLDriver, filepath.Join(t.TempDir(), "evmindexer.sqlite"))
+	require.NoError(t, err)
+	defer db.Close()
+	idxer, err := indexer.NewSQLIndexer(db, indexer.DefaultSQLDriver, log.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	token := common.BigToAddress(big.NewInt(1))
+	recipient := common.BigToAddress(big.NewInt(2))
+	block1, result1, transferHash := buildEthTxBlock(t, clientCtx, priv, 1, &types.EvmTxArgs{Nonce: 0, To: &token, GasLimit: 60000})
+	block2, result2, createHash := buildEthTxBlock(t, clientCtx, priv, 2, &types.EvmTxArgs{Nonce: 1, Input: []byte{0x00}, GasLimit: 60000})
+	contract := crypto.CreateAddress(from, 1)
+
+	// ERC-20 transfer of 100 tokens to the recipient
+	transferLog := types.Log{
+		Address: token.Hex(),
+		Topics: []string{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")).Hex(),
+			common.BytesToHash(from.Bytes()).Hex(),
+			common.BytesToHash(recipient.Bytes()).Hex(),
+		},
+		Data:        common.BigToHash(big.NewInt(100)).Bytes(),
+		BlockNumber: 1,
+		TxHash:      transferHash.Hex(),
+	}
+	logBz, err := json.Marshal(&transferLog)
+	require.NoError(t, err)
+	result1[0].Events = append(result1[0].Events, abci.Event{
+		Type:       types.EventTypeTxLog,
+		Attributes: []abci.EventAttribute{{Key: types.AttributeKeyTxLog, Value: string(logBz)}},
+	})
+
+	require.NoError(t, idxer.IndexBlock(block1, result1))
+	require.NoError(t, idxer.IndexBlock(block2, result2))
+	// the rows of a block are replaced when indexed again
+	require.NoError(t, idxer.IndexBlock(block1, result1))
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), last)
+
+	txResult, err := idxer.GetByTxHash(createHash)
+	require.NoError(t, err)
+	require.Equal(t, evmostypes.TxResult{Height: 2}, *txResult)
+	txResult2, err := idxer.GetByBlockAndIndex(2, 0)
+	require.NoError(t, err)
+	require.Equal(t, txResult, txResult2)
+	_, err = idxer.GetByTxHash(common.Hash{})
+	require.Error(t, err)
+	_, err = idxer.GetByBlockAndIndex(2, 1)
+	require.Error(t, err)
+
+	logs, err := idxer.GetLogsByBlock(1)
+	require.NoError(t, err)
+	require.Equal(t, [][]*ethtypes.Log{types.LogsToEthereum([]*types.Log{&transferLog})}, logs)
+	logs, err = idxer.GetLogsByBlock(2)
+	require.NoError(t, err)
+	require.Equal(t, [][]*ethtypes.Log{{}}, logs)
+	_, err = idxer.GetLogsByBlock(3)
+	require.Error(t, err)
+
+	bloom, err := idxer.GetBlockBloom(1)
+	require.NoError(t, err)
+	require.True(t, ethtypes.BloomLookup(bloom, token))
+	_, err = idxer.GetBloomBits(0, 0)
+	require.Error(t, err)
+
+	hashes, err := idxer.GetTxHashesByAddress(from, 0, 2, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{transferHash, createHash}, hashes)
+	hashes, err = idxer.GetTxHashesByAddress(from, 0, 2, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{createHash}, hashes)
+	hashes, err = idxer.GetTxHashesByAddress(contract, 0, 2, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{createHash}, hashes)
+	hashes, err = idxer.GetTxHashesByAddress(token, 2, 2, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, hashes)
+
+	var count int
+	var value, to string
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM transactions").Scan(&count))
+	require.Equal(t, 2, count)
+	require.NoError(t, db.QueryRow("SELECT value, to_address FROM token_transfers WHERE tx_hash = ?", transferHash.Hex()).Scan(&value, &to))
+	require.Equal(t, "100", value)
+	require.Equal(t, recipient, common.HexToAddress(to))
+}
+
+func TestSQLIndexerDeleteAndVerify(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db, err := sql.Open(indexer.DefaultSQLDriver, filepath.Join(t.TempDir(), "evmindexer.sqlite"))
+	require.NoError(t, err)
+	defer db.Close()
+	idxer, err := indexer.NewSQLIndexer(db, indexer.DefaultSQLDriver, log.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+
+	to := common.BigToAddress(big.NewInt(1))
+	blocks := make([]*cmttypes.Block, 3)
+	results := make([][]*abci.ExecTxResult, 3)
+	hashes := make([]common.Hash, 3)
+	for i := range blocks {
+		blocks[i], results[i], hashes[i] = buildEthTxBlock(t, clientCtx, priv, int64(i+1), &types.EvmTxArgs{
+			Nonce: uint64(i), To: &to, Amount: big.NewInt(1), GasLimit: 21000,
+		})
+		require.NoError(t, idxer.IndexBlock(blocks[i], results[i]))
+		require.NoError(t, idxer.VerifyBlock(blocks[i], results[i]))
+	}
+
+	// the gas used doesn't match the indexed one
+	results[0][0].GasUsed = 22000
+	require.Error(t, idxer.VerifyBlock(blocks[0], results[0]))
+
+	require.Error(t, idxer.DeleteBlocks(3, 2))
+	require.NoError(t, idxer.DeleteBlocks(2, 3))
+
+	_, err = idxer.GetByTxHash(hashes[1])
+	require.Error(t, err)
+	_, err = idxer.GetLogsByBlock(2)
+	require.Error(t, err)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), last)
+
+	txHashes, err := idxer.GetTxHashesByAddress(from, 0, 3, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hashes[0]}, txHashes)
+
+	// the deleted block is reported as missing until indexed again
+	require.Error(t, idxer.VerifyBlock(blocks[1], results[1]))
+	require.NoError(t, idxer.IndexBlock(blocks[1], results[1]))
+	require.NoError(t, idxer.VerifyBlock(blocks[1], results[1]))
+}
@@ -0,0 +1,178 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	evmostypes "github.com/evmos/evmos/v20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// blockTx is an eth tx of a block along with its parsed results.
+type blockTx struct {
+	hash   common.Hash
+	msg    *evmtypes.MsgEthereumTx
+	result evmostypes.TxResult
+	// logs is nil if the logs of the tx are not emitted
+	logs []*evmtypes.Log
+}
+
+// parseBlockTxs parses the valid eth txs of the block through the following steps:
+// - Iterates over all of the Txs in Block
+// - Parses eth Tx infos from cosmos-sdk events for every TxResult
+// - Iterates over all the messages of the Tx
+// - Builds a blockTx with the indexer.TxResult and logs of every message
+func parseBlockTxs(clientCtx client.Context, logger log.Logger, block *cmttypes.Block, txResults []*abci.ExecTxResult) []*blockTx {
+	height := block.Header.Height
+
+	var blockTxs []*blockTx
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSucessOrExpectedFailure(result) {
+			continue
+		}
+
+		tx, err := clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		var cumulativeGasUsed uint64
+		msgsLogs, err := rpctypes.AllTxLogsFromEvents(result.Events)
+		if err != nil {
+			logger.Error("Fail to parse logs", "err", err, "block", height, "txIndex", txIndex)
+		}
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+			txHash := common.HexToHash(ethMsg.Hash)
+
+			txResult := evmostypes.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),  //nolint:gosec
+				MsgIndex:   uint32(msgIndex), //nolint:gosec
+				EthTxIndex: ethTxIndex,
+			}
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				txResult.GasUsed = ethMsg.GetGas()
+				txResult.Failed = true
+			} else {
+				parsedTx := txs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				txResult.GasUsed = parsedTx.GasUsed
+				txResult.Failed = parsedTx.Failed
+			}
+
+			cumulativeGasUsed += txResult.GasUsed
+			txResult.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			blockTx := &blockTx{hash: txHash, msg: ethMsg, result: txResult}
+			// the logs of the failed txs are not emitted
+			if result.Code == abci.CodeTypeOK && msgIndex < len(msgsLogs) {
+				blockTx.logs = msgsLogs[msgIndex]
+			}
+			blockTxs = append(blockTxs, blockTx)
+		}
+	}
+	return blockTxs
+}
+
+// ethTxSender recovers the sender of the eth tx from its signature.
+func ethTxSender(msg *evmtypes.MsgEthereumTx) (common.Address, error) {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return common.Address{}, err
+	}
+	// set code txs can't be represented as go-ethereum transactions
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		return setCodeTx.GetSender(nil)
+	}
+
+	tx := msg.AsTransaction()
+	var signer ethtypes.Signer = ethtypes.HomesteadSigner{}
+	if tx.Protected() {
+		signer = ethtypes.LatestSignerForChainID(tx.ChainId())
+	}
+	return ethtypes.Sender(signer, tx)
+}
+
+// verifyIndexedBlock checks the entries of the given block indexed by idx
+// against the ones indexed by expected, returning an error that describes the
+// first mismatch found. The logs and the bloom are only compared if checkLogs
+// is true.
+func verifyIndexedBlock(expected, idx evmostypes.EVMTxIndexer, height int64, checkLogs bool) error {
+	for ethTxIndex := int32(0); ; ethTxIndex++ {
+		expResult, expErr := expected.GetByBlockAndIndex(height, ethTxIndex)
+		result, err := idx.GetByBlockAndIndex(height, ethTxIndex)
+		if expErr != nil {
+			if err == nil {
+				return fmt.Errorf("unexpected tx at eth tx index %d", ethTxIndex)
+			}
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("missing tx at eth tx index %d: %w", ethTxIndex, err)
+		}
+		if *result != *expResult {
+			return fmt.Errorf("tx result mismatch at eth tx index %d, expected: %s, got: %s", ethTxIndex, expResult, result)
+		}
+	}
+
+	if !checkLogs {
+		return nil
+	}
+	expLogs, err := expected.GetLogsByBlock(height)
+	if err != nil {
+		return err
+	}
+	logs, err := idx.GetLogsByBlock(height)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(logs, expLogs) {
+		return fmt.Errorf("logs mismatch, expected %d txs with logs, got %d", len(expLogs), len(logs))
+	}
+	expBloom, err := expected.GetBlockBloom(height)
+	if err != nil {
+		return err
+	}
+	bloom, err := idx.GetBlockBloom(height)
+	if err != nil {
+		return err
+	}
+	if bloom != expBloom {
+		return errors.New("block bloom mismatch")
+	}
+	return nil
+}
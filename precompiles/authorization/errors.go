@@ -19,6 +19,9 @@ const (
 	ErrInvalidMethods = "invalid methods defined; expected an array of strings; got: %v"
 	// ErrInvalidMethod is raised when the given method cannot be unpacked.
 	ErrInvalidMethod = "invalid method defined; expected a string; got: %v"
+	// ErrDifferentOriginFromAccount is raised when the origin address is not the same as the account
+	// a precompile message is sent on behalf of.
+	ErrDifferentOriginFromAccount = "origin address %s is not the same as the account address %s"
 	// ErrAuthzNotAccepted is raised when the authorization is not accepted.
 	ErrAuthzNotAccepted = "authorization to %s for address %s is not accepted"
 )
@@ -155,6 +155,36 @@ func CheckAuthzExists(
 	return msgAuthz, expiration, nil
 }
 
+// CheckOriginOrAuthzExists checks that a precompile call from the given caller
+// can act on behalf of the given account for the given message type. The
+// account must be either the calling contract or the origin. When a contract
+// acts on behalf of the origin, the origin must have granted it an
+// authorization for the message type.
+func CheckOriginOrAuthzExists(
+	ctx sdk.Context,
+	authzKeeper authzkeeper.Keeper,
+	origin, caller, account common.Address,
+	msgURL string,
+) error {
+	// the contract is the account, it handles who is authorized to make this call
+	isContractAccount := caller == account && caller != origin
+	if isContractAccount {
+		return nil
+	}
+
+	if origin != account {
+		return fmt.Errorf(ErrDifferentOriginFromAccount, origin.String(), account.String())
+	}
+
+	if caller != origin {
+		if _, _, err := CheckAuthzExists(ctx, authzKeeper, caller, origin, msgURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // CheckAuthzAndAllowanceForGranter checks if the authorization exists and is not expired for the
 // given spender and the allowance is not exceeded.
 // If the authorization has a limit, checks that the provided amount does not exceed the current limit.
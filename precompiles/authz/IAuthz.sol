@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev GrantInfo defines the information of an authz grant.
+/// The spend limit and allow list are only populated for the send authorizations.
+struct GrantInfo {
+    /// granter defines the address of the account that gave the grant.
+    address granter;
+    /// grantee defines the address of the account that received the grant.
+    address grantee;
+    /// authorizationType defines the type URL of the authorization
+    /// (e.g. /cosmos.authz.v1beta1.GenericAuthorization).
+    string authorizationType;
+    /// msgTypeUrl defines the type URL of the authorized message.
+    string msgTypeUrl;
+    /// spendLimit defines the coins that the grantee can send.
+    Coin[] spendLimit;
+    /// allowList defines the addresses the grantee can send coins to.
+    address[] allowList;
+    /// expiration defines the unix timestamp when the grant expires, 0 if it doesn't expire.
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the x/authz module
+/// to grant, revoke and execute authorizations of any Cosmos message.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IAuthz {
+    /// @dev Grant defines an Event emitted when a grant is given.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the authorized message
+    /// @param expiration the unix timestamp when the grant expires, 0 if it doesn't expire
+    event Grant(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl,
+        int64 expiration
+    );
+
+    /// @dev Revoke defines an Event emitted when a grant is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the revoked message
+    event Revoke(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Exec defines an Event emitted when messages are executed.
+    /// @param grantee the address of the grantee that executed the messages
+    /// @param msgTypeUrls the type URLs of the executed messages
+    event Exec(address indexed grantee, string[] msgTypeUrls);
+
+    /// @dev grant defines a method for granting a generic authorization of the
+    /// given message type from the caller to the grantee.
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the authorized message
+    /// @param expiration the unix timestamp when the grant expires, 0 if it doesn't expire
+    /// @return success true if the grant was given successfully
+    function grant(
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev grantSend defines a method for granting a bank send authorization
+    /// from the caller to the grantee.
+    /// @param grantee the address of the grantee
+    /// @param spendLimit the coins that the grantee can send
+    /// @param allowList the addresses the grantee can send coins to, empty for any address
+    /// @param expiration the unix timestamp when the grant expires, 0 if it doesn't expire
+    /// @return success true if the grant was given successfully
+    function grantSend(
+        address grantee,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev revoke defines a method for revoking the authorization of the given
+    /// message type granted by the caller to the grantee.
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the revoked message
+    /// @return success true if the grant was revoked successfully
+    function revoke(
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev exec defines a method for executing Cosmos messages with the caller
+    /// as grantee. The messages signed by the caller don't require a grant.
+    /// Only the bank send, staking, distribution and gov vote messages are allowed.
+    /// @param msgs the protobuf encoded messages to execute
+    /// @return results the results of the executed messages
+    function exec(
+        CosmosMsg[] calldata msgs
+    ) external returns (bytes[] memory results);
+
+    /// @dev grants defines a method for querying the grants between a granter
+    /// and a grantee.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the message to filter the grants by, empty for all
+    /// @param pageRequest the pagination of the query
+    /// @return grants the grants between the granter and the grantee
+    /// @return pageResponse the pagination of the response
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (GrantInfo[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev granterGrants defines a method for querying the grants given by a granter.
+    /// @param granter the address of the granter
+    /// @param pageRequest the pagination of the query
+    /// @return grants the grants given by the granter
+    /// @return pageResponse the pagination of the response
+    function granterGrants(
+        address granter,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (GrantInfo[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev granteeGrants defines a method for querying the grants given to a grantee.
+    /// @param grantee the address of the grantee
+    /// @param pageRequest the pagination of the query
+    /// @return grants the grants given to the grantee
+    /// @return pageResponse the pagination of the response
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (GrantInfo[] memory grants, PageResponse memory pageResponse);
+}
@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+const (
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid message type URL: %v"
+	// ErrInvalidExpiration is raised when the expiration is not a valid unix timestamp.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidMsgs is raised when the messages to execute cannot be decoded.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrNoMsgs is raised when there are no messages to execute.
+	ErrNoMsgs = "no messages to execute"
+	// ErrMsgNotAllowed is raised when executing a message that is not allowed through the precompile.
+	ErrMsgNotAllowed = "message %s cannot be executed through the authz precompile"
+)
@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants
+	// query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz
+	// GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz
+	// GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants returns the grants between a granter and a grantee, optionally
+// filtered by message type URL.
+func (p Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, grantee := args[0].(common.Address), args[1].(common.Address)
+	out, err := new(GrantsOutput).FromGrants(p.codec, granter, grantee, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GranterGrants returns all the grants given by a granter.
+func (p Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranterGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(p.codec, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GranteeGrants returns all the grants given to a grantee.
+func (p Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranteeGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(p.codec, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
@@ -0,0 +1,69 @@
+package authz_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestGrants() {
+	method := s.precompile.Methods[authz.GrantsMethod]
+
+	s.SetupTest()
+	_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+	s.grant(ctx, 0, 1, sendMsgURL)
+	s.grant(ctx, 0, 1, "/cosmos.staking.v1beta1.MsgDelegate")
+
+	// fail - empty granter
+	_, err := s.precompile.Grants(ctx, &method, []interface{}{common.Address{}, s.keyring.GetAddr(1), "", query.PageRequest{}})
+	s.Require().ErrorContains(err, "invalid granter address")
+
+	bz, err := s.precompile.Grants(ctx, &method, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgURL, query.PageRequest{}})
+	s.Require().NoError(err)
+
+	var out authz.GrantsOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz))
+	s.Require().Len(out.Grants, 1)
+	s.Require().Equal(s.keyring.GetAddr(0), out.Grants[0].Granter)
+	s.Require().Equal(s.keyring.GetAddr(1), out.Grants[0].Grantee)
+	s.Require().Equal("/cosmos.authz.v1beta1.GenericAuthorization", out.Grants[0].AuthorizationType)
+	s.Require().Equal(sendMsgURL, out.Grants[0].MsgTypeUrl)
+	s.Require().Equal(ctx.BlockTime().Add(time24h).Unix(), out.Grants[0].Expiration)
+
+	bz, err = s.precompile.Grants(ctx, &method, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz))
+	s.Require().Len(out.Grants, 1)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+}
+
+func (s *PrecompileTestSuite) TestGranterAndGranteeGrants() {
+	s.SetupTest()
+	_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+	s.grant(ctx, 0, 1, sendMsgURL)
+	s.grant(ctx, 0, 2, sendMsgURL)
+	s.grant(ctx, 2, 1, sendMsgURL)
+
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+	bz, err := s.precompile.GranterGrants(ctx, &method, []interface{}{s.keyring.GetAddr(0), query.PageRequest{}})
+	s.Require().NoError(err)
+
+	var out authz.GrantsOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, bz))
+	s.Require().Len(out.Grants, 2)
+	for _, grant := range out.Grants {
+		s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+	}
+
+	method = s.precompile.Methods[authz.GranteeGrantsMethod]
+	bz, err = s.precompile.GranteeGrants(ctx, &method, []interface{}{s.keyring.GetAddr(1), query.PageRequest{}})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GranteeGrantsMethod, bz))
+	s.Require().Len(out.Grants, 2)
+	for _, grant := range out.Grants {
+		s.Require().Equal(s.keyring.GetAddr(1), grant.Grantee)
+	}
+}
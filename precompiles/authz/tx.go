@@ -0,0 +1,161 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant
+	// transaction with a generic authorization.
+	GrantMethod = "grant"
+	// GrantSendMethod defines the ABI method name for the authz Grant
+	// transaction with a bank send authorization.
+	GrantSendMethod = "grantSend"
+	// RevokeMethod defines the ABI method name for the authz Revoke
+	// transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec
+	// transaction.
+	ExecMethod = "exec"
+)
+
+// Grant grants a generic authorization for the given message type URL from
+// the caller to the grantee.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgGrant(granter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, stateDB, method, granter, grantee, msg)
+}
+
+// GrantSend grants a bank send authorization with the given spend limit and
+// allow list from the caller to the grantee.
+func (p Precompile) GrantSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgGrantSend(method, granter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, stateDB, method, granter, grantee, msg)
+}
+
+// grant is a common function that saves the grant of the given MsgGrant
+// through the authz message server and emits the Grant event.
+func (p Precompile) grant(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	granter, grantee common.Address,
+	msg *authz.MsgGrant,
+) ([]byte, error) {
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.authzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	var expiration int64
+	if msg.Grant.Expiration != nil {
+		expiration = msg.Grant.Expiration.Unix()
+	}
+
+	if err := p.EmitGrantEvent(ctx, stateDB, granter, grantee, authorization.MsgTypeURL(), expiration); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke revokes the authorization for the given message type URL granted by
+// the caller to the grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	msg, grantee, err := NewMsgRevoke(granter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.authzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRevokeEvent(ctx, stateDB, granter, grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes the given Cosmos messages on behalf of their signers with the
+// caller as grantee. The messages signed by the caller are executed without
+// an authorization.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee := contract.CallerAddress
+	msg, err := NewMsgExec(p.codec, method, grantee, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the events of the executed messages are captured to mirror the
+	// balance changes of the EVM denom in the stateDB
+	eventManager := sdk.NewEventManager()
+	res, err := p.authzKeeper.Exec(ctx.WithEventManager(eventManager), msg)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(eventManager.Events())
+
+	if err := p.SetBalanceChangeEntriesFromEvents(eventManager.Events()); err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs := make([]string, len(msg.Msgs))
+	for i, anyMsg := range msg.Msgs {
+		msgTypeURLs[i] = anyMsg.TypeUrl
+	}
+
+	if err := p.EmitExecEvent(ctx, stateDB, grantee, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
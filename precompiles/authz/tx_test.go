@@ -0,0 +1,296 @@
+package authz_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var sendMsgURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[authz.GrantMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - empty grantee",
+			func() []interface{} {
+				return []interface{}{common.Address{}, sendMsgURL, int64(0)}
+			},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), sendMsgURL, int64(-1)}
+			},
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), sendMsgURL, int64(1)}
+			},
+			true,
+			"expiration must be after the current block time",
+		},
+		{
+			"fail - unknown message type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), "/cosmos.bank.v1beta1.MsgUnknown", int64(0)}
+			},
+			true,
+			"doesn't exist",
+		},
+		{
+			"fail - grant to itself",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), sendMsgURL, int64(0)}
+			},
+			true,
+			"grantee and granter should be different",
+		},
+		{
+			"success - generic grant without expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), sendMsgURL, int64(0)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			_, err := s.precompile.Grant(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			authorization, expiration := s.network.App.AuthzKeeper.GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgURL)
+			s.Require().NotNil(authorization)
+			s.Require().Nil(expiration)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[authz.EventTypeGrant].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantSend() {
+	method := s.precompile.Methods[authz.GrantSendMethod]
+
+	s.SetupTest()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+	expiration := ctx.BlockTime().Add(time24h).Unix()
+	args := []interface{}{
+		s.keyring.GetAddr(1),
+		[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+		[]common.Address{s.keyring.GetAddr(2)},
+		expiration,
+	}
+	_, err := s.precompile.GrantSend(ctx, contract, s.network.GetStateDB(), &method, args)
+	s.Require().NoError(err)
+
+	authorization, exp := s.network.App.AuthzKeeper.GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgURL)
+	s.Require().NotNil(exp)
+	s.Require().Equal(expiration, exp.Unix())
+	sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+	s.Require().True(ok)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(100))), sendAuthz.SpendLimit)
+	s.Require().Equal([]string{s.keyring.GetAccAddr(2).String()}, sendAuthz.AllowList)
+
+	// an empty spend limit is not a valid send authorization
+	args[1] = []cmn.Coin{}
+	_, err = s.precompile.GrantSend(ctx, contract, s.network.GetStateDB(), &method, args)
+	s.Require().ErrorContains(err, "spend limit cannot be nil")
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authz.RevokeMethod]
+
+	s.SetupTest()
+	stateDB := s.network.GetStateDB()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+	args := []interface{}{s.keyring.GetAddr(1), sendMsgURL}
+	_, err := s.precompile.Revoke(ctx, contract, stateDB, &method, args)
+	s.Require().ErrorContains(err, "authorization not found")
+
+	s.grant(ctx, 0, 1, sendMsgURL)
+
+	_, err = s.precompile.Revoke(ctx, contract, stateDB, &method, args)
+	s.Require().NoError(err)
+
+	authorization, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgURL)
+	s.Require().Nil(authorization)
+
+	logs := stateDB.Logs()
+	s.Require().Len(logs, 1)
+	s.Require().Equal(s.precompile.ABI.Events[authz.EventTypeRevoke].ID, logs[0].Topics[0])
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.ExecMethod]
+	amount := math.NewInt(1000)
+
+	sendMsg := func(from, to int) cmn.CosmosMsg {
+		msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(from), s.keyring.GetAccAddr(to), sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount)))
+		bz, err := s.network.App.AppCodec().Marshal(msg)
+		s.Require().NoError(err)
+		return cmn.CosmosMsg{TypeUrl: sendMsgURL, Value: bz}
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []cmn.CosmosMsg
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - no messages",
+			func() []cmn.CosmosMsg {
+				return []cmn.CosmosMsg{}
+			},
+			true,
+			"no messages to execute",
+		},
+		{
+			"fail - disallowed message: eth tx",
+			func() []cmn.CosmosMsg {
+				return []cmn.CosmosMsg{{TypeUrl: sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})}}
+			},
+			true,
+			"cannot be executed through the authz precompile",
+		},
+		{
+			"fail - disallowed message: ERC-20 conversion",
+			func() []cmn.CosmosMsg {
+				return []cmn.CosmosMsg{{TypeUrl: sdk.MsgTypeURL(&erc20types.MsgConvertERC20{})}}
+			},
+			true,
+			"cannot be executed through the authz precompile",
+		},
+		{
+			"fail - disallowed message: ICS-20 transfer",
+			func() []cmn.CosmosMsg {
+				return []cmn.CosmosMsg{sendMsg(0, 2), {TypeUrl: sdk.MsgTypeURL(&transfertypes.MsgTransfer{})}}
+			},
+			true,
+			"cannot be executed through the authz precompile",
+		},
+		{
+			"fail - disallowed message: nested exec",
+			func() []cmn.CosmosMsg {
+				return []cmn.CosmosMsg{{TypeUrl: sdk.MsgTypeURL(&sdkauthz.MsgExec{})}}
+			},
+			true,
+			"cannot be executed through the authz precompile",
+		},
+		{
+			"fail - message not in the allowlist",
+			func() []cmn.CosmosMsg {
+				msg := banktypes.NewMsgMultiSend(
+					banktypes.NewInput(s.keyring.GetAccAddr(0), sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount))),
+					[]banktypes.Output{banktypes.NewOutput(s.keyring.GetAccAddr(2), sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount)))},
+				)
+				bz, err := s.network.App.AppCodec().Marshal(msg)
+				s.Require().NoError(err)
+				s.grant(ctx, 0, 1, sdk.MsgTypeURL(msg))
+				return []cmn.CosmosMsg{{TypeUrl: sdk.MsgTypeURL(msg), Value: bz}}
+			},
+			true,
+			"cannot be executed through the authz precompile",
+		},
+		{
+			"fail - invalid message bytes",
+			func() []cmn.CosmosMsg {
+				return []cmn.CosmosMsg{{TypeUrl: sendMsgURL, Value: []byte{0xff}}}
+			},
+			true,
+			"invalid messages",
+		},
+		{
+			"fail - no authorization",
+			func() []cmn.CosmosMsg {
+				return []cmn.CosmosMsg{sendMsg(0, 2)}
+			},
+			true,
+			sdkauthz.ErrNoAuthorizationFound.Error(),
+		},
+		{
+			"success - execute granted send",
+			func() []cmn.CosmosMsg {
+				s.grant(ctx, 0, 1, sendMsgURL)
+				return []cmn.CosmosMsg{sendMsg(0, 2)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stateDB := s.network.GetStateDB()
+
+			msgs := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), s.precompile, 200000)
+			prevBalance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(2), s.network.GetBaseDenom())
+
+			_, err := s.precompile.Exec(ctx, contract, stateDB, &method, []interface{}{msgs})
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			balance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(2), s.network.GetBaseDenom())
+			s.Require().Equal(prevBalance.Amount.Add(amount), balance.Amount)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[authz.EventTypeExec].ID, logs[0].Topics[0])
+		})
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+// allowedExecMsgs defines the messages that can be executed through the exec
+// method. Any other message is rejected, so that the messages that re-enter
+// the EVM (e.g. MsgEthereumTx, MsgConvertERC20 or MsgTransfer of an ERC-20
+// token) or nest the authz execution (MsgExec) cannot be executed.
+var allowedExecMsgs = map[string]struct{}{
+	// bank
+	sdk.MsgTypeURL(&banktypes.MsgSend{}): {},
+	// staking
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):                  {},
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):                {},
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):           {},
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}): {},
+	// distribution
+	sdk.MsgTypeURL(&distributiontypes.MsgSetWithdrawAddress{}):          {},
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}):     {},
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawValidatorCommission{}): {},
+	sdk.MsgTypeURL(&distributiontypes.MsgFundCommunityPool{}):           {},
+	// gov
+	sdk.MsgTypeURL(&govv1.MsgVote{}):         {},
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}): {},
+}
+
+// GrantInfo defines the information of an authz grant returned by the
+// grants queries. The spend limit and allow list are only populated for the
+// send authorizations.
+type GrantInfo struct {
+	Granter           common.Address
+	Grantee           common.Address
+	AuthorizationType string
+	MsgTypeUrl        string //nolint:revive,stylecheck
+	SpendLimit        []cmn.Coin
+	AllowList         []common.Address
+	Expiration        int64
+}
+
+// GrantSendInput defines the input of the grantSend transaction.
+type GrantSendInput struct {
+	Grantee    common.Address
+	SpendLimit []cmn.Coin
+	AllowList  []common.Address
+	Expiration int64
+}
+
+// GrantsInput defines the input of the grants query.
+type GrantsInput struct {
+	Granter     common.Address
+	Grantee     common.Address
+	MsgTypeUrl  string //nolint:revive,stylecheck
+	PageRequest query.PageRequest
+}
+
+// GranterGrantsInput defines the input of the granterGrants query.
+type GranterGrantsInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// GranteeGrantsInput defines the input of the granteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// GrantsOutput defines the output of the grants queries.
+type GrantsOutput struct {
+	Grants       []GrantInfo
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrant creates a new MsgGrant with a generic authorization from the
+// granter to the grantee given in the call arguments.
+func NewMsgGrant(granter common.Address, args []interface{}) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgTypeURL, ok := args[1].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[1])
+	}
+
+	expiration, err := parseExpiration(args[2])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := authz.NewMsgGrant(granter.Bytes(), grantee.Bytes(), authz.NewGenericAuthorization(msgTypeURL), expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, grantee, nil
+}
+
+// NewMsgGrantSend creates a new MsgGrant with a bank send authorization from
+// the granter to the grantee given in the call arguments.
+func NewMsgGrantSend(method *abi.Method, granter common.Address, args []interface{}) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantSendInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	spendLimit := make(sdk.Coins, len(input.SpendLimit))
+	for i, coin := range input.SpendLimit {
+		if coin.Amount == nil {
+			return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+		}
+		// NOTE: sdk.NewCoin panics on invalid coins, they are validated by the authorization
+		spendLimit[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	allowList := make([]sdk.AccAddress, len(input.AllowList))
+	for i, addr := range input.AllowList {
+		allowList[i] = addr.Bytes()
+	}
+
+	expiration, err := parseExpiration(input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	authorization := banktypes.NewSendAuthorization(spendLimit.Sort(), allowList)
+	msg, err := authz.NewMsgGrant(granter.Bytes(), input.Grantee.Bytes(), authorization, expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke from the granter for the grantee and
+// message type URL given in the call arguments.
+func NewMsgRevoke(granter common.Address, args []interface{}) (*authz.MsgRevoke, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgTypeURL, ok := args[1].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[1])
+	}
+
+	msg := authz.NewMsgRevoke(granter.Bytes(), grantee.Bytes(), msgTypeURL)
+	return &msg, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec for the grantee from the protobuf encoded
+// messages given in the call arguments.
+func NewMsgExec(cdc codec.Codec, method *abi.Method, grantee common.Address, args []interface{}) (*authz.MsgExec, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var cosmosMsgs []cmn.CosmosMsg
+	if err := method.Inputs.Copy(&cosmosMsgs, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to CosmosMsg struct: %s", err)
+	}
+
+	if len(cosmosMsgs) == 0 {
+		return nil, errors.New(ErrNoMsgs)
+	}
+
+	msgs := make([]sdk.Msg, len(cosmosMsgs))
+	for i, cosmosMsg := range cosmosMsgs {
+		if _, found := allowedExecMsgs[cosmosMsg.TypeUrl]; !found {
+			return nil, fmt.Errorf(ErrMsgNotAllowed, cosmosMsg.TypeUrl)
+		}
+
+		var msg sdk.Msg
+		anyMsg := &codectypes.Any{TypeUrl: cosmosMsg.TypeUrl, Value: cosmosMsg.Value}
+		if err := cdc.UnpackAny(anyMsg, &msg); err != nil {
+			return nil, fmt.Errorf(ErrInvalidMsgs, err)
+		}
+		msgs[i] = msg
+	}
+
+	msg := authz.NewMsgExec(grantee.Bytes(), msgs)
+	return &msg, nil
+}
+
+// NewGrantsRequest creates a new QueryGrantsRequest from the call arguments.
+func NewGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &authz.QueryGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// NewGranterGrantsRequest creates a new QueryGranterGrantsRequest from the call arguments.
+func NewGranterGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// NewGranteeGrantsRequest creates a new QueryGranteeGrantsRequest from the call arguments.
+func NewGranteeGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// FromGrants populates the GrantsOutput from the grants between the given
+// granter and grantee.
+func (o *GrantsOutput) FromGrants(cdc codec.Codec, granter, grantee common.Address, grants []*authz.Grant, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]GrantInfo, len(grants))
+	for i, grant := range grants {
+		info, err := newGrantInfo(cdc, granter, grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = info
+	}
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the grants of a
+// granter or a grantee.
+func (o *GrantsOutput) FromGrantAuthorizations(cdc codec.Codec, grants []*authz.GrantAuthorization, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]GrantInfo, len(grants))
+	for i, grant := range grants {
+		granter, err := sdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			return nil, err
+		}
+		grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+		if err != nil {
+			return nil, err
+		}
+		info, err := newGrantInfo(cdc, common.BytesToAddress(granter), common.BytesToAddress(grantee), grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = info
+	}
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (o *GrantsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(o.Grants, o.PageResponse)
+}
+
+// setPageResponse sets the page response of the output.
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse.Total = pageRes.Total
+		o.PageResponse.NextKey = pageRes.NextKey
+	}
+}
+
+// newGrantInfo creates a new GrantInfo from the given authorization and expiration.
+func newGrantInfo(cdc codec.Codec, granter, grantee common.Address, authzAny *codectypes.Any, expiration *time.Time) (GrantInfo, error) {
+	var authorization authz.Authorization
+	if err := cdc.UnpackAny(authzAny, &authorization); err != nil {
+		return GrantInfo{}, err
+	}
+
+	info := GrantInfo{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: authzAny.TypeUrl,
+		MsgTypeUrl:        authorization.MsgTypeURL(),
+		SpendLimit:        []cmn.Coin{},
+		AllowList:         []common.Address{},
+	}
+
+	if sendAuthz, ok := authorization.(*banktypes.SendAuthorization); ok {
+		info.SpendLimit = cmn.NewCoinsResponse(sendAuthz.SpendLimit)
+		for _, addr := range sendAuthz.AllowList {
+			accAddr, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				return GrantInfo{}, err
+			}
+			info.AllowList = append(info.AllowList, common.BytesToAddress(accAddr))
+		}
+	}
+
+	if expiration != nil {
+		info.Expiration = expiration.Unix()
+	}
+
+	return info, nil
+}
+
+// parseExpiration parses the expiration unix timestamp argument, where zero
+// means that the grant doesn't expire.
+func parseExpiration(arg interface{}) (*time.Time, error) {
+	expiration, ok := arg.(int64)
+	if !ok || expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, arg)
+	}
+	if expiration == 0 {
+		return nil, nil
+	}
+	expirationTime := time.Unix(expiration, 0).UTC()
+	return &expirationTime, nil
+}
+
+// parsePageRequest returns the page request to use in the grants queries.
+func parsePageRequest(pageRequest query.PageRequest) *query.PageRequest {
+	if bytes.Equal(pageRequest.Key, []byte{0}) {
+		pageRequest.Key = nil
+	}
+	return &pageRequest
+}
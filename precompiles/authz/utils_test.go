@@ -0,0 +1,24 @@
+package authz_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const time24h = 24 * time.Hour
+
+// grant is a helper function to give a generic authorization for the given
+// message type URL between two accounts of the keyring.
+func (s *PrecompileTestSuite) grant(ctx sdk.Context, granter, grantee int, msgTypeURL string) {
+	expiration := ctx.BlockTime().Add(time24h)
+	err := s.network.App.AuthzKeeper.SaveGrant(
+		ctx,
+		s.keyring.GetAccAddr(grantee),
+		s.keyring.GetAccAddr(granter),
+		sdkauthz.NewGenericAuthorization(msgTypeURL),
+		&expiration,
+	)
+	s.Require().NoError(err)
+}
@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,57 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Input specifies the sender and the native coins sent in a multiSend.
+struct Input {
+    /// addr defines the sender address.
+    address addr;
+    /// coins defines the native coins sent.
+    Coin[] coins;
+}
+
+/// @dev Output specifies the recipient and the native coins received in a multiSend.
+struct Output {
+    /// addr defines the recipient address.
+    address addr;
+    /// coins defines the native coins received.
+    Coin[] coins;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module
+ * and for sending native coins.
  */
 interface IBank {
+    /// @dev Transfer defines an Event emitted for each native coin with a registered
+    /// ERC-20 token pair that is sent through the send and multiSend methods.
+    /// The event is emitted with the address of the ERC-20 token contract.
+    /// @param from the address of the sender
+    /// @param to the address of the recipient
+    /// @param value the amount of tokens sent
+    event Transfer(address indexed from, address indexed to, uint256 value);
+
+    /// @dev send defines a method for sending native coins from the caller
+    /// to the given address.
+    /// @param to the address of the recipient
+    /// @param amount the native coins to send
+    /// @return success true if the coins were sent successfully
+    function send(
+        address to,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for sending native coins from the caller
+    /// to several recipients. The caller must be the only input.
+    /// @param inputs the sender and the total of the native coins to send
+    /// @param outputs the recipients and the native coins each of them receives
+    /// @return success true if the coins were sent successfully
+    function multiSend(
+        Input[] calldata inputs,
+        Output[] calldata outputs
+    ) external returns (bool success);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
@@ -0,0 +1,17 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+const (
+	// ErrInvalidRecipient is raised when the recipient address is not valid.
+	ErrInvalidRecipient = "invalid recipient address: %v"
+	// ErrDifferentSender is raised when the input address of a multiSend is not the caller.
+	ErrDifferentSender = "caller address %s does not match the input address %s"
+	// ErrInvalidInputs is raised when a multiSend doesn't have exactly one input.
+	ErrInvalidInputs = "multiSend requires exactly one input, got %d"
+	// ErrInvalidOutputs is raised when a multiSend has no outputs.
+	ErrInvalidOutputs = "multiSend requires at least one output"
+	// ErrERC20NativeCoin is raised when sending the coin representation of a native ERC-20 token.
+	ErrERC20NativeCoin = "coin %s is the representation of the native ERC-20 token %s and must be sent through its contract"
+)
@@ -0,0 +1,66 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeTransfer defines the event type for the ERC-20 Transfer event
+	// emitted on send and multiSend transactions.
+	EventTypeTransfer = "Transfer"
+)
+
+// EmitTransferEvents creates a new ERC-20 Transfer event for each of the sent
+// coins that has a registered token pair. The events are emitted with the
+// address of the ERC-20 token contract, so the transfers are tracked as any
+// other ERC-20 transfer.
+func (p Precompile) EmitTransferEvents(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, coins sdk.Coins) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeTransfer]
+
+	for _, coin := range coins {
+		tokenPair, found := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetTokenPairID(ctx, coin.Denom))
+		if !found {
+			continue
+		}
+
+		topics := make([]common.Hash, 3)
+
+		// The first topic is always the signature of the event.
+		topics[0] = event.ID
+
+		var err error
+		topics[1], err = cmn.MakeTopic(from)
+		if err != nil {
+			return err
+		}
+
+		topics[2], err = cmn.MakeTopic(to)
+		if err != nil {
+			return err
+		}
+
+		arguments := abi.Arguments{event.Inputs[2]}
+		packed, err := arguments.Pack(coin.Amount.BigInt())
+		if err != nil {
+			return err
+		}
+
+		stateDB.AddLog(&ethtypes.Log{
+			Address:     tokenPair.GetERC20Contract(),
+			Topics:      topics,
+			Data:        packed,
+			BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send sends the given native coins from the caller to the given address.
+// The x/bank send-enabled flags and blocked addresses are enforced by the
+// bank message server.
+func (p *Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender := contract.CallerAddress
+	msg, to, err := NewMsgSend(method, sender, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkERC20NativeCoins(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err := msgSrv.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if amount := msg.Amount.AmountOf(evmtypes.GetEVMCoinDenom()); amount.IsPositive() {
+		// add the entries to the statedb journal in 18 decimals
+		convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt())
+		p.SetBalanceChangeEntries(
+			cmn.NewBalanceChangeEntry(sender, convertedAmount, cmn.Sub),
+			cmn.NewBalanceChangeEntry(to, convertedAmount, cmn.Add),
+		)
+	}
+
+	if err := p.EmitTransferEvents(ctx, stateDB, sender, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend sends the given native coins from the caller to several
+// recipients. The caller must be the only input of the transaction.
+// The x/bank send-enabled flags and blocked addresses are enforced by the
+// bank message server.
+func (p *Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender := contract.CallerAddress
+	msg, err := NewMsgMultiSend(method, sender, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkERC20NativeCoins(ctx, msg.Inputs[0].Coins); err != nil {
+		return nil, err
+	}
+
+	// NOTE: we already charged for a single send so we only charge for the
+	// additional outputs
+	ctx.GasMeter().ConsumeGas(GasSend*uint64(len(msg.Outputs)-1), "bank multiSend outputs")
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err := msgSrv.MultiSend(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// add the entries to the statedb journal in 18 decimals
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	if amount := msg.Inputs[0].Coins.AmountOf(evmDenom); amount.IsPositive() {
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(sender, evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt()), cmn.Sub))
+	}
+
+	for _, output := range msg.Outputs {
+		to := common.BytesToAddress(sdk.MustAccAddressFromBech32(output.Address))
+		if amount := output.Coins.AmountOf(evmDenom); amount.IsPositive() {
+			p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(to, evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt()), cmn.Add))
+		}
+		if err := p.EmitTransferEvents(ctx, stateDB, sender, to, output.Coins); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// checkERC20NativeCoins returns an error if any of the given coins is the
+// bank representation of a native ERC-20 token. The balances of those tokens
+// are owned by their ERC-20 contracts, so they can't be moved by the bank
+// precompile.
+func (p Precompile) checkERC20NativeCoins(ctx sdk.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		tokenPair, found := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetTokenPairID(ctx, coin.Denom))
+		if found && tokenPair.IsNativeERC20() {
+			return fmt.Errorf(ErrERC20NativeCoin, coin.Denom, tokenPair.Erc20Address)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package bank_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/bank"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	evmosutiltx "github.com/evmos/evmos/v20/testutil/tx"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (s *PrecompileTestSuite) TestSend() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[bank.SendMethod]
+	receiver := evmosutiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{receiver}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - empty recipient",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					[]cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}},
+				}
+			},
+			false,
+			"invalid recipient address",
+		},
+		{
+			"fail - no coins",
+			func() []interface{} {
+				return []interface{}{receiver, []cmn.Coin{}}
+			},
+			false,
+			"invalid amount",
+		},
+		{
+			"fail - send disabled for the denom",
+			func() []interface{} {
+				s.network.App.BankKeeper.SetSendEnabled(ctx, s.tokenDenom, false)
+				return []interface{}{
+					receiver,
+					[]cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}},
+				}
+			},
+			false,
+			"transfers are currently disabled",
+		},
+		{
+			"fail - blocked recipient",
+			func() []interface{} {
+				return []interface{}{
+					common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName)),
+					[]cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}},
+				}
+			},
+			false,
+			"is not allowed to receive funds",
+		},
+		{
+			"fail - native ERC-20 token",
+			func() []interface{} {
+				erc20Addr := evmosutiltx.GenerateAddress()
+				tokenPair := erc20types.NewTokenPair(erc20Addr, erc20types.CreateDenom(erc20Addr.String()), erc20types.OWNER_EXTERNAL)
+				s.network.App.Erc20Keeper.SetTokenPair(ctx, tokenPair)
+				s.network.App.Erc20Keeper.SetDenomMap(ctx, tokenPair.Denom, tokenPair.GetID())
+				return []interface{}{
+					receiver,
+					[]cmn.Coin{{Denom: tokenPair.Denom, Amount: big.NewInt(1)}},
+				}
+			},
+			false,
+			"must be sent through its contract",
+		},
+		{
+			"pass - send EVMOS and XMPL",
+			func() []interface{} {
+				return []interface{}{
+					receiver,
+					[]cmn.Coin{
+						{Denom: s.tokenDenom, Amount: big.NewInt(2)},
+						{Denom: s.bondDenom, Amount: big.NewInt(1)},
+					},
+				}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx = s.SetupTest() // reset the chain each test
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+			bz, err := s.precompile.Send(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().True(out[0].(bool))
+
+				balance := s.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), s.tokenDenom)
+				s.Require().Equal(math.NewInt(2), balance.Amount)
+				balance = s.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), s.bondDenom)
+				s.Require().Equal(math.NewInt(1), balance.Amount)
+
+				// a Transfer event is emitted for each coin with a token pair
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 2)
+				event := s.precompile.ABI.Events[bank.EventTypeTransfer]
+				logAddrs := []common.Address{logs[0].Address, logs[1].Address}
+				s.Require().ElementsMatch([]common.Address{s.evmosAddr, s.xmplAddr}, logAddrs)
+				s.Require().Equal(event.ID, logs[0].Topics[0])
+				s.Require().Equal(common.BytesToHash(s.keyring.GetAddr(0).Bytes()), logs[0].Topics[1])
+				s.Require().Equal(common.BytesToHash(receiver.Bytes()), logs[0].Topics[2])
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[bank.MultiSendMethod]
+	receiver1 := evmosutiltx.GenerateAddress()
+	receiver2 := evmosutiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - input is not the caller",
+			func() []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: s.keyring.GetAddr(1), Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}},
+					[]bank.Output{{Addr: receiver1, Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}},
+				}
+			},
+			false,
+			"does not match the input address",
+		},
+		{
+			"fail - multiple inputs",
+			func() []interface{} {
+				return []interface{}{
+					[]bank.Input{
+						{Addr: s.keyring.GetAddr(0), Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}},
+						{Addr: s.keyring.GetAddr(0), Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}},
+					},
+					[]bank.Output{{Addr: receiver1, Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(2)}}}},
+				}
+			},
+			false,
+			"exactly one input",
+		},
+		{
+			"fail - inputs and outputs mismatch",
+			func() []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: s.keyring.GetAddr(0), Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(2)}}}},
+					[]bank.Output{{Addr: receiver1, Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}},
+				}
+			},
+			false,
+			"sum inputs != sum outputs",
+		},
+		{
+			"pass - send to two recipients",
+			func() []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: s.keyring.GetAddr(0), Coins: []cmn.Coin{
+						{Denom: s.tokenDenom, Amount: big.NewInt(3)},
+						{Denom: s.bondDenom, Amount: big.NewInt(1)},
+					}}},
+					[]bank.Output{
+						{Addr: receiver1, Coins: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}},
+						{Addr: receiver2, Coins: []cmn.Coin{
+							{Denom: s.tokenDenom, Amount: big.NewInt(2)},
+							{Denom: s.bondDenom, Amount: big.NewInt(1)},
+						}},
+					},
+				}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx = s.SetupTest() // reset the chain each test
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+			_, err := s.precompile.MultiSend(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(1), s.network.App.BankKeeper.GetBalance(ctx, receiver1.Bytes(), s.tokenDenom).Amount)
+				s.Require().Equal(math.NewInt(2), s.network.App.BankKeeper.GetBalance(ctx, receiver2.Bytes(), s.tokenDenom).Amount)
+				s.Require().Equal(math.NewInt(1), s.network.App.BankKeeper.GetBalance(ctx, receiver2.Bytes(), s.bondDenom).Amount)
+				s.Require().Len(stateDB.Logs(), 3)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
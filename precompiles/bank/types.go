@@ -4,10 +4,14 @@
 package bank
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 )
@@ -45,3 +49,110 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// Input defines the sender and the native coins sent in a multiSend transaction.
+type Input struct {
+	Addr  common.Address
+	Coins []cmn.Coin
+}
+
+// Output defines the recipient and the native coins received in a multiSend transaction.
+type Output struct {
+	Addr  common.Address
+	Coins []cmn.Coin
+}
+
+// NewMsgSend creates a new bank MsgSend from the caller to the address given
+// in the call arguments.
+func NewMsgSend(method *abi.Method, sender common.Address, args []interface{}) (*banktypes.MsgSend, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok || to == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidRecipient, args[0])
+	}
+
+	var coins []cmn.Coin
+	if err := method.Inputs[1:].Copy(&coins, args[1:]); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to Coin struct: %s", err)
+	}
+
+	amount, err := toSDKCoins(coins)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return banktypes.NewMsgSend(sender.Bytes(), to.Bytes(), amount), to, nil
+}
+
+// NewMsgMultiSend creates a new bank MsgMultiSend from the call arguments.
+// The caller must be the only input of the transaction.
+func NewMsgMultiSend(method *abi.Method, sender common.Address, args []interface{}) (*banktypes.MsgMultiSend, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var inputs []Input
+	if err := method.Inputs[:1].Copy(&inputs, args[:1]); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to Input struct: %s", err)
+	}
+
+	var outputs []Output
+	if err := method.Inputs[1:].Copy(&outputs, args[1:]); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to Output struct: %s", err)
+	}
+
+	if len(inputs) != 1 {
+		return nil, fmt.Errorf(ErrInvalidInputs, len(inputs))
+	}
+	if inputs[0].Addr != sender {
+		return nil, fmt.Errorf(ErrDifferentSender, sender, inputs[0].Addr)
+	}
+	if len(outputs) == 0 {
+		return nil, errors.New(ErrInvalidOutputs)
+	}
+
+	inputCoins, err := toSDKCoins(inputs[0].Coins)
+	if err != nil {
+		return nil, err
+	}
+
+	bankOutputs := make([]banktypes.Output, len(outputs))
+	for i, output := range outputs {
+		if output.Addr == (common.Address{}) {
+			return nil, fmt.Errorf(ErrInvalidRecipient, output.Addr)
+		}
+		outputCoins, err := toSDKCoins(output.Coins)
+		if err != nil {
+			return nil, err
+		}
+		bankOutputs[i] = banktypes.NewOutput(output.Addr.Bytes(), outputCoins)
+	}
+
+	return &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(sender.Bytes(), inputCoins)},
+		Outputs: bankOutputs,
+	}, nil
+}
+
+// toSDKCoins converts the given coins to a sorted and valid sdk.Coins.
+func toSDKCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+		}
+		// NOTE: sdk.NewCoin panics on invalid coins, they are validated below
+		sdkCoins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+	if sdkCoins.IsZero() {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, "no coins to send")
+	}
+	return sdkCoins, nil
+}
@@ -24,6 +24,13 @@ struct Coin {
     uint256 amount;
 }
 
+/// @dev CosmosMsg represents a Cosmos message encoded as protobuf bytes
+/// with its type URL (e.g. /cosmos.bank.v1beta1.MsgSend).
+struct CosmosMsg {
+    string typeUrl;
+    bytes value;
+}
+
 /// @dev DecCoin is a struct that represents a token with a denomination, an amount and a precision.
 struct DecCoin {
     string denom;
@@ -10,10 +10,12 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 // Precompile is a common struct for all precompiles that holds the common data each
@@ -204,6 +206,58 @@ func (p *Precompile) SetBalanceChangeEntries(entries ...balanceChangeEntry) {
 	p.journalEntries = entries
 }
 
+// AddBalanceChangeEntries appends the balanceChange entries
+// to the journalEntries field of the precompile.
+func (p *Precompile) AddBalanceChangeEntries(entries ...balanceChangeEntry) {
+	p.journalEntries = append(p.journalEntries, entries...)
+}
+
+// SetBalanceChangeEntriesFromEvents sets as the journalEntries field of the
+// precompile the balanceChange entries of the EVM denom built from the coin
+// spent and received events of the given events. It is used when the accounts
+// whose balance changed are only known after executing a Cosmos message.
+func (p *Precompile) SetBalanceChangeEntriesFromEvents(events sdk.Events) error {
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	entries := make([]balanceChangeEntry, 0, len(events))
+	for _, event := range events {
+		var (
+			addrKey string
+			op      Operation
+		)
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey, op = banktypes.AttributeKeySpender, Sub
+		case banktypes.EventTypeCoinReceived:
+			addrKey, op = banktypes.AttributeKeyReceiver, Add
+		default:
+			continue
+		}
+
+		var addr sdk.AccAddress
+		var coins sdk.Coins
+		for _, attr := range event.Attributes {
+			var err error
+			switch attr.Key {
+			case addrKey:
+				addr, err = sdk.AccAddressFromBech32(attr.Value)
+			case sdk.AttributeKeyAmount:
+				coins, err = sdk.ParseCoinsNormalized(attr.Value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if amount := coins.AmountOf(evmDenom); amount.IsPositive() && !addr.Empty() {
+			// add the entries to the statedb journal in 18 decimals
+			convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt())
+			entries = append(entries, NewBalanceChangeEntry(common.BytesToAddress(addr), convertedAmount, op))
+		}
+	}
+	p.SetBalanceChangeEntries(entries...)
+	return nil
+}
+
 func (p Precompile) Address() common.Address {
 	return p.address
 }
@@ -0,0 +1,86 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetBalanceChangeEntriesFromEvents(t *testing.T) {
+	const evmDenom = "aevmos"
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.WithEVMCoinInfo(evmDenom, uint8(evmtypes.EighteenDecimals)).Configure())
+
+	spender := sdk.AccAddress(common.BigToAddress(big.NewInt(1)).Bytes())
+	receiver1 := sdk.AccAddress(common.BigToAddress(big.NewInt(2)).Bytes())
+	receiver2 := sdk.AccAddress(common.BigToAddress(big.NewInt(3)).Bytes())
+	evmCoins := sdk.NewCoins(sdk.NewCoin(evmDenom, math.NewInt(10)))
+	otherCoins := sdk.NewCoins(sdk.NewCoin("other", math.NewInt(10)))
+
+	testCases := []struct {
+		name       string
+		events     sdk.Events
+		expEntries []balanceChangeEntry
+		expErr     bool
+	}{
+		{
+			"no bank events",
+			sdk.Events{sdk.NewEvent("message")},
+			[]balanceChangeEntry{},
+			false,
+		},
+		{
+			"spent and received by several accounts",
+			sdk.Events{
+				banktypes.NewCoinSpentEvent(spender, evmCoins.Add(evmCoins...)),
+				banktypes.NewCoinReceivedEvent(receiver1, evmCoins),
+				banktypes.NewCoinReceivedEvent(receiver2, evmCoins),
+			},
+			[]balanceChangeEntry{
+				NewBalanceChangeEntry(common.BytesToAddress(spender), big.NewInt(20), Sub),
+				NewBalanceChangeEntry(common.BytesToAddress(receiver1), big.NewInt(10), Add),
+				NewBalanceChangeEntry(common.BytesToAddress(receiver2), big.NewInt(10), Add),
+			},
+			false,
+		},
+		{
+			"other denom is skipped",
+			sdk.Events{banktypes.NewCoinReceivedEvent(receiver1, otherCoins)},
+			[]balanceChangeEntry{},
+			false,
+		},
+		{
+			"invalid address",
+			sdk.Events{sdk.NewEvent(
+				banktypes.EventTypeCoinReceived,
+				sdk.NewAttribute(banktypes.AttributeKeyReceiver, "invalid"),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, evmCoins.String()),
+			)},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the previous entries are replaced
+			p := Precompile{journalEntries: []balanceChangeEntry{
+				NewBalanceChangeEntry(common.BytesToAddress(spender), big.NewInt(1), Add),
+			}}
+			err := p.SetBalanceChangeEntriesFromEvents(tc.events)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expEntries, p.journalEntries)
+		})
+	}
+}
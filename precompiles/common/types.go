@@ -40,6 +40,13 @@ type Coin struct {
 	Amount *big.Int
 }
 
+// CosmosMsg defines an ABI-encoded Cosmos message, where the value is the
+// protobuf encoding of the message with the given type URL.
+type CosmosMsg struct {
+	TypeUrl string //nolint:revive,stylecheck
+	Value   []byte
+}
+
 // DecCoin defines a struct that stores all needed information about a decimal coin
 // in types native to the EVM.
 type DecCoin struct {
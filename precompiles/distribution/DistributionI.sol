@@ -32,6 +32,14 @@ struct DelegationDelegatorReward {
     DecCoin[] reward;
 }
 
+/// @dev Params defines the parameters of the distribution module.
+struct Params {
+    Dec communityTax;
+    Dec baseProposerReward;
+    Dec bonusProposerReward;
+    bool withdrawAddrEnabled;
+}
+
 /// @author Evmos Team
 /// @title Distribution Precompile Contract
 /// @dev The interface through which solidity contracts will interact with Distribution
@@ -74,6 +82,19 @@ interface DistributionI {
     /// @param amount the amount being sent to the community pool
     event FundCommunityPool(address indexed depositor, uint256 amount);
 
+    /// @dev DepositValidatorRewardsPool defines an Event emitted when an account
+    /// deposits funds into the rewards pool of a validator
+    /// @param depositor the address depositing into the validator rewards pool
+    /// @param validatorAddress the address of the validator
+    /// @param denom the denomination of the deposited coin
+    /// @param amount the amount of the deposited coin
+    event DepositValidatorRewardsPool(
+        address indexed depositor,
+        address indexed validatorAddress,
+        string denom,
+        uint256 amount
+    );
+
     /// TRANSACTIONS
 
     /// @dev Claims all rewards from a select set of validators or all of them for a delegator.
@@ -121,6 +142,19 @@ interface DistributionI {
         uint256 amount
     ) external returns (bool success);
 
+    /// @dev depositValidatorRewardsPool defines a method to allow an account to
+    /// directly deposit funds into the rewards pool of a validator, which are
+    /// distributed to its delegators.
+    /// @param depositor The address of the depositor
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount of coins deposited into the validator rewards pool
+    /// @return success Whether the transaction was successful or not
+    function depositValidatorRewardsPool(
+        address depositor,
+        string memory validatorAddress,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
     /// QUERIES
     /// @dev Queries validator commission and self-delegation rewards for validator.
     /// @param validatorAddress The address of the validator
@@ -204,4 +238,12 @@ interface DistributionI {
     function delegatorWithdrawAddress(
         address delegatorAddress
     ) external view returns (string memory withdrawAddress);
+
+    /// @dev Queries the community pool coins.
+    /// @return coins The coins held by the community pool
+    function communityPool() external view returns (DecCoin[] calldata coins);
+
+    /// @dev Queries the distribution module parameters.
+    /// @return params The distribution module parameters
+    function params() external view returns (Params calldata params);
 }
@@ -104,6 +104,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.WithdrawValidatorCommission(ctx, evm.Origin, contract, stateDB, method, args)
 	case FundCommunityPoolMethod:
 		bz, err = p.FundCommunityPool(ctx, evm.Origin, contract, stateDB, method, args)
+	case DepositValidatorRewardsPoolMethod:
+		bz, err = p.DepositValidatorRewardsPool(ctx, evm.Origin, contract, stateDB, method, args)
 	// Distribution queries
 	case ValidatorDistributionInfoMethod:
 		bz, err = p.ValidatorDistributionInfo(ctx, contract, method, args)
@@ -121,6 +123,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.DelegatorValidators(ctx, contract, method, args)
 	case DelegatorWithdrawAddressMethod:
 		bz, err = p.DelegatorWithdrawAddress(ctx, contract, method, args)
+	case CommunityPoolMethod:
+		bz, err = p.CommunityPool(ctx, contract, method, args)
+	case ParamsMethod:
+		bz, err = p.Params(ctx, contract, method, args)
 	}
 
 	if err != nil {
@@ -147,13 +153,16 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - SetWithdrawAddress
 //   - WithdrawDelegatorRewards
 //   - WithdrawValidatorCommission
+//   - FundCommunityPool
+//   - DepositValidatorRewardsPool
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case ClaimRewardsMethod,
 		SetWithdrawAddressMethod,
 		WithdrawDelegatorRewardsMethod,
 		WithdrawValidatorCommissionMethod,
-		FundCommunityPoolMethod:
+		FundCommunityPoolMethod,
+		DepositValidatorRewardsPoolMethod:
 		return true
 	default:
 		return false
@@ -26,6 +26,8 @@ const (
 	EventTypeFundCommunityPool = "FundCommunityPool"
 	// EventTypeClaimRewards defines the event type for the distribution ClaimRewardsMethod transaction.
 	EventTypeClaimRewards = "ClaimRewards"
+	// EventTypeDepositValidatorRewardsPool defines the event type for the distribution DepositValidatorRewardsPoolMethod transaction.
+	EventTypeDepositValidatorRewardsPool = "DepositValidatorRewardsPool"
 )
 
 // EmitClaimRewardsEvent creates a new event emitted on a ClaimRewards transaction.
@@ -192,3 +194,47 @@ func (p Precompile) EmitFundCommunityPoolEvent(ctx sdk.Context, stateDB vm.State
 
 	return nil
 }
+
+// EmitDepositValidatorRewardsPoolEvent creates a new event for each coin emitted on a
+// DepositValidatorRewardsPool transaction.
+func (p Precompile) EmitDepositValidatorRewardsPoolEvent(ctx sdk.Context, stateDB vm.StateDB, depositor common.Address, validatorAddress string, coins sdk.Coins) error {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeDepositValidatorRewardsPool]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	topics[1], err = cmn.MakeTopic(depositor)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(common.BytesToAddress(valAddr.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	for _, coin := range coins {
+		packed, err := arguments.Pack(coin.Denom, coin.Amount.BigInt())
+		if err != nil {
+			return err
+		}
+
+		stateDB.AddLog(&ethtypes.Log{
+			Address:     p.Address(),
+			Topics:      topics,
+			Data:        packed,
+			BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+		})
+	}
+
+	return nil
+}
@@ -326,3 +326,63 @@ func (s *PrecompileTestSuite) TestFundCommunityPoolEvent() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDepositValidatorRewardsPoolEvent() {
+	var (
+		ctx  sdk.Context
+		stDB *statedb.StateDB
+	)
+	testCases := []struct {
+		name      string
+		coins     sdk.Coins
+		postCheck func(valAddr sdk.ValAddress)
+	}{
+		{
+			"success - an event is emitted for each deposited coin",
+			sdk.NewCoins(
+				sdk.NewCoin(s.baseDenom, math.NewInt(1e18)),
+				sdk.NewCoin("xmpl", math.NewInt(2e18)),
+			),
+			func(valAddr sdk.ValAddress) {
+				logs := stDB.Logs()
+				s.Require().Len(logs, 2)
+
+				event := s.precompile.ABI.Events[distribution.EventTypeDepositValidatorRewardsPool]
+				for i, expCoin := range []sdk.Coin{
+					sdk.NewCoin(s.baseDenom, math.NewInt(1e18)),
+					sdk.NewCoin("xmpl", math.NewInt(2e18)),
+				} {
+					log := logs[i]
+					s.Require().Equal(log.Address, s.precompile.Address())
+					// Check event signature matches the one emitted
+					s.Require().Equal(event.ID, common.HexToHash(log.Topics[0].Hex()))
+					s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+					var depositEvent distribution.EventDepositValidatorRewardsPool
+					err := cmn.UnpackLog(s.precompile.ABI, &depositEvent, distribution.EventTypeDepositValidatorRewardsPool, *log)
+					s.Require().NoError(err)
+					s.Require().Equal(s.keyring.GetAddr(0), depositEvent.Depositor)
+					s.Require().Equal(common.BytesToAddress(valAddr.Bytes()), depositEvent.ValidatorAddress)
+					s.Require().Equal(expCoin.Denom, depositEvent.Denom)
+					s.Require().Equal(expCoin.Amount.BigInt(), depositEvent.Amount)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB = s.network.GetStateDB()
+
+			operatorAddress := s.network.GetValidators()[0].OperatorAddress
+			valAddr, err := sdk.ValAddressFromBech32(operatorAddress)
+			s.Require().NoError(err)
+
+			err = s.precompile.EmitDepositValidatorRewardsPoolEvent(ctx, stDB, s.keyring.GetAddr(0), operatorAddress, tc.coins)
+			s.Require().NoError(err)
+			tc.postCheck(valAddr)
+		})
+	}
+}
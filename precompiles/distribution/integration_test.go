@@ -639,6 +639,84 @@ var _ = Describe("Calling distribution precompile from EOA", func() {
 			Expect(finalBalance.Amount).To(Equal(expBalanceAmt), "expected final balance to be equal to initial balance + rewards - fees")
 		})
 	})
+
+	Describe("Execute DepositValidatorRewardsPool transaction", func() {
+		var (
+			valAddr     string
+			depositAmt  = math.NewInt(1e18)
+			depositArgs []cmn.Coin
+		)
+
+		BeforeEach(func() {
+			// set the default call arguments
+			callArgs.MethodName = distribution.DepositValidatorRewardsPoolMethod
+			valAddr = s.network.GetValidators()[0].OperatorAddress
+			depositArgs = []cmn.Coin{{Denom: s.bondDenom, Amount: depositAmt.BigInt()}}
+		})
+
+		It("should return err if the origin is different than the depositor", func() {
+			callArgs.Args = []interface{}{
+				differentAddr, valAddr, depositArgs,
+			}
+
+			depositCheck := defaultLogCheck.WithErrContains(cmn.ErrSpenderDifferentOrigin, s.keyring.GetAddr(0).String(), differentAddr.String())
+
+			_, _, err := s.factory.CallContractAndCheckLogs(
+				s.keyring.GetPrivKey(0),
+				txArgs,
+				callArgs,
+				depositCheck,
+			)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+		})
+
+		It("should deposit into the validator rewards pool", func() {
+			queryRes, err := s.grpcHandler.GetBalanceFromBank(s.keyring.GetAccAddr(0), s.bondDenom)
+			Expect(err).To(BeNil(), "error while calling GetBalance")
+			initialBalance := queryRes.Balance
+
+			rewardsRes, err := s.grpcHandler.GetValidatorOutstandingRewards(valAddr)
+			Expect(err).To(BeNil(), "error while calling GetValidatorOutstandingRewards")
+			initialRewards := rewardsRes.Rewards.Rewards.AmountOf(s.bondDenom)
+
+			callArgs.Args = []interface{}{
+				s.keyring.GetAddr(0), valAddr, depositArgs,
+			}
+
+			// get base fee to use in tx to then calculate fee paid
+			bfQuery, err := s.grpcHandler.GetEvmBaseFee()
+			Expect(err).To(BeNil(), "error while calling BaseFee")
+			gasPrice := bfQuery.BaseFee.BigInt()
+			txArgs.GasPrice = gasPrice
+
+			depositCheck := passCheck.WithExpEvents(distribution.EventTypeDepositValidatorRewardsPool)
+
+			txRes, _, err := s.factory.CallContractAndCheckLogs(
+				s.keyring.GetPrivKey(0),
+				txArgs,
+				callArgs,
+				depositCheck,
+			)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+
+			// persist state change
+			Expect(s.network.NextBlock()).To(BeNil(), "error on NextBlock")
+
+			// check that the deposit was deducted from the balance
+			queryRes, err = s.grpcHandler.GetBalanceFromBank(s.keyring.GetAccAddr(0), s.bondDenom)
+			Expect(err).To(BeNil(), "error while calling GetBalance")
+
+			fee := gasPrice.Mul(math.NewInt(txRes.GasUsed).BigInt(), gasPrice)
+			expBalanceAmt := initialBalance.Amount.Sub(depositAmt).Sub(math.NewIntFromBigInt(fee))
+			Expect(queryRes.Balance.Amount).To(Equal(expBalanceAmt), "expected final balance to be equal to initial balance - deposit - fees")
+
+			// check that the deposit was added to the validator outstanding rewards
+			rewardsRes, err = s.grpcHandler.GetValidatorOutstandingRewards(valAddr)
+			Expect(err).To(BeNil(), "error while calling GetValidatorOutstandingRewards")
+			finalRewards := rewardsRes.Rewards.Rewards.AmountOf(s.bondDenom)
+			Expect(finalRewards.GTE(initialRewards.Add(math.LegacyNewDecFromInt(depositAmt)))).To(BeTrue(), "expected outstanding rewards to include the deposit")
+		})
+	})
 	// =====================================
 	// 				QUERIES
 	// =====================================
@@ -946,6 +1024,49 @@ var _ = Describe("Calling distribution precompile from EOA", func() {
 			expAddr := s.keyring.GetAccAddr(0)
 			Expect(withdrawAddr[0]).To(Equal(expAddr.String()))
 		})
+
+		It("should get the community pool coins - communityPool query", func() {
+			callArgs.MethodName = distribution.CommunityPoolMethod
+			callArgs.Args = []interface{}{}
+
+			_, ethRes, err := s.factory.CallContractAndCheckLogs(
+				s.keyring.GetPrivKey(0),
+				txArgs,
+				callArgs,
+				passCheck,
+			)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+
+			var coins []cmn.DecCoin
+			err = s.precompile.UnpackIntoInterface(&coins, distribution.CommunityPoolMethod, ethRes.Ret)
+			Expect(err).To(BeNil())
+
+			res, err := s.grpcHandler.GetCommunityPool()
+			Expect(err).To(BeNil())
+			Expect(coins).To(Equal(cmn.NewDecCoinsResponse(res.Pool)))
+		})
+
+		It("should get the distribution params - params query", func() {
+			callArgs.MethodName = distribution.ParamsMethod
+			callArgs.Args = []interface{}{}
+
+			_, ethRes, err := s.factory.CallContractAndCheckLogs(
+				s.keyring.GetPrivKey(0),
+				txArgs,
+				callArgs,
+				passCheck,
+			)
+			Expect(err).To(BeNil(), "error while calling the precompile")
+
+			var out distribution.ParamsOutput
+			err = s.precompile.UnpackIntoInterface(&out, distribution.ParamsMethod, ethRes.Ret)
+			Expect(err).To(BeNil())
+
+			params, err := s.network.App.DistrKeeper.Params.Get(s.network.GetContext())
+			Expect(err).To(BeNil())
+			Expect(out.Params.CommunityTax.Value.Cmp(params.CommunityTax.BigInt())).To(BeZero())
+			Expect(out.Params.WithdrawAddrEnabled).To(Equal(params.WithdrawAddrEnabled))
+		})
 	})
 })
 
@@ -4,8 +4,11 @@
 package distribution
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
@@ -36,6 +39,12 @@ const (
 	// DelegatorWithdrawAddressMethod defines the ABI method name for the
 	// DelegatorWithdrawAddress query.
 	DelegatorWithdrawAddressMethod = "delegatorWithdrawAddress"
+	// CommunityPoolMethod defines the ABI method name for the
+	// CommunityPool query.
+	CommunityPoolMethod = "communityPool"
+	// ParamsMethod defines the ABI method name for the
+	// distribution Params query.
+	ParamsMethod = "params"
 )
 
 // ValidatorDistributionInfo returns the distribution info for a validator.
@@ -218,3 +227,46 @@ func (p Precompile) DelegatorWithdrawAddress(
 
 	return method.Outputs.Pack(res.WithdrawAddress)
 }
+
+// CommunityPool returns the coins held by the community pool.
+func (p Precompile) CommunityPool(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	querier := distributionkeeper.Querier{Keeper: p.distributionKeeper}
+
+	res, err := querier.CommunityPool(ctx, &distributiontypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewDecCoinsResponse(res.Pool))
+}
+
+// Params returns the distribution module parameters.
+func (p Precompile) Params(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	querier := distributionkeeper.Querier{Keeper: p.distributionKeeper}
+
+	res, err := querier.Params(ctx, &distributiontypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(ParamsOutput).FromResponse(res)
+	return method.Outputs.Pack(out.Params)
+}
@@ -892,3 +892,123 @@ func (s *PrecompileTestSuite) TestDelegatorWithdrawAddress() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestCommunityPool() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[distribution.CommunityPoolMethod]
+
+	testCases := []distrTestCases{
+		{
+			"fail - invalid number of args",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+				}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1),
+		},
+		{
+			"success - community pool funded with 1 EVMOS",
+			func() []interface{} {
+				err := s.network.App.DistrKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(s.baseDenom, math.NewInt(1e18))), s.keyring.GetAccAddr(0))
+				s.Require().NoError(err)
+				return []interface{}{}
+			},
+			func(bz []byte) {
+				var coins []cmn.DecCoin
+				err := s.precompile.UnpackIntoInterface(&coins, distribution.CommunityPoolMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().NotEmpty(coins)
+				s.Require().Equal(s.baseDenom, coins[0].Denom)
+				s.Require().True(coins[0].Amount.Cmp(big.NewInt(1e18)) >= 0)
+				s.Require().Equal(uint8(18), coins[0].Precision)
+			},
+			100000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			ctx = s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+
+			bz, err := s.precompile.CommunityPool(ctx, contract, &method, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestParams() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[distribution.ParamsMethod]
+
+	testCases := []distrTestCases{
+		{
+			"fail - invalid number of args",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+				}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1),
+		},
+		{
+			"success - get distribution params",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(bz []byte) {
+				var out distribution.ParamsOutput
+				err := s.precompile.UnpackIntoInterface(&out, distribution.ParamsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+
+				params, err := s.network.App.DistrKeeper.Params.Get(ctx)
+				s.Require().NoError(err)
+				s.Require().Zero(params.CommunityTax.BigInt().Cmp(out.Params.CommunityTax.Value))
+				s.Require().Equal(uint8(math.LegacyPrecision), out.Params.CommunityTax.Precision)
+				s.Require().Zero(params.BaseProposerReward.BigInt().Cmp(out.Params.BaseProposerReward.Value))
+				s.Require().Zero(params.BonusProposerReward.BigInt().Cmp(out.Params.BonusProposerReward.Value))
+				s.Require().Equal(params.WithdrawAddrEnabled, out.Params.WithdrawAddrEnabled)
+			},
+			100000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			ctx = s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+
+			bz, err := s.precompile.Params(ctx, contract, &method, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
@@ -572,3 +572,129 @@ func (s *PrecompileTestSuite) TestFundCommunityPool() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDepositValidatorRewardsPool() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[distribution.DepositValidatorRewardsPoolMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(operatorAddress string) []interface{}
+		postCheck   func(operatorAddress string)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(string) []interface{} {
+				return []interface{}{}
+			},
+			func(string) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid depositor address",
+			func(operatorAddress string) []interface{} {
+				return []interface{}{
+					nil,
+					operatorAddress,
+					[]cmn.Coin{{Denom: s.baseDenom, Amount: big.NewInt(1e18)}},
+				}
+			},
+			func(string) {},
+			200000,
+			true,
+			"invalid hex address address",
+		},
+		{
+			"fail - zero amount",
+			func(operatorAddress string) []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					operatorAddress,
+					[]cmn.Coin{{Denom: s.baseDenom, Amount: big.NewInt(0)}},
+				}
+			},
+			func(string) {},
+			200000,
+			true,
+			"invalid amount",
+		},
+		{
+			"fail - origin different from depositor",
+			func(operatorAddress string) []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1),
+					operatorAddress,
+					[]cmn.Coin{{Denom: s.baseDenom, Amount: big.NewInt(1e18)}},
+				}
+			},
+			func(string) {},
+			200000,
+			true,
+			"does not match the spender address",
+		},
+		{
+			"fail - validator does not exist",
+			func(string) []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					sdk.ValAddress(utiltx.GenerateAddress().Bytes()).String(),
+					[]cmn.Coin{{Denom: s.baseDenom, Amount: big.NewInt(1e18)}},
+				}
+			},
+			func(string) {},
+			200000,
+			true,
+			"validator does not exist",
+		},
+		{
+			"success - deposit 1 EVMOS into the validator rewards pool",
+			func(operatorAddress string) []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					operatorAddress,
+					[]cmn.Coin{{Denom: s.baseDenom, Amount: big.NewInt(1e18)}},
+				}
+			},
+			func(operatorAddress string) {
+				valAddr, err := sdk.ValAddressFromBech32(operatorAddress)
+				s.Require().NoError(err)
+				outstanding, err := s.network.App.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddr)
+				s.Require().NoError(err)
+				s.Require().True(outstanding.Rewards.AmountOf(s.baseDenom).GTE(math.LegacyNewDec(1e18)))
+				userBalance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAddr(0).Bytes(), s.baseDenom)
+				s.Require().Equal(network.PrefundedAccountInitialBalance.Sub(math.NewInt(1e18)), userBalance.Amount)
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			operatorAddress := s.network.GetValidators()[0].OperatorAddress
+			bz, err := s.precompile.DepositValidatorRewardsPool(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate(operatorAddress))
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				success, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+				tc.postCheck(operatorAddress)
+			}
+		})
+	}
+}
@@ -50,6 +50,14 @@ type EventFundCommunityPool struct {
 	Amount    *big.Int
 }
 
+// EventDepositValidatorRewardsPool defines the event data for the DepositValidatorRewardsPool transaction.
+type EventDepositValidatorRewardsPool struct {
+	Depositor        common.Address
+	ValidatorAddress common.Address
+	Denom            string
+	Amount           *big.Int
+}
+
 // parseClaimRewardsArgs parses the arguments for the ClaimRewards method.
 func parseClaimRewardsArgs(args []interface{}) (common.Address, uint32, error) {
 	if len(args) != 2 {
@@ -164,6 +172,61 @@ func NewMsgFundCommunityPool(args []interface{}) (*distributiontypes.MsgFundComm
 	return msg, depositorAddress, nil
 }
 
+// NewMsgDepositValidatorRewardsPool creates a new MsgDepositValidatorRewardsPool message.
+func NewMsgDepositValidatorRewardsPool(method *abi.Method, args []interface{}) (*distributiontypes.MsgDepositValidatorRewardsPool, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	depositorAddress, ok := args[0].(common.Address)
+	if !ok || depositorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, args[0])
+	}
+
+	validatorAddress, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidValidator, args[1])
+	}
+
+	var coins []cmn.Coin
+	if err := method.Inputs[2:].Copy(&coins, args[2:]); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to Coin struct: %s", err)
+	}
+
+	amount, err := toSDKCoins(coins)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &distributiontypes.MsgDepositValidatorRewardsPool{
+		Depositor:        sdk.AccAddress(depositorAddress.Bytes()).String(),
+		ValidatorAddress: validatorAddress,
+		Amount:           amount,
+	}
+
+	return msg, depositorAddress, nil
+}
+
+// toSDKCoins converts the given coins to a sorted and valid sdk.Coins.
+func toSDKCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+		}
+		// NOTE: sdk.NewCoin panics on invalid coins, they are validated below
+		sdkCoins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+	if sdkCoins.IsZero() {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, "no coins to deposit")
+	}
+	return sdkCoins, nil
+}
+
 // NewValidatorDistributionInfoRequest creates a new QueryValidatorDistributionInfoRequest  instance and does sanity
 // checks on the provided arguments.
 func NewValidatorDistributionInfoRequest(args []interface{}) (*distributiontypes.QueryValidatorDistributionInfoRequest, error) {
@@ -408,3 +471,27 @@ func (dtr *DelegationTotalRewardsOutput) FromResponse(res *distributiontypes.Que
 func (dtr *DelegationTotalRewardsOutput) Pack(args abi.Arguments) ([]byte, error) {
 	return args.Pack(dtr.Rewards, dtr.Total)
 }
+
+// Params is a struct to represent the distribution module parameters.
+type Params struct {
+	CommunityTax        cmn.Dec `abi:"communityTax"`
+	BaseProposerReward  cmn.Dec `abi:"baseProposerReward"`
+	BonusProposerReward cmn.Dec `abi:"bonusProposerReward"`
+	WithdrawAddrEnabled bool    `abi:"withdrawAddrEnabled"`
+}
+
+// ParamsOutput is a wrapper for Params to return in the response.
+type ParamsOutput struct {
+	Params Params `abi:"params"`
+}
+
+// FromResponse populates the ParamsOutput from a QueryParamsResponse.
+func (po *ParamsOutput) FromResponse(res *distributiontypes.QueryParamsResponse) *ParamsOutput {
+	po.Params = Params{
+		CommunityTax:        cmn.Dec{Value: res.Params.CommunityTax.BigInt(), Precision: math.LegacyPrecision},
+		BaseProposerReward:  cmn.Dec{Value: res.Params.BaseProposerReward.BigInt(), Precision: math.LegacyPrecision},
+		BonusProposerReward: cmn.Dec{Value: res.Params.BonusProposerReward.BigInt(), Precision: math.LegacyPrecision},
+		WithdrawAddrEnabled: res.Params.WithdrawAddrEnabled,
+	}
+	return po
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IEpochs contract's address.
+address constant EPOCHS_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080c;
+
+/// @dev The IEpochs contract's instance.
+IEpochs constant EPOCHS_CONTRACT = IEpochs(EPOCHS_PRECOMPILE_ADDRESS);
+
+/// @dev EpochInfo defines the information of a running epoch.
+struct EpochInfo {
+    // identifier is the unique identifier of the epoch (e.g. "day" or "week")
+    string identifier;
+    // startTime is the UNIX timestamp in seconds at which the epoch counting starts
+    int64 startTime;
+    // duration is the duration of the epoch in seconds
+    int64 duration;
+    // currentEpoch is the number of the current epoch
+    int64 currentEpoch;
+    // currentEpochStartTime is the UNIX timestamp in seconds at which the current epoch started
+    int64 currentEpochStartTime;
+    // epochCountingStarted is true if the epoch counting has started
+    bool epochCountingStarted;
+    // currentEpochStartHeight is the block height at which the current epoch started
+    int64 currentEpochStartHeight;
+}
+
+/// @author Evmos Team
+/// @title Epochs and Inflation Precompiled Contract
+/// @dev The interface through which solidity contracts will query the running
+/// epochs of the x/epochs module and the emission data of the x/inflation module.
+/// @custom:address 0x000000000000000000000000000000000000080c
+interface IEpochs {
+    /// @dev epochInfos returns the running epochs.
+    /// @param pagination the pagination of the query
+    /// @return epochInfos the running epochs
+    /// @return pageResponse the pagination of the response
+    function epochInfos(
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            EpochInfo[] memory epochInfos,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev currentEpoch returns the number of the current epoch of the given identifier.
+    /// @param identifier the identifier of the epoch (e.g. "day" or "week")
+    /// @return currentEpoch the number of the current epoch
+    function currentEpoch(
+        string calldata identifier
+    ) external view returns (int64 currentEpoch);
+
+    /// @dev period returns the current inflation period.
+    /// @return period the current inflation period
+    function period() external view returns (uint64 period);
+
+    /// @dev epochMintProvision returns the amount of tokens minted on every epoch
+    /// of the current period.
+    /// @return epochMintProvision the minted tokens per epoch
+    function epochMintProvision()
+        external
+        view
+        returns (DecCoin memory epochMintProvision);
+
+    /// @dev inflationRate returns the percentage by which the total supply
+    /// increases within the current period.
+    /// @return inflationRate the inflation rate in percent
+    function inflationRate() external view returns (Dec memory inflationRate);
+
+    /// @dev circulatingSupply returns the total amount of tokens in circulation.
+    /// @return circulatingSupply the circulating supply
+    function circulatingSupply()
+        external
+        view
+        returns (DecCoin memory circulatingSupply);
+}
+
+/// @author Evmos Team
+/// @title Epoch Hooks
+/// @dev The interface that the contracts registered by governance in the x/epochs
+/// module parameters implement to be notified of the end of every epoch. The hook
+/// is called by the epochs precompile address with a bounded amount of gas and
+/// its failure doesn't affect the epoch processing.
+interface IEpochHooks {
+    /// @dev onEpochEnd is called at the end of every epoch.
+    /// @param identifier the identifier of the epoch that ended (e.g. "day" or "week")
+    /// @param epochNumber the number of the epoch that ended
+    function onEpochEnd(string calldata identifier, int64 epochNumber) external;
+}
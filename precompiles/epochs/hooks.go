@@ -0,0 +1,132 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package epochs
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// OnEpochEndHook defines the ABI method name of the hook called on the
+	// registered contracts at the end of every epoch.
+	OnEpochEndHook = "onEpochEnd"
+
+	// EventTypeEpochHook defines the event type for the epoch hooks.
+	EventTypeEpochHook = "epoch_hook"
+	// AttributeKeyContract defines the attribute key for the hook contract.
+	AttributeKeyContract = "contract"
+	// AttributeKeyEpochIdentifier defines the attribute key for the epoch identifier.
+	AttributeKeyEpochIdentifier = "epoch_identifier"
+	// AttributeKeyEpochNumber defines the attribute key for the epoch number.
+	AttributeKeyEpochNumber = "epoch_number"
+	// AttributeKeyError defines the attribute key for the hook error.
+	AttributeKeyError = "error"
+)
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochsKeeper defines the expected epochs keeper interface used to read the
+// hook contracts registered by governance.
+type EpochsKeeper interface {
+	GetParams(ctx sdk.Context) epochstypes.Params
+}
+
+// EVMKeeper defines the expected EVM keeper interface used to call the hook
+// contracts.
+type EVMKeeper interface {
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	CallEVMWithGasLimit(ctx sdk.Context, from, contract common.Address, data []byte, gasLimit uint64) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// EpochHooks implements the epochs module hooks to call onEpochEnd on the
+// contracts registered in the epochs module parameters.
+type EpochHooks struct {
+	epochsKeeper EpochsKeeper
+	evmKeeper    EVMKeeper
+	hooksABI     abi.ABI
+}
+
+// NewEpochHooks creates a new EpochHooks given the epochs and EVM keepers.
+func NewEpochHooks(epochsKeeper EpochsKeeper, evmKeeper EVMKeeper) EpochHooks {
+	hooksABI, err := LoadHooksABI()
+	if err != nil {
+		panic(err)
+	}
+
+	return EpochHooks{
+		epochsKeeper: epochsKeeper,
+		evmKeeper:    evmKeeper,
+		hooksABI:     hooksABI,
+	}
+}
+
+// AfterEpochEnd calls onEpochEnd on every registered hook contract with at
+// most the hook gas limit of the epochs module parameters. The state changes
+// of a hook are only committed if the call succeeds and a failed hook never
+// fails the epoch processing, so that a misbehaving contract cannot halt the
+// chain.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := h.epochsKeeper.GetParams(ctx)
+	if len(params.HookContracts) == 0 {
+		return
+	}
+
+	data, err := h.hooksABI.Pack(OnEpochEndHook, epochIdentifier, epochNumber)
+	if err != nil {
+		ctx.Logger().Error("failed to pack epoch hook data", "error", err.Error())
+		return
+	}
+
+	for _, contract := range params.GetHookContractAddresses() {
+		// NOTE: the registered addresses without code are skipped, so that
+		// a hook can be registered before the contract is deployed.
+		account := h.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+		if account == nil || !account.IsContract() {
+			continue
+		}
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(AttributeKeyContract, contract.String()),
+			sdk.NewAttribute(AttributeKeyEpochIdentifier, epochIdentifier),
+			sdk.NewAttribute(AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+		}
+
+		if err := h.callContract(ctx, contract, data, params.HookGasLimit); err != nil {
+			attrs = append(attrs, sdk.NewAttribute(AttributeKeyError, err.Error()))
+			ctx.Logger().Error(
+				"epoch hook failed",
+				"contract", contract.String(),
+				"epoch", epochIdentifier,
+				"error", err.Error(),
+			)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeEpochHook, attrs...))
+	}
+}
+
+// BeforeEpochStart implements the epochs module hooks. The hook contracts are
+// only notified of the end of the epochs.
+func (EpochHooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// callContract calls the hook contract from the precompile address with at
+// most the given amount of gas.
+func (h EpochHooks) callContract(ctx sdk.Context, contract common.Address, data []byte, gasLimit uint64) error {
+	_, err := h.evmKeeper.CallEVMWithGasLimit(
+		ctx,
+		common.HexToAddress(evmtypes.EpochsPrecompileAddress),
+		contract,
+		data,
+		gasLimit,
+	)
+	return err
+}
@@ -0,0 +1,128 @@
+package epochs_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v20/precompiles/epochs"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+)
+
+var (
+	// storeCode is the runtime code of a contract that stores 1 in the slot 0 on any call.
+	storeCode = common.FromHex("0x600160005500")
+	// revertCode is the runtime code of a contract that reverts on any call.
+	revertCode = common.FromHex("0x60006000fd")
+)
+
+// setCode sets the given runtime code to the account of the given address.
+func (s *PrecompileTestSuite) setCode(ctx sdk.Context, addr common.Address, code []byte) {
+	codeHash := crypto.Keccak256Hash(code)
+	s.network.App.EvmKeeper.SetCode(ctx, codeHash.Bytes(), code)
+	account := statedb.NewEmptyAccount()
+	account.CodeHash = codeHash.Bytes()
+	s.Require().NoError(s.network.App.EvmKeeper.SetAccount(ctx, addr, *account))
+}
+
+// hookEvents returns the epoch hook events emitted in the given context.
+func hookEvents(ctx sdk.Context) []sdk.Event {
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == epochs.EventTypeEpochHook {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (s *PrecompileTestSuite) TestAfterEpochEnd() {
+	contract := common.BytesToAddress([]byte("epoch hook contract"))
+
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context) epochstypes.Params
+		expEvent  bool
+		expStored bool
+		expError  bool
+	}{
+		{
+			"no hook - no registered contracts",
+			func(ctx sdk.Context) epochstypes.Params {
+				s.setCode(ctx, contract, storeCode)
+				return epochstypes.DefaultParams()
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"no hook - registered address is not a contract",
+			func(sdk.Context) epochstypes.Params {
+				return epochstypes.NewParams([]string{contract.Hex()}, epochstypes.DefaultHookGasLimit)
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"hook - contract succeeds",
+			func(ctx sdk.Context) epochstypes.Params {
+				s.setCode(ctx, contract, storeCode)
+				return epochstypes.NewParams([]string{contract.Hex()}, epochstypes.DefaultHookGasLimit)
+			},
+			true,
+			true,
+			false,
+		},
+		{
+			"hook - contract reverts",
+			func(ctx sdk.Context) epochstypes.Params {
+				s.setCode(ctx, contract, revertCode)
+				return epochstypes.NewParams([]string{contract.Hex()}, epochstypes.DefaultHookGasLimit)
+			},
+			true,
+			false,
+			true,
+		},
+		{
+			"hook - contract runs out of gas",
+			func(ctx sdk.Context) epochstypes.Params {
+				s.setCode(ctx, contract, storeCode)
+				return epochstypes.NewParams([]string{contract.Hex()}, 25_000)
+			},
+			true,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext().WithEventManager(sdk.NewEventManager())
+			params := tc.malleate(ctx)
+			s.Require().NoError(s.network.App.EpochsKeeper.SetParams(ctx, params))
+
+			hooks := epochs.NewEpochHooks(s.network.App.EpochsKeeper, s.network.App.EvmKeeper)
+			hooks.AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+
+			events := hookEvents(ctx)
+			if !tc.expEvent {
+				s.Require().Empty(events)
+				return
+			}
+
+			s.Require().Len(events, 1)
+			identifier, found := events[0].GetAttribute(epochs.AttributeKeyEpochIdentifier)
+			s.Require().True(found)
+			s.Require().Equal(epochstypes.DayEpochID, identifier.Value)
+			_, hasError := events[0].GetAttribute(epochs.AttributeKeyError)
+			s.Require().Equal(tc.expError, hasError)
+
+			stored := s.network.App.EvmKeeper.GetState(ctx, contract, common.Hash{})
+			s.Require().Equal(tc.expStored, stored == common.BigToHash(common.Big1))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package epochs
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
+	inflationtypes "github.com/evmos/evmos/v20/x/inflation/v1/types"
+)
+
+const (
+	// EpochInfosMethod defines the ABI method name for the epochs
+	// EpochInfos query.
+	EpochInfosMethod = "epochInfos"
+	// CurrentEpochMethod defines the ABI method name for the epochs
+	// CurrentEpoch query.
+	CurrentEpochMethod = "currentEpoch"
+	// PeriodMethod defines the ABI method name for the inflation
+	// Period query.
+	PeriodMethod = "period"
+	// EpochMintProvisionMethod defines the ABI method name for the inflation
+	// EpochMintProvision query.
+	EpochMintProvisionMethod = "epochMintProvision"
+	// InflationRateMethod defines the ABI method name for the inflation
+	// InflationRate query.
+	InflationRateMethod = "inflationRate"
+	// CirculatingSupplyMethod defines the ABI method name for the inflation
+	// CirculatingSupply query.
+	CirculatingSupplyMethod = "circulatingSupply"
+)
+
+// EpochInfos returns the running epochs with pagination.
+func (p Precompile) EpochInfos(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseEpochInfosArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.epochsKeeper.EpochInfos(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(EpochInfosOutput).FromResponse(res)
+	return out.Pack(method.Outputs)
+}
+
+// CurrentEpoch returns the number of the current epoch of the given identifier.
+func (p Precompile) CurrentEpoch(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	identifier, err := ParseCurrentEpochArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.epochsKeeper.CurrentEpoch(ctx, &epochstypes.QueryCurrentEpochRequest{Identifier: identifier})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.CurrentEpoch)
+}
+
+// Period returns the current inflation period.
+func (p Precompile) Period(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.Period(ctx, &inflationtypes.QueryPeriodRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Period)
+}
+
+// EpochMintProvision returns the amount of tokens minted on every epoch of the
+// current period.
+func (p Precompile) EpochMintProvision(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.EpochMintProvision(ctx, &inflationtypes.QueryEpochMintProvisionRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDecCoin(res.EpochMintProvision))
+}
+
+// InflationRate returns the percentage by which the total supply increases
+// within the current period.
+func (p Precompile) InflationRate(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.InflationRate(ctx, &inflationtypes.QueryInflationRateRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.Dec{
+		Value:     res.InflationRate.BigInt(),
+		Precision: math.LegacyPrecision,
+	})
+}
+
+// CirculatingSupply returns the total amount of tokens in circulation.
+func (p Precompile) CirculatingSupply(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.inflationKeeper.CirculatingSupply(ctx, &inflationtypes.QueryCirculatingSupplyRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDecCoin(res.CirculatingSupply))
+}
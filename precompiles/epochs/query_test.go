@@ -0,0 +1,198 @@
+package epochs_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/epochs"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+func (s *PrecompileTestSuite) TestEpochInfos() {
+	method := s.precompile.Methods[epochs.EpochInfosMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expLen      int
+		expTotal    uint64
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			0,
+			0,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"success - all epochs",
+			[]interface{}{query.PageRequest{CountTotal: true}},
+			2,
+			2,
+			"",
+		},
+		{
+			"success - paginated epochs",
+			[]interface{}{query.PageRequest{Limit: 1, CountTotal: true}},
+			1,
+			2,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, err := s.precompile.EpochInfos(ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out epochs.EpochInfosOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, epochs.EpochInfosMethod, bz))
+			s.Require().Len(out.EpochInfos, tc.expLen)
+			s.Require().Equal(tc.expTotal, out.PageResponse.Total)
+
+			info, found := s.network.App.EpochsKeeper.GetEpochInfo(ctx, out.EpochInfos[0].Identifier)
+			s.Require().True(found)
+			s.Require().Equal(epochs.NewEpochInfo(info), out.EpochInfos[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCurrentEpoch() {
+	method := s.precompile.Methods[epochs.CurrentEpochMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty identifier",
+			[]interface{}{""},
+			"blank epoch identifier",
+		},
+		{
+			"fail - epoch not found",
+			[]interface{}{"month"},
+			"epoch info not found",
+		},
+		{
+			"success - day epoch",
+			[]interface{}{epochstypes.DayEpochID},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, err := s.precompile.CurrentEpoch(ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+
+			info, found := s.network.App.EpochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+			s.Require().True(found)
+			s.Require().Equal(info.CurrentEpoch, out[0].(int64))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestInflationQueries() {
+	testCases := []struct {
+		name     string
+		method   string
+		query    func(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error)
+		expected func(ctx sdk.Context) interface{}
+	}{
+		{
+			"period",
+			epochs.PeriodMethod,
+			func(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+				return s.precompile.Period(ctx, method, args)
+			},
+			func(ctx sdk.Context) interface{} {
+				return s.network.App.InflationKeeper.GetPeriod(ctx)
+			},
+		},
+		{
+			"epoch mint provision",
+			epochs.EpochMintProvisionMethod,
+			func(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+				return s.precompile.EpochMintProvision(ctx, method, args)
+			},
+			func(ctx sdk.Context) interface{} {
+				denom := s.network.App.InflationKeeper.GetParams(ctx).MintDenom
+				provision := s.network.App.InflationKeeper.GetEpochMintProvision(ctx)
+				return epochs.NewDecCoin(sdk.NewDecCoinFromDec(denom, provision))
+			},
+		},
+		{
+			"inflation rate",
+			epochs.InflationRateMethod,
+			func(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+				return s.precompile.InflationRate(ctx, method, args)
+			},
+			func(ctx sdk.Context) interface{} {
+				denom := s.network.App.InflationKeeper.GetParams(ctx).MintDenom
+				rate := s.network.App.InflationKeeper.GetInflationRate(ctx, denom)
+				return cmn.Dec{Value: rate.BigInt(), Precision: math.LegacyPrecision}
+			},
+		},
+		{
+			"circulating supply",
+			epochs.CirculatingSupplyMethod,
+			func(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+				return s.precompile.CirculatingSupply(ctx, method, args)
+			},
+			func(ctx sdk.Context) interface{} {
+				denom := s.network.App.InflationKeeper.GetParams(ctx).MintDenom
+				supply := s.network.App.InflationKeeper.GetCirculatingSupply(ctx, denom)
+				return epochs.NewDecCoin(sdk.NewDecCoinFromDec(denom, supply))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			method := s.precompile.Methods[tc.method]
+			_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			_, err := tc.query(ctx, &method, []interface{}{"invalid"})
+			s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1))
+
+			bz, err := tc.query(ctx, &method, []interface{}{})
+			s.Require().NoError(err)
+
+			expBz, err := method.Outputs.Pack(tc.expected(ctx))
+			s.Require().NoError(err)
+			s.Require().Equal(expBz, bz)
+		})
+	}
+}
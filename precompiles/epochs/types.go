@@ -0,0 +1,117 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package epochs
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+// EpochInfo defines the epoch info struct of the epochs precompile. The
+// timestamps and the duration are expressed in seconds.
+type EpochInfo struct {
+	Identifier              string `abi:"identifier"`
+	StartTime               int64  `abi:"startTime"`
+	Duration                int64  `abi:"duration"`
+	CurrentEpoch            int64  `abi:"currentEpoch"`
+	CurrentEpochStartTime   int64  `abi:"currentEpochStartTime"`
+	EpochCountingStarted    bool   `abi:"epochCountingStarted"`
+	CurrentEpochStartHeight int64  `abi:"currentEpochStartHeight"`
+}
+
+// NewEpochInfo creates a new EpochInfo from the given epochs module epoch info.
+func NewEpochInfo(info epochstypes.EpochInfo) EpochInfo {
+	return EpochInfo{
+		Identifier:              info.Identifier,
+		StartTime:               info.StartTime.Unix(),
+		Duration:                int64(info.Duration.Seconds()),
+		CurrentEpoch:            info.CurrentEpoch,
+		CurrentEpochStartTime:   info.CurrentEpochStartTime.Unix(),
+		EpochCountingStarted:    info.EpochCountingStarted,
+		CurrentEpochStartHeight: info.CurrentEpochStartHeight,
+	}
+}
+
+// NewDecCoin creates a new precompile DecCoin from the given SDK DecCoin,
+// keeping the decimal amount with the SDK precision.
+func NewDecCoin(coin sdk.DecCoin) cmn.DecCoin {
+	return cmn.DecCoin{
+		Denom:     coin.Denom,
+		Amount:    coin.Amount.BigInt(),
+		Precision: math.LegacyPrecision,
+	}
+}
+
+// EpochInfosInput defines the input for the EpochInfos query.
+type EpochInfosInput struct {
+	Pagination query.PageRequest
+}
+
+// EpochInfosOutput defines the output for the EpochInfos query.
+type EpochInfosOutput struct {
+	EpochInfos   []EpochInfo        `abi:"epochInfos"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// FromResponse populates the EpochInfosOutput from a QueryEpochsInfoResponse.
+func (eo *EpochInfosOutput) FromResponse(res *epochstypes.QueryEpochsInfoResponse) *EpochInfosOutput {
+	eo.EpochInfos = make([]EpochInfo, len(res.Epochs))
+	for i, info := range res.Epochs {
+		eo.EpochInfos[i] = NewEpochInfo(info)
+	}
+
+	if res.Pagination != nil {
+		eo.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+	return eo
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (eo *EpochInfosOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(eo.EpochInfos, eo.PageResponse)
+}
+
+// ParseEpochInfosArgs parses the arguments of the EpochInfos query.
+func ParseEpochInfosArgs(method *abi.Method, args []interface{}) (*epochstypes.QueryEpochsInfoRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input EpochInfosInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to EpochInfosInput: %s", err)
+	}
+
+	return &epochstypes.QueryEpochsInfoRequest{
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseCurrentEpochArgs parses the epoch identifier of the CurrentEpoch query.
+func ParseCurrentEpochArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	identifier, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, "identifier", "", args[0])
+	}
+
+	if err := epochstypes.ValidateEpochIdentifierString(identifier); err != nil {
+		return "", err
+	}
+
+	return identifier, nil
+}
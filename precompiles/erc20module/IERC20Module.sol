@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IERC20Module contract's address.
+address constant ERC20_MODULE_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080b;
+
+/// @dev The IERC20Module contract's instance.
+IERC20Module constant ERC20_MODULE_CONTRACT = IERC20Module(ERC20_MODULE_PRECOMPILE_ADDRESS);
+
+/// @dev Owner enumerates the owners of the contract of a token pair.
+enum Owner {
+    // Unspecified defines an invalid/undefined owner.
+    Unspecified,
+    // Module defines a token that is owned by the erc20 module.
+    Module,
+    // External defines a token that is owned by an external account.
+    External
+}
+
+/// @dev The TokenPair struct defines the mapping between a Cosmos coin and an ERC20 token.
+struct TokenPair {
+    // erc20Address is the address of the ERC20 contract
+    address erc20Address;
+    // denom is the denomination of the Cosmos coin
+    string denom;
+    // enabled defines if the conversions are allowed for the token pair
+    bool enabled;
+    // contractOwner is the owner of the ERC20 contract
+    Owner contractOwner;
+}
+
+/// @author The Evmos Core Team
+/// @title ERC20 Module Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/erc20 module
+interface IERC20Module {
+    /// @dev Event emitted when the token pair of an ERC20 contract is registered.
+    /// @param contractAddress The address of the ERC20 contract
+    /// @param denom The denomination of the Cosmos coin of the token pair
+    event RegisterERC20(address indexed contractAddress, string denom);
+
+    /// @dev Registers the token pair of the given ERC20 contract. This is only
+    /// allowed if permissionless registration is enabled in the module parameters.
+    /// @param contractAddress The address of the ERC20 contract
+    /// @return denom The denomination of the Cosmos coin of the new token pair
+    function registerERC20(address contractAddress) external returns (string memory denom);
+
+    /// @dev Queries the token pair of the given Cosmos denomination or ERC20
+    /// contract hex address. It returns an empty token pair if the token is
+    /// not registered.
+    /// @param token The Cosmos denomination or ERC20 contract address
+    /// @return tokenPair The registered token pair
+    function tokenPair(string memory token) external view returns (TokenPair memory tokenPair);
+
+    /// @dev Queries all the registered token pairs with pagination.
+    /// @param pagination Pagination request
+    /// @return tokenPairs List of registered token pairs
+    /// @return pageResponse Pagination response
+    function tokenPairs(PageRequest calldata pagination)
+        external
+        view
+        returns (TokenPair[] memory tokenPairs, PageResponse memory pageResponse);
+}
@@ -0,0 +1,11 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+const (
+	// ErrInvalidContract is raised when the ERC20 contract address is invalid.
+	ErrInvalidContract = "invalid ERC20 contract address: %v"
+	// ErrInvalidToken is raised when the token denomination or address is invalid.
+	ErrInvalidToken = "invalid token: %v"
+)
@@ -0,0 +1,59 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// TokenPairMethod defines the ABI method name for the erc20 module
+	// TokenPair query.
+	TokenPairMethod = "tokenPair"
+	// TokenPairsMethod defines the ABI method name for the erc20 module
+	// TokenPairs query.
+	TokenPairsMethod = "tokenPairs"
+)
+
+// TokenPair returns the token pair of the given Cosmos denomination or ERC20
+// contract hex address. It returns an empty token pair if the token is not
+// registered, so that contracts can check whether a token pair exists.
+func (p Precompile) TokenPair(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	token, err := ParseTokenPairArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, found := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetTokenPairID(ctx, token))
+	if !found {
+		return method.Outputs.Pack(TokenPair{})
+	}
+
+	return method.Outputs.Pack(NewTokenPair(pair))
+}
+
+// TokenPairs returns all the registered token pairs with pagination.
+func (p Precompile) TokenPairs(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseTokenPairsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.erc20Keeper.TokenPairs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(TokenPairsOutput).FromResponse(res)
+	return out.Pack(method.Outputs)
+}
@@ -0,0 +1,139 @@
+package erc20module_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/erc20module"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (s *PrecompileTestSuite) TestTokenPair() {
+	method := s.precompile.Methods[erc20module.TokenPairMethod]
+	contractAddr := common.HexToAddress("0x1D1530e3A1C5D8Ba2b8fE1B6A2D92C2C1B2D8E0f")
+	pair := erc20types.NewTokenPair(contractAddr, "test", erc20types.OWNER_EXTERNAL)
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expPair     erc20module.TokenPair
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			erc20module.TokenPair{},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty token",
+			[]interface{}{""},
+			erc20module.TokenPair{},
+			"invalid token",
+		},
+		{
+			"success - token not registered",
+			[]interface{}{"unregistered"},
+			erc20module.TokenPair{},
+			"",
+		},
+		{
+			"success - query by denom",
+			[]interface{}{pair.Denom},
+			erc20module.NewTokenPair(pair),
+			"",
+		},
+		{
+			"success - query by contract address",
+			[]interface{}{contractAddr.Hex()},
+			erc20module.NewTokenPair(pair),
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.network.App.Erc20Keeper.SetToken(s.network.GetContext(), pair)
+			_, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, err := s.precompile.TokenPair(ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out struct {
+				TokenPair erc20module.TokenPair
+			}
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, erc20module.TokenPairMethod, bz))
+			s.Require().Equal(tc.expPair, out.TokenPair)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTokenPairs() {
+	method := s.precompile.Methods[erc20module.TokenPairsMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expLen      int
+		expTotal    uint64
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			0,
+			0,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"success - all token pairs",
+			[]interface{}{query.PageRequest{CountTotal: true}},
+			3,
+			3,
+			"",
+		},
+		{
+			"success - paginated token pairs",
+			[]interface{}{query.PageRequest{Limit: 2, CountTotal: true}},
+			2,
+			3,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			// NOTE: the genesis of the test network already contains a token pair
+			// for the native coin
+			for _, denom := range []string{"atest", "btest"} {
+				pair := erc20types.NewTokenPair(common.BytesToAddress([]byte(denom)), denom, erc20types.OWNER_EXTERNAL)
+				s.network.App.Erc20Keeper.SetToken(ctx, pair)
+			}
+			_, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, err := s.precompile.TokenPairs(ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out erc20module.TokenPairsOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, erc20module.TokenPairsMethod, bz))
+			s.Require().Len(out.TokenPairs, tc.expLen)
+			s.Require().Equal(tc.expTotal, out.PageResponse.Total)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package erc20module_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v20/contracts"
+	"github.com/evmos/evmos/v20/precompiles/erc20module"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *erc20module.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	var err error
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, grpcHandler)
+
+	s.network = nw
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+
+	if s.precompile, err = erc20module.NewPrecompile(
+		s.network.App.Erc20Keeper,
+		s.network.App.AuthzKeeper,
+	); err != nil {
+		panic(err)
+	}
+}
+
+// deployERC20 deploys an ERC20 contract and mints the given amount of tokens
+// to the first account of the keyring.
+func (s *PrecompileTestSuite) deployERC20(amount *big.Int) common.Address {
+	contractAddr, err := s.factory.DeployContract(
+		s.keyring.GetPrivKey(0),
+		evmtypes.EvmTxArgs{},
+		factory.ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{"Test Token", "TEST", uint8(18)},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+
+	_, err = s.factory.ExecuteContractCall(
+		s.keyring.GetPrivKey(0),
+		evmtypes.EvmTxArgs{To: &contractAddr},
+		factory.CallArgs{
+			ContractABI: contracts.ERC20MinterBurnerDecimalsContract.ABI,
+			MethodName:  "mint",
+			Args:        []interface{}{s.keyring.GetAddr(0), amount},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+
+	return contractAddr
+}
+
+// enablePermissionlessRegistration enables the permissionless registration of
+// token pairs in the erc20 module parameters.
+func (s *PrecompileTestSuite) enablePermissionlessRegistration() {
+	ctx := s.network.GetContext()
+	params := s.network.App.Erc20Keeper.GetParams(ctx)
+	params.PermissionlessRegistration = true
+	s.Require().NoError(s.network.App.Erc20Keeper.SetParams(ctx, params))
+}
@@ -0,0 +1,50 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// RegisterERC20Method defines the ABI method name for the erc20 module
+	// RegisterERC20 transaction.
+	RegisterERC20Method = "registerERC20"
+)
+
+// RegisterERC20 registers the token pair of the given ERC20 contract if the
+// permissionless registration is enabled in the erc20 module parameters.
+func (p Precompile) RegisterERC20(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	contractAddress, err := ParseRegisterERC20Args(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"caller", contract.CallerAddress.String(),
+		"contract", contractAddress.String(),
+	)
+
+	pair, err := p.erc20Keeper.RegisterERC20Permissionless(ctx, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRegisterERC20Event(ctx, stateDB, contractAddress, pair.Denom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(pair.Denom)
+}
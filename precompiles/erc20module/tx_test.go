@@ -0,0 +1,95 @@
+package erc20module_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/erc20module"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (s *PrecompileTestSuite) TestRegisterERC20() {
+	method := s.precompile.Methods[erc20module.RegisterERC20Method]
+
+	testCases := []struct {
+		name        string
+		malleate    func(contractAddr common.Address) []interface{}
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(common.Address) []interface{} {
+				s.enablePermissionlessRegistration()
+				return []interface{}{}
+			},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty contract address",
+			func(common.Address) []interface{} {
+				s.enablePermissionlessRegistration()
+				return []interface{}{common.Address{}}
+			},
+			"invalid ERC20 contract address",
+		},
+		{
+			"fail - permissionless registration disabled",
+			func(contractAddr common.Address) []interface{} {
+				return []interface{}{contractAddr}
+			},
+			erc20types.ErrPermissionlessDisabled.Error(),
+		},
+		{
+			"fail - token pair already registered",
+			func(contractAddr common.Address) []interface{} {
+				s.enablePermissionlessRegistration()
+				_, err := s.network.App.Erc20Keeper.RegisterERC20Permissionless(s.network.GetContext(), contractAddr)
+				s.Require().NoError(err)
+				return []interface{}{contractAddr}
+			},
+			erc20types.ErrTokenPairAlreadyExists.Error(),
+		},
+		{
+			"success - token pair registered",
+			func(contractAddr common.Address) []interface{} {
+				s.enablePermissionlessRegistration()
+				return []interface{}{contractAddr}
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contractAddr := s.deployERC20(big.NewInt(1e18))
+			args := tc.malleate(contractAddr)
+
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile, 2_000_000)
+
+			bz, err := s.precompile.RegisterERC20(ctx, contract, stateDB, &method, args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(stateDB.Logs())
+				return
+			}
+
+			s.Require().NoError(err)
+			denom := erc20types.CreateDenom(contractAddr.String())
+			s.Require().True(s.network.App.Erc20Keeper.IsDenomRegistered(ctx, denom))
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(denom, out[0])
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.Events[erc20module.EventTypeRegisterERC20].ID, logs[0].Topics[0])
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// TokenPair defines the token pair struct of the erc20 module precompile.
+type TokenPair struct {
+	Erc20Address  common.Address `abi:"erc20Address"`
+	Denom         string         `abi:"denom"`
+	Enabled       bool           `abi:"enabled"`
+	ContractOwner uint8          `abi:"contractOwner"`
+}
+
+// NewTokenPair creates a new TokenPair from the given erc20 module token pair.
+func NewTokenPair(pair erc20types.TokenPair) TokenPair {
+	return TokenPair{
+		Erc20Address:  pair.GetERC20Contract(),
+		Denom:         pair.Denom,
+		Enabled:       pair.Enabled,
+		ContractOwner: uint8(pair.ContractOwner), //nolint:gosec // G115 // the owner enum only has three values
+	}
+}
+
+// TokenPairsInput defines the input for the TokenPairs query.
+type TokenPairsInput struct {
+	Pagination query.PageRequest
+}
+
+// TokenPairsOutput defines the output for the TokenPairs query.
+type TokenPairsOutput struct {
+	TokenPairs   []TokenPair        `abi:"tokenPairs"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// FromResponse populates the TokenPairsOutput from a QueryTokenPairsResponse.
+func (tpo *TokenPairsOutput) FromResponse(res *erc20types.QueryTokenPairsResponse) *TokenPairsOutput {
+	tpo.TokenPairs = make([]TokenPair, len(res.TokenPairs))
+	for i, pair := range res.TokenPairs {
+		tpo.TokenPairs[i] = NewTokenPair(pair)
+	}
+
+	if res.Pagination != nil {
+		tpo.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+	return tpo
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (tpo *TokenPairsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(tpo.TokenPairs, tpo.PageResponse)
+}
+
+// ParseRegisterERC20Args parses the arguments for the RegisterERC20 transaction.
+func ParseRegisterERC20Args(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	contractAddress, ok := args[0].(common.Address)
+	if !ok || contractAddress == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidContract, args[0])
+	}
+
+	return contractAddress, nil
+}
+
+// ParseTokenPairArgs parses the arguments for the TokenPair query.
+func ParseTokenPairArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	token, ok := args[0].(string)
+	if !ok || token == "" {
+		return "", fmt.Errorf(ErrInvalidToken, args[0])
+	}
+
+	return token, nil
+}
+
+// ParseTokenPairsArgs parses the arguments for the TokenPairs query.
+func ParseTokenPairsArgs(method *abi.Method, args []interface{}) (*erc20types.QueryTokenPairsRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input TokenPairsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to TokenPairsInput: %s", err)
+	}
+
+	return &erc20types.QueryTokenPairsRequest{
+		Pagination: &input.Pagination,
+	}, nil
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev Allowance defines the information of a fee allowance.
+/// The period fields are only populated for the periodic allowances and the
+/// allowed messages for the allowances restricted to some message types.
+struct Allowance {
+    /// granter defines the address of the account that pays the fees.
+    address granter;
+    /// grantee defines the address of the account whose fees are paid.
+    address grantee;
+    /// allowanceType defines the type URL of the allowance
+    /// (e.g. /cosmos.feegrant.v1beta1.BasicAllowance).
+    string allowanceType;
+    /// spendLimit defines the maximum amount of fees that can be paid, empty for no limit.
+    Coin[] spendLimit;
+    /// expiration defines the unix timestamp when the allowance expires, 0 if it doesn't expire.
+    int64 expiration;
+    /// period defines the duration of a period in seconds.
+    int64 period;
+    /// periodSpendLimit defines the maximum amount of fees that can be paid in a period.
+    Coin[] periodSpendLimit;
+    /// periodCanSpend defines the amount of fees that can still be paid in the current period.
+    Coin[] periodCanSpend;
+    /// periodReset defines the unix timestamp when the current period ends.
+    int64 periodReset;
+    /// allowedMessages defines the message type URLs the allowance can pay the fees for,
+    /// empty for any message.
+    string[] allowedMessages;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the x/feegrant module
+/// to pay the Cosmos and EVM transaction fees of other accounts.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IFeegrant {
+    /// @dev GrantAllowance defines an Event emitted when a fee allowance is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param allowanceType the type URL of the granted allowance
+    event GrantAllowance(
+        address indexed granter,
+        address indexed grantee,
+        string allowanceType
+    );
+
+    /// @dev RevokeAllowance defines an Event emitted when a fee allowance is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev Approval defines an Event emitted when a granter approves a grantee
+    /// to grant or revoke fee allowances on its behalf.
+    /// @param grantee the address of the grantee
+    /// @param granter the address of the granter
+    /// @param methods the message type URLs of the approved methods
+    event Approval(address indexed grantee, address indexed granter, string[] methods);
+
+    /// @dev Revocation defines an Event emitted when a granter revokes an approval.
+    /// @param grantee the address of the grantee
+    /// @param granter the address of the granter
+    /// @param methods the message type URLs of the revoked methods
+    event Revocation(address indexed grantee, address indexed granter, string[] methods);
+
+    /// @dev grantAllowance defines a method for granting a basic fee allowance
+    /// from the granter to the grantee.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param spendLimit the maximum amount of fees that can be paid, empty for no limit
+    /// @param expiration the unix timestamp when the allowance expires, 0 if it doesn't expire
+    /// @return success true if the allowance was granted successfully
+    function grantAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev grantPeriodicAllowance defines a method for granting a periodic fee
+    /// allowance from the granter to the grantee. The first period starts at the
+    /// current block time.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param spendLimit the maximum amount of fees that can be paid, empty for no limit
+    /// @param expiration the unix timestamp when the allowance expires, 0 if it doesn't expire
+    /// @param period the duration of a period in seconds
+    /// @param periodSpendLimit the maximum amount of fees that can be paid in a period
+    /// @return success true if the allowance was granted successfully
+    function grantPeriodicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit
+    ) external returns (bool success);
+
+    /// @dev revokeAllowance defines a method for revoking the fee allowance
+    /// given by the granter to the grantee.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @return success true if the allowance was revoked successfully
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// @dev approve defines a method to grant the grantee an authorization to
+    /// grant or revoke fee allowances on behalf of the caller.
+    /// @param grantee The address of the grantee
+    /// @param methods The message type URLs of the methods to approve
+    /// @return approved Whether the approval was successful or not
+    function approve(
+        address grantee,
+        string[] calldata methods
+    ) external returns (bool approved);
+
+    /// @dev revoke defines a method to revoke the authorizations given to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param methods The message type URLs of the methods to revoke
+    /// @return revoked Whether the revocation was successful or not
+    function revoke(
+        address grantee,
+        string[] calldata methods
+    ) external returns (bool revoked);
+
+    /// @dev allowance defines a method for querying the fee allowance given by
+    /// the granter to the grantee.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @return allowance the fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (Allowance memory allowance);
+
+    /// @dev allowances defines a method for querying the fee allowances given to a grantee.
+    /// @param grantee the address of the grantee
+    /// @param pageRequest the pagination of the query
+    /// @return allowances the fee allowances given to the grantee
+    /// @return pageResponse the pagination of the response
+    function allowances(
+        address grantee,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (Allowance[] memory allowances, PageResponse memory pageResponse);
+
+    /// @dev allowancesByGranter defines a method for querying the fee allowances
+    /// given by a granter.
+    /// @param granter the address of the granter
+    /// @param pageRequest the pagination of the query
+    /// @return allowances the fee allowances given by the granter
+    /// @return pageResponse the pagination of the response
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (Allowance[] memory allowances, PageResponse memory pageResponse);
+}
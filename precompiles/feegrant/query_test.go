@@ -0,0 +1,113 @@
+package feegrant_test
+
+import (
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	// no allowance
+	_, err := s.precompile.Allowance(ctx, &method, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)})
+	s.Require().ErrorContains(err, "fee-grant not found")
+
+	// periodic allowance restricted to some messages
+	denom := evmtypes.GetEVMCoinDenom()
+	expiration := ctx.BlockTime().Add(24 * time.Hour).UTC()
+	periodic := &sdkfeegrant.PeriodicAllowance{
+		Basic: sdkfeegrant.BasicAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1000))),
+			Expiration: &expiration,
+		},
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100))),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(50))),
+		PeriodReset:      ctx.BlockTime().Add(time.Hour),
+	}
+	allowedMsgs := []string{sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})}
+	allowedMsgAllowance, err := sdkfeegrant.NewAllowedMsgAllowance(periodic, allowedMsgs)
+	s.Require().NoError(err)
+	err = s.network.App.FeeGrantKeeper.GrantAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), allowedMsgAllowance)
+	s.Require().NoError(err)
+
+	bz, err := s.precompile.Allowance(ctx, &method, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)})
+	s.Require().NoError(err)
+
+	var out struct {
+		Allowance feegrant.Allowance
+	}
+	err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz)
+	s.Require().NoError(err)
+
+	allowance := out.Allowance
+	s.Require().Equal(s.keyring.GetAddr(0), allowance.Granter)
+	s.Require().Equal(s.keyring.GetAddr(1), allowance.Grantee)
+	s.Require().Equal(sdk.MsgTypeURL(&sdkfeegrant.AllowedMsgAllowance{}), allowance.AllowanceType)
+	s.Require().Equal(allowedMsgs, allowance.AllowedMessages)
+	s.Require().Equal(big.NewInt(1000), allowance.SpendLimit[0].Amount)
+	s.Require().Equal(expiration.Unix(), allowance.Expiration)
+	s.Require().Equal(int64(3600), allowance.Period)
+	s.Require().Equal(big.NewInt(100), allowance.PeriodSpendLimit[0].Amount)
+	s.Require().Equal(big.NewInt(50), allowance.PeriodCanSpend[0].Amount)
+	s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), allowance.PeriodReset)
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	s.grantAllowance(ctx, 0, 2)
+	s.grantAllowance(ctx, 1, 2)
+
+	bz, err := s.precompile.Allowances(ctx, &method, []interface{}{
+		s.keyring.GetAddr(2), query.PageRequest{Key: []byte{0}, CountTotal: true},
+	})
+	s.Require().NoError(err)
+
+	var out feegrant.AllowancesOutput
+	err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Len(out.Allowances, 2)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+	for _, allowance := range out.Allowances {
+		s.Require().Equal(s.keyring.GetAddr(2), allowance.Grantee)
+		s.Require().Equal(sdk.MsgTypeURL(&sdkfeegrant.BasicAllowance{}), allowance.AllowanceType)
+		s.Require().Empty(allowance.SpendLimit)
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowancesByGranter() {
+	method := s.precompile.Methods[feegrant.AllowancesByGranterMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	s.grantAllowance(ctx, 0, 1)
+	s.grantAllowance(ctx, 0, 2)
+	s.grantAllowance(ctx, 1, 2)
+
+	bz, err := s.precompile.AllowancesByGranter(ctx, &method, []interface{}{
+		s.keyring.GetAddr(0), query.PageRequest{Key: []byte{0}, CountTotal: true},
+	})
+	s.Require().NoError(err)
+
+	var out feegrant.AllowancesOutput
+	err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesByGranterMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Len(out.Allowances, 2)
+	for _, allowance := range out.Allowances {
+		s.Require().Equal(s.keyring.GetAddr(0), allowance.Granter)
+	}
+}
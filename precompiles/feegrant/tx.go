@@ -0,0 +1,147 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/precompiles/authorization"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GrantAllowanceMethod defines the ABI method name for the feegrant
+	// GrantAllowance transaction with a basic allowance.
+	GrantAllowanceMethod = "grantAllowance"
+	// GrantPeriodicAllowanceMethod defines the ABI method name for the
+	// feegrant GrantAllowance transaction with a periodic allowance.
+	GrantPeriodicAllowanceMethod = "grantPeriodicAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant
+	// RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+var (
+	// GrantAllowanceMsgURL defines the authorization type for MsgGrantAllowance
+	GrantAllowanceMsgURL = sdk.MsgTypeURL(&feegrant.MsgGrantAllowance{})
+	// RevokeAllowanceMsgURL defines the authorization type for MsgRevokeAllowance
+	RevokeAllowanceMsgURL = sdk.MsgTypeURL(&feegrant.MsgRevokeAllowance{})
+)
+
+// GrantAllowance grants a basic fee allowance from the granter to the grantee.
+func (p Precompile) GrantAllowance(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantAllowance(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, origin, contract, stateDB, method, granter, grantee, msg)
+}
+
+// GrantPeriodicAllowance grants a periodic fee allowance from the granter to
+// the grantee.
+func (p Precompile) GrantPeriodicAllowance(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantPeriodicAllowance(ctx, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, origin, contract, stateDB, method, granter, grantee, msg)
+}
+
+// grantAllowance is a common function that saves the allowance of the given
+// MsgGrantAllowance through the feegrant message server and emits the
+// GrantAllowance event.
+func (p Precompile) grantAllowance(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	granter, grantee common.Address,
+	msg *feegrant.MsgGrantAllowance,
+) ([]byte, error) {
+	if err := authorization.CheckOriginOrAuthzExists(ctx, p.AuthzKeeper, origin, contract.CallerAddress, granter, GrantAllowanceMsgURL); err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, allowance: %s }",
+			granter, grantee, msg.Allowance.TypeUrl,
+		),
+	)
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err := msgSrv.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantAllowanceEvent(ctx, stateDB, granter, grantee, msg.Allowance.TypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RevokeAllowance revokes the fee allowance given by the granter to the grantee.
+func (p Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgRevokeAllowance(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorization.CheckOriginOrAuthzExists(ctx, p.AuthzKeeper, origin, contract.CallerAddress, granter, RevokeAllowanceMsgURL); err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s }",
+			granter, grantee,
+		),
+	)
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err := msgSrv.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRevokeAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,312 @@
+package feegrant_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	spendLimit := []cmn.Coin{{Denom: evmtypes.GetEVMCoinDenom(), Amount: big.NewInt(1e18)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - empty grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), common.Address{}, spendLimit, int64(0)}
+			},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(-1)}
+			},
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - granter different from origin",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), s.keyring.GetAddr(1), spendLimit, int64(0)}
+			},
+			true,
+			"is not the same as the account address",
+		},
+		{
+			"fail - grant to itself",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0), spendLimit, int64(0)}
+			},
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"success - basic allowance without spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0)}
+			},
+			false,
+			"",
+		},
+		{
+			"success - basic allowance with spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			_, err := s.precompile.GrantAllowance(ctx, s.keyring.GetAddr(0), contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+			s.Require().NoError(err)
+			s.Require().IsType(&sdkfeegrant.BasicAllowance{}, allowance)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[feegrant.EventTypeGrantAllowance].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantAllowanceFromContract() {
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+
+	// NOTE: the account with index 2 acts as the calling contract
+	testCases := []struct {
+		name        string
+		granterIdx  int
+		malleate    func(ctx sdk.Context)
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the calling contract is the granter",
+			2,
+			func(sdk.Context) {},
+			false,
+			"",
+		},
+		{
+			"fail - the origin is the granter without approval",
+			0,
+			func(sdk.Context) {},
+			true,
+			"does not exist or is expired",
+		},
+		{
+			"success - the origin is the granter with approval",
+			0,
+			func(ctx sdk.Context) {
+				approveMethod := s.precompile.Methods[authorization.ApproveMethod]
+				contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+				_, err := s.precompile.Approve(ctx, contract.CallerAddress, s.network.GetStateDB(), &approveMethod, []interface{}{
+					s.keyring.GetAddr(2), []string{feegrant.GrantAllowanceMsgURL},
+				})
+				s.Require().NoError(err)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			tc.malleate(ctx)
+
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(2), s.precompile, 200000)
+
+			_, err := s.precompile.GrantAllowance(ctx, s.keyring.GetAddr(0), contract, stateDB, &method, []interface{}{
+				s.keyring.GetAddr(tc.granterIdx), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0),
+			})
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(tc.granterIdx), s.keyring.GetAccAddr(1))
+			s.Require().NoError(err)
+			s.Require().NotNil(allowance)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantPeriodicAllowance() {
+	method := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+	denom := evmtypes.GetEVMCoinDenom()
+	spendLimit := []cmn.Coin{{Denom: denom, Amount: big.NewInt(1e18)}}
+	periodSpendLimit := []cmn.Coin{{Denom: denom, Amount: big.NewInt(1e17)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			"fail - invalid period",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), int64(0), periodSpendLimit}
+			},
+			true,
+			"invalid period",
+		},
+		{
+			"fail - empty period spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), int64(3600), []cmn.Coin{}}
+			},
+			true,
+			"spend limit must be positive",
+		},
+		{
+			"success - periodic allowance",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), spendLimit, int64(0), int64(3600), periodSpendLimit}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			_, err := s.precompile.GrantPeriodicAllowance(ctx, s.keyring.GetAddr(0), contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+			s.Require().NoError(err)
+			periodic, ok := allowance.(*sdkfeegrant.PeriodicAllowance)
+			s.Require().True(ok)
+			s.Require().Equal(time.Hour, periodic.Period)
+			s.Require().Equal(ctx.BlockTime().Add(time.Hour), periodic.PeriodReset)
+			s.Require().Equal(periodic.PeriodSpendLimit, periodic.PeriodCanSpend)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[feegrant.EventTypeGrantAllowance].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(sdk.Context) []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - allowance not found",
+			func(sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - revoke existing allowance",
+			func(ctx sdk.Context) []interface{} {
+				s.grantAllowance(ctx, 0, 1)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			_, err := s.precompile.RevokeAllowance(ctx, s.keyring.GetAddr(0), contract, stateDB, &method, tc.malleate(ctx))
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			_, err = s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+			s.Require().Error(err)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.ABI.Events[feegrant.EventTypeRevokeAllowance].ID, logs[0].Topics[0])
+		})
+	}
+}
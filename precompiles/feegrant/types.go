@@ -0,0 +1,359 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+// Allowance defines the information of a fee allowance returned by the
+// allowance queries. The period fields are only populated for the periodic
+// allowances and the allowed messages for the allowed message allowances.
+type Allowance struct {
+	Granter          common.Address
+	Grantee          common.Address
+	AllowanceType    string
+	SpendLimit       []cmn.Coin
+	Expiration       int64
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+	PeriodCanSpend   []cmn.Coin
+	PeriodReset      int64
+	AllowedMessages  []string
+}
+
+// GrantAllowanceInput defines the input of the grantAllowance transaction.
+type GrantAllowanceInput struct {
+	Granter    common.Address
+	Grantee    common.Address
+	SpendLimit []cmn.Coin
+	Expiration int64
+}
+
+// GrantPeriodicAllowanceInput defines the input of the grantPeriodicAllowance transaction.
+type GrantPeriodicAllowanceInput struct {
+	Granter          common.Address
+	Grantee          common.Address
+	SpendLimit       []cmn.Coin
+	Expiration       int64
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+}
+
+// AllowancesInput defines the input of the allowances query.
+type AllowancesInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// AllowancesByGranterInput defines the input of the allowancesByGranter query.
+type AllowancesByGranterInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// AllowancesOutput defines the output of the allowances queries.
+type AllowancesOutput struct {
+	Allowances   []Allowance
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance with a basic allowance
+// from the call arguments.
+func NewMsgGrantAllowance(method *abi.Method, args []interface{}) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantAllowanceInput struct: %s", err)
+	}
+
+	basic, err := newBasicAllowance(input.Granter, input.Grantee, input.SpendLimit, input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := feegrant.NewMsgGrantAllowance(basic, input.Granter.Bytes(), input.Grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, input.Granter, input.Grantee, nil
+}
+
+// NewMsgGrantPeriodicAllowance creates a new MsgGrantAllowance with a periodic
+// allowance from the call arguments. The first period starts at the current
+// block time.
+func NewMsgGrantPeriodicAllowance(ctx sdk.Context, method *abi.Method, args []interface{}) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	var input GrantPeriodicAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantPeriodicAllowanceInput struct: %s", err)
+	}
+
+	basic, err := newBasicAllowance(input.Granter, input.Grantee, input.SpendLimit, input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	if input.Period <= 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidPeriod, input.Period)
+	}
+	period := time.Duration(input.Period) * time.Second
+
+	periodSpendLimit, err := toSDKCoins(input.PeriodSpendLimit)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      ctx.BlockTime().Add(period),
+	}
+
+	msg, err := feegrant.NewMsgGrantAllowance(periodic, input.Granter.Bytes(), input.Grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, input.Granter, input.Grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance from the call arguments.
+func NewMsgRevokeAllowance(args []interface{}) (*feegrant.MsgRevokeAllowance, common.Address, common.Address, error) {
+	granter, grantee, err := checkGranterGranteeArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := feegrant.NewMsgRevokeAllowance(granter.Bytes(), grantee.Bytes())
+	return &msg, granter, grantee, nil
+}
+
+// NewAllowanceRequest creates a new QueryAllowanceRequest from the call arguments.
+func NewAllowanceRequest(args []interface{}) (*feegrant.QueryAllowanceRequest, error) {
+	granter, grantee, err := checkGranterGranteeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: sdk.AccAddress(granter.Bytes()).String(),
+		Grantee: sdk.AccAddress(grantee.Bytes()).String(),
+	}, nil
+}
+
+// NewAllowancesRequest creates a new QueryAllowancesRequest from the call arguments.
+func NewAllowancesRequest(method *abi.Method, args []interface{}) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// NewAllowancesByGranterRequest creates a new QueryAllowancesByGranterRequest
+// from the call arguments.
+func NewAllowancesByGranterRequest(method *abi.Method, args []interface{}) (*feegrant.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	return &feegrant.QueryAllowancesByGranterRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// NewAllowance creates a new Allowance from the given feegrant Grant.
+func NewAllowance(grant *feegrant.Grant) (Allowance, error) {
+	granter, err := sdk.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return Allowance{}, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	feeAllowance, err := grant.GetGrant()
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	allowance := Allowance{
+		Granter:          common.BytesToAddress(granter),
+		Grantee:          common.BytesToAddress(grantee),
+		AllowanceType:    grant.Allowance.TypeUrl,
+		SpendLimit:       []cmn.Coin{},
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+		AllowedMessages:  []string{},
+	}
+
+	// NOTE: the allowed message allowance wraps a basic or periodic allowance
+	if allowedMsgAllowance, ok := feeAllowance.(*feegrant.AllowedMsgAllowance); ok {
+		allowance.AllowedMessages = allowedMsgAllowance.AllowedMessages
+		if feeAllowance, err = allowedMsgAllowance.GetAllowance(); err != nil {
+			return Allowance{}, err
+		}
+	}
+
+	switch a := feeAllowance.(type) {
+	case *feegrant.BasicAllowance:
+		allowance.setBasic(a)
+	case *feegrant.PeriodicAllowance:
+		allowance.setBasic(&a.Basic)
+		allowance.Period = int64(a.Period.Seconds())
+		allowance.PeriodSpendLimit = cmn.NewCoinsResponse(a.PeriodSpendLimit)
+		allowance.PeriodCanSpend = cmn.NewCoinsResponse(a.PeriodCanSpend)
+		allowance.PeriodReset = a.PeriodReset.Unix()
+	}
+
+	return allowance, nil
+}
+
+// setBasic sets the spend limit and expiration of the given basic allowance.
+func (a *Allowance) setBasic(basic *feegrant.BasicAllowance) {
+	a.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		a.Expiration = basic.Expiration.Unix()
+	}
+}
+
+// FromGrants populates the AllowancesOutput from the given feegrant grants.
+func (o *AllowancesOutput) FromGrants(grants []*feegrant.Grant, pageRes *query.PageResponse) (*AllowancesOutput, error) {
+	o.Allowances = make([]Allowance, len(grants))
+	for i, grant := range grants {
+		allowance, err := NewAllowance(grant)
+		if err != nil {
+			return nil, err
+		}
+		o.Allowances[i] = allowance
+	}
+
+	if pageRes != nil {
+		o.PageResponse.Total = pageRes.Total
+		o.PageResponse.NextKey = pageRes.NextKey
+	}
+
+	return o, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (o *AllowancesOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(o.Allowances, o.PageResponse)
+}
+
+// newBasicAllowance validates the granter and grantee addresses and creates a
+// new basic allowance with the given spend limit and expiration.
+func newBasicAllowance(granter, grantee common.Address, spendLimit []cmn.Coin, expiration int64) (*feegrant.BasicAllowance, error) {
+	if granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, granter)
+	}
+	if grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, grantee)
+	}
+
+	coins, err := toSDKCoins(spendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := &feegrant.BasicAllowance{SpendLimit: coins}
+	if expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	}
+	if expiration > 0 {
+		expirationTime := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &expirationTime
+	}
+
+	return basic, nil
+}
+
+// checkGranterGranteeArgs checks the granter and grantee call arguments.
+func checkGranterGranteeArgs(args []interface{}) (common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return granter, grantee, nil
+}
+
+// toSDKCoins converts the given coins to sorted SDK coins. An empty spend
+// limit means that the allowance has no limit.
+func toSDKCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	if len(coins) == 0 {
+		return nil, nil
+	}
+
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+		}
+		// NOTE: sdk.NewCoin panics on invalid coins, they are validated by the allowance
+		sdkCoins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+	return sdkCoins.Sort(), nil
+}
+
+// parsePageRequest returns the page request to use in the allowances queries.
+func parsePageRequest(pageRequest query.PageRequest) *query.PageRequest {
+	if bytes.Equal(pageRequest.Key, []byte{0}) {
+		pageRequest.Key = nil
+	}
+	return &pageRequest
+}
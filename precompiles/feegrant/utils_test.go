@@ -0,0 +1,18 @@
+package feegrant_test
+
+import (
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// grantAllowance is a helper function to give a basic fee allowance without
+// spend limit between two accounts of the keyring.
+func (s *PrecompileTestSuite) grantAllowance(ctx sdk.Context, granter, grantee int) {
+	err := s.network.App.FeeGrantKeeper.GrantAllowance(
+		ctx,
+		s.keyring.GetAccAddr(granter),
+		s.keyring.GetAccAddr(grantee),
+		&sdkfeegrant.BasicAllowance{},
+	)
+	s.Require().NoError(err)
+}
@@ -45,6 +45,26 @@ struct DepositData {
     Coin[] amount;
 }
 
+/// @dev Params defines the gov module params. The periods are expressed in seconds.
+struct Params {
+    Coin[] minDeposit;
+    int64 maxDepositPeriod;
+    int64 votingPeriod;
+    string quorum;
+    string threshold;
+    string vetoThreshold;
+    string minInitialDepositRatio;
+    string proposalCancelRatio;
+    string proposalCancelDest;
+    int64 expeditedVotingPeriod;
+    string expeditedThreshold;
+    Coin[] expeditedMinDeposit;
+    bool burnVoteQuorum;
+    bool burnProposalDepositPrevote;
+    bool burnVoteVeto;
+    string minDepositRatio;
+}
+
 /// @dev TallyResultData represents the tally result of a proposal
 struct TallyResultData {
     string yes;
@@ -75,6 +95,35 @@ struct ProposalData {
 /// @dev The interface through which solidity contracts will interact with Gov
 interface IGov {
 
+    /// @dev SubmitProposal defines an Event emitted when a proposal is submitted.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the id of the proposal
+    event SubmitProposal(address indexed proposer, uint64 proposalId);
+
+    /// @dev Deposit defines an Event emitted when a deposit is added to a proposal.
+    /// @param depositor the address of the depositor
+    /// @param proposalId the id of the proposal
+    /// @param amount the amount deposited
+    event Deposit(address indexed depositor, uint64 proposalId, Coin[] amount);
+
+    /// @dev CancelProposal defines an Event emitted when a proposal is canceled.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the id of the proposal
+    event CancelProposal(address indexed proposer, uint64 proposalId);
+
+    /// @dev Approval defines an Event emitted when a granter approves a grantee
+    /// to send gov messages on its behalf.
+    /// @param grantee the address of the grantee
+    /// @param granter the address of the granter
+    /// @param methods the message type URLs of the approved methods
+    event Approval(address indexed grantee, address indexed granter, string[] methods);
+
+    /// @dev Revocation defines an Event emitted when a granter revokes an approval.
+    /// @param grantee the address of the grantee
+    /// @param granter the address of the granter
+    /// @param methods the message type URLs of the revoked methods
+    event Revocation(address indexed grantee, address indexed granter, string[] methods);
+
     /// @dev Vote defines an Event emitted when a proposal voted.
     /// @param voter the address of the voter
     /// @param proposalId the proposal of id
@@ -89,6 +138,78 @@ interface IGov {
 
     /// TRANSACTIONS
 
+    /// @dev submitProposal defines a method to submit a proposal. The proposal
+    /// follows the JSON format of the gov submit-proposal CLI command, without the deposit.
+    /// @param proposer The address of the proposer
+    /// @param jsonProposal The JSON encoded proposal with its messages, metadata, title, summary and expedited flag
+    /// @param deposit The initial deposit of the proposal
+    /// @return proposalId The id of the submitted proposal
+    function submitProposal(
+        address proposer,
+        bytes calldata jsonProposal,
+        Coin[] calldata deposit
+    ) external returns (uint64 proposalId);
+
+    /// @dev submitProposalWithMessages defines a method to submit a proposal with
+    /// its messages encoded as protobuf bytes.
+    /// @param proposer The address of the proposer
+    /// @param messages The messages of the proposal
+    /// @param metadata The metadata of the proposal
+    /// @param title The title of the proposal
+    /// @param summary The summary of the proposal
+    /// @param expedited Whether the proposal is expedited or not
+    /// @param deposit The initial deposit of the proposal
+    /// @return proposalId The id of the submitted proposal
+    function submitProposalWithMessages(
+        address proposer,
+        CosmosMsg[] calldata messages,
+        string calldata metadata,
+        string calldata title,
+        string calldata summary,
+        bool expedited,
+        Coin[] calldata deposit
+    ) external returns (uint64 proposalId);
+
+    /// @dev deposit defines a method to add a deposit on a specific proposal.
+    /// @param depositor The address of the depositor
+    /// @param proposalId The proposal id
+    /// @param amount The amount to deposit
+    /// @return success Whether the transaction was successful or not
+    function deposit(
+        address depositor,
+        uint64 proposalId,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev cancelProposal defines a method to cancel a proposal. The remaining
+    /// deposits, after the cancellation charges, are refunded to the depositors.
+    /// @param proposer The address of the proposer
+    /// @param proposalId The proposal id
+    /// @return success Whether the transaction was successful or not
+    function cancelProposal(
+        address proposer,
+        uint64 proposalId
+    ) external returns (bool success);
+
+    /// @dev approve defines a method to grant the grantee an authorization to
+    /// submit, deposit on or cancel proposals on behalf of the caller.
+    /// @param grantee The address of the grantee
+    /// @param methods The message type URLs of the methods to approve
+    /// @return approved Whether the approval was successful or not
+    function approve(
+        address grantee,
+        string[] calldata methods
+    ) external returns (bool approved);
+
+    /// @dev revoke defines a method to revoke the authorizations given to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param methods The message type URLs of the methods to revoke
+    /// @return revoked Whether the revocation was successful or not
+    function revoke(
+        address grantee,
+        string[] calldata methods
+    ) external returns (bool revoked);
+
     /// @dev vote defines a method to add a vote on a specific proposal.
     /// @param voter The address of the voter
     /// @param proposalId the proposal of id
@@ -189,4 +310,12 @@ interface IGov {
         address depositor,
         PageRequest calldata pagination
     ) external view returns (ProposalData[] memory proposals, PageResponse memory pageResponse);
+
+    /// @dev getParams returns the gov module params.
+    /// @return params The gov params
+    function getParams() external view returns (Params memory params);
+
+    /// @dev getConstitution returns the chain constitution.
+    /// @return constitution The constitution
+    function getConstitution() external view returns (string memory constitution);
 }
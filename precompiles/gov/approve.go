@@ -0,0 +1,94 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+// Approve grants a generic authorization to the grantee to submit proposals,
+// deposit or cancel proposals on behalf of the caller, for the given message
+// type URLs.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	// the approve and revoke arguments are the same
+	grantee, typeURLs, err := authorization.CheckRevokeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	expiration := ctx.BlockTime().Add(p.ApprovalExpiration).UTC()
+	for _, typeURL := range typeURLs {
+		switch typeURL {
+		case SubmitProposalMsgURL, DepositMsgURL, CancelProposalMsgURL:
+			genericAuthz := authz.NewGenericAuthorization(typeURL)
+			if err := p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), origin.Bytes(), genericAuthz, &expiration); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "gov", typeURL)
+		}
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, grantee, origin, typeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke removes the authorizations given to the grantee by the caller for
+// the given message type URLs.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, typeURLs, err := authorization.CheckRevokeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range typeURLs {
+		switch typeURL {
+		case SubmitProposalMsgURL, DepositMsgURL, CancelProposalMsgURL:
+			if err = p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), origin.Bytes(), typeURL); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "gov", typeURL)
+		}
+	}
+
+	if err = authorization.EmitRevocationEvent(cmn.EmitEventArgs{
+		Ctx:            ctx,
+		StateDB:        stateDB,
+		ContractAddr:   p.Address(),
+		ContractEvents: p.ABI.Events,
+		EventData: authorization.EventRevocation{
+			Granter:  origin,
+			Grantee:  grantee,
+			TypeUrls: typeURLs,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
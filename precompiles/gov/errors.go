@@ -25,4 +25,12 @@ const (
 	ErrInvalidWeightedVoteOptionWeight = "invalid weighted vote option weight %s "
 	// ErrInvalidDepositor invalid depositor.
 	ErrInvalidDepositor = "invalid depositor %s "
+	// ErrInvalidProposer invalid proposer.
+	ErrInvalidProposer = "invalid proposer %s "
+	// ErrInvalidProposalJSON invalid proposal json.
+	ErrInvalidProposalJSON = "invalid proposal json: %s "
+	// ErrInvalidProposalMessages invalid proposal messages.
+	ErrInvalidProposalMessages = "invalid proposal messages: %s "
+	// ErrInvalidDeposit invalid deposit.
+	ErrInvalidDeposit = "invalid deposit %s "
 )
@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -16,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/evmos/evmos/v20/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
@@ -32,6 +34,7 @@ var f embed.FS
 type Precompile struct {
 	cmn.Precompile
 	govKeeper govkeeper.Keeper
+	codec     codec.Codec
 }
 
 // LoadABI loads the gov ABI from the embedded abi.json file
@@ -44,6 +47,7 @@ func LoadABI() (abi.ABI, error) {
 // PrecompiledContract interface.
 func NewPrecompile(
 	govKeeper govkeeper.Keeper,
+	cdc codec.Codec,
 	authzKeeper authzkeeper.Keeper,
 ) (*Precompile, error) {
 	abi, err := LoadABI()
@@ -60,6 +64,7 @@ func NewPrecompile(
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
 		},
 		govKeeper: govKeeper,
+		codec:     cdc,
 	}
 
 	// SetAddress defines the address of the gov precompiled contract.
@@ -98,10 +103,23 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 
 	switch method.Name {
 	// gov transactions
+	case SubmitProposalMethod:
+		bz, err = p.SubmitProposal(ctx, evm.Origin, contract, stateDB, method, args)
+	case SubmitProposalWithMessagesMethod:
+		bz, err = p.SubmitProposalWithMessages(ctx, evm.Origin, contract, stateDB, method, args)
+	case DepositMethod:
+		bz, err = p.Deposit(ctx, evm.Origin, contract, stateDB, method, args)
+	case CancelProposalMethod:
+		bz, err = p.CancelProposal(ctx, evm.Origin, contract, stateDB, method, args)
 	case VoteMethod:
 		bz, err = p.Vote(ctx, evm.Origin, contract, stateDB, method, args)
 	case VoteWeightedMethod:
 		bz, err = p.VoteWeighted(ctx, evm.Origin, contract, stateDB, method, args)
+	// gov authorization transactions
+	case authorization.ApproveMethod:
+		bz, err = p.Approve(ctx, evm.Origin, stateDB, method, args)
+	case authorization.RevokeMethod:
+		bz, err = p.Revoke(ctx, evm.Origin, stateDB, method, args)
 	// gov queries
 	case GetVoteMethod:
 		bz, err = p.GetVote(ctx, method, contract, args)
@@ -117,6 +135,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.GetProposal(ctx, method, contract, args)
 	case GetProposalsMethod:
 		bz, err = p.GetProposals(ctx, method, contract, args)
+	case GetParamsMethod:
+		bz, err = p.GetParams(ctx, method, contract, args)
+	case GetConstitutionMethod:
+		bz, err = p.GetConstitution(ctx, method, contract, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -141,11 +163,24 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 // IsTransaction checks if the given method name corresponds to a transaction or query.
 //
 // Available gov transactions are:
+//   - SubmitProposal
+//   - SubmitProposalWithMessages
+//   - Deposit
+//   - CancelProposal
 //   - Vote
 //   - VoteWeighted
+//   - Approve
+//   - Revoke
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case VoteMethod, VoteWeightedMethod:
+	case SubmitProposalMethod,
+		SubmitProposalWithMessagesMethod,
+		DepositMethod,
+		CancelProposalMethod,
+		VoteMethod,
+		VoteWeightedMethod,
+		authorization.ApproveMethod,
+		authorization.RevokeMethod:
 		return true
 	default:
 		return false
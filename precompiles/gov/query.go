@@ -4,9 +4,13 @@
 package gov
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 )
 
@@ -25,6 +29,10 @@ const (
 	GetProposalMethod = "getProposal"
 	// GetProposalsMethod defines the method name for the proposals precompile request.
 	GetProposalsMethod = "getProposals"
+	// GetParamsMethod defines the method name for the params precompile request.
+	GetParamsMethod = "getParams"
+	// GetConstitutionMethod defines the method name for the constitution precompile request.
+	GetConstitutionMethod = "getConstitution"
 )
 
 // GetVotes implements the query logic for getting votes for a proposal.
@@ -182,3 +190,44 @@ func (p *Precompile) GetProposals(
 
 	return method.Outputs.Pack(output.Proposals, output.PageResponse)
 }
+
+// GetParams implements the query logic for getting the gov params
+func (p *Precompile) GetParams(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	queryServer := govkeeper.NewQueryServer(&p.govKeeper)
+	res, err := queryServer.Params(ctx, &govv1.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	output := new(ParamsOutput).FromResponse(res)
+	return method.Outputs.Pack(output.Params)
+}
+
+// GetConstitution implements the query logic for getting the constitution
+func (p *Precompile) GetConstitution(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	queryServer := govkeeper.NewQueryServer(&p.govKeeper)
+	res, err := queryServer.Constitution(ctx, &govv1.QueryConstitutionRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Constitution)
+}
@@ -584,3 +584,40 @@ func (s *PrecompileTestSuite) TestGetProposals() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestGetParams() {
+	method := s.precompile.Methods[gov.GetParamsMethod]
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+	bz, err := s.precompile.GetParams(ctx, &method, contract, []interface{}{})
+	s.Require().NoError(err)
+
+	var out gov.ParamsOutput
+	err = s.precompile.UnpackIntoInterface(&out, gov.GetParamsMethod, bz)
+	s.Require().NoError(err)
+
+	params, err := s.network.App.GovKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.NewCoinsResponse(params.MinDeposit), out.Params.MinDeposit)
+	s.Require().Equal(int64(params.VotingPeriod.Seconds()), out.Params.VotingPeriod)
+	s.Require().Equal(params.Quorum, out.Params.Quorum)
+	s.Require().Equal(params.ProposalCancelRatio, out.Params.ProposalCancelRatio)
+
+	_, err = s.precompile.GetParams(ctx, &method, contract, []interface{}{uint64(1)})
+	s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1))
+}
+
+func (s *PrecompileTestSuite) TestGetConstitution() {
+	method := s.precompile.Methods[gov.GetConstitutionMethod]
+
+	ctx := s.network.GetContext()
+	s.Require().NoError(s.network.App.GovKeeper.Constitution.Set(ctx, "constitution"))
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+	bz, err := s.precompile.GetConstitution(ctx, &method, contract, []interface{}{})
+	s.Require().NoError(err)
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal("constitution", out[0].(string))
+}
@@ -7,21 +7,199 @@ import (
 	"fmt"
 
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var (
+	// SubmitProposalMsgURL defines the authorization type for MsgSubmitProposal
+	SubmitProposalMsgURL = sdk.MsgTypeURL(&govv1.MsgSubmitProposal{})
+	// DepositMsgURL defines the authorization type for MsgDeposit
+	DepositMsgURL = sdk.MsgTypeURL(&govv1.MsgDeposit{})
+	// CancelProposalMsgURL defines the authorization type for MsgCancelProposal
+	CancelProposalMsgURL = sdk.MsgTypeURL(&govv1.MsgCancelProposal{})
 )
 
 const (
+	// SubmitProposalMethod defines the ABI method name for the gov SubmitProposal transaction.
+	SubmitProposalMethod = "submitProposal"
+	// SubmitProposalWithMessagesMethod defines the ABI method name for the gov SubmitProposal
+	// transaction with ABI-encoded messages.
+	SubmitProposalWithMessagesMethod = "submitProposalWithMessages"
+	// DepositMethod defines the ABI method name for the gov Deposit transaction.
+	DepositMethod = "deposit"
+	// CancelProposalMethod defines the ABI method name for the gov CancelProposal transaction.
+	CancelProposalMethod = "cancelProposal"
 	// VoteMethod defines the ABI method name for the gov Vote transaction.
 	VoteMethod = "vote"
 	// VoteWeightedMethod defines the ABI method name for the gov VoteWeighted transaction.
 	VoteWeightedMethod = "voteWeighted"
 )
 
+// SubmitProposal defines a method to submit a proposal with its messages
+// encoded as JSON.
+func (p *Precompile) SubmitProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgSubmitProposal(p.codec, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.submitProposal(ctx, origin, contract, stateDB, method, msg, proposerHexAddr)
+}
+
+// SubmitProposalWithMessages defines a method to submit a proposal with its
+// messages encoded as protobuf bytes in ABI structs.
+func (p *Precompile) SubmitProposalWithMessages(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgSubmitProposalWithMessages(p.codec, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.submitProposal(ctx, origin, contract, stateDB, method, msg, proposerHexAddr)
+}
+
+// submitProposal submits the given proposal and returns the packed proposal id.
+func (p *Precompile) submitProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *govv1.MsgSubmitProposal,
+	proposerHexAddr common.Address,
+) ([]byte, error) {
+	if err := authorization.CheckOriginOrAuthzExists(ctx, p.AuthzKeeper, origin, contract.CallerAddress, proposerHexAddr, SubmitProposalMsgURL); err != nil {
+		return nil, err
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	res, err := msgSrv.SubmitProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.setSpentBalanceEntry(origin, contract, proposerHexAddr, msg.InitialDeposit)
+
+	if err = p.EmitSubmitProposalEvent(ctx, stateDB, proposerHexAddr, res.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ProposalId)
+}
+
+// Deposit defines a method to add a deposit on a specific proposal.
+func (p *Precompile) Deposit(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositorHexAddr, err := NewMsgDeposit(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorization.CheckOriginOrAuthzExists(ctx, p.AuthzKeeper, origin, contract.CallerAddress, depositorHexAddr, DepositMsgURL); err != nil {
+		return nil, err
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Deposit(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	p.setSpentBalanceEntry(origin, contract, depositorHexAddr, msg.Amount)
+
+	if err = p.EmitDepositEvent(ctx, stateDB, depositorHexAddr, msg.ProposalId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelProposal defines a method to cancel a proposal. The remaining deposits,
+// after the cancellation charges, are refunded to the depositors.
+func (p *Precompile) CancelProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgCancelProposal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorization.CheckOriginOrAuthzExists(ctx, p.AuthzKeeper, origin, contract.CallerAddress, proposerHexAddr, CancelProposalMsgURL); err != nil {
+		return nil, err
+	}
+
+	// NOTE: the events of the cancellation are captured to mirror in the EVM
+	// stateDB the refunds of every depositor, along with the charges sent to
+	// the burn or destination accounts
+	eventManager := sdk.NewEventManager()
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.CancelProposal(ctx.WithEventManager(eventManager), msg); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(eventManager.Events())
+
+	if err := p.SetBalanceChangeEntriesFromEvents(eventManager.Events()); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCancelProposalEvent(ctx, stateDB, proposerHexAddr, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// setSpentBalanceEntry mirrors in the EVM stateDB the EVM denom coins spent
+// by the sender when the precompile is called from a smart contract.
+func (p *Precompile) setSpentBalanceEntry(origin common.Address, contract *vm.Contract, sender common.Address, coins sdk.Coins) {
+	if contract.CallerAddress == origin {
+		return
+	}
+
+	amount := coins.AmountOf(evmtypes.GetEVMCoinDenom())
+	if !amount.IsPositive() {
+		return
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract.
+	// Need to scale the amount to 18 decimals for the EVM balance change entry
+	scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt())
+	p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(sender, scaledAmt, cmn.Sub))
+}
+
 // Vote defines a method to add a vote on a specific proposal.
 func (p Precompile) Vote(
 	ctx sdk.Context,
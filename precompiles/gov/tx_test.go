@@ -2,12 +2,16 @@ package gov_test
 
 import (
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/evmos/evmos/v20/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/precompiles/gov"
 	"github.com/evmos/evmos/v20/precompiles/testutil"
@@ -276,3 +280,320 @@ func (s *PrecompileTestSuite) TestVoteWeighted() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestSubmitProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.SubmitProposalMethod]
+	newProposerAddr := utiltx.GenerateAddress()
+
+	jsonProposal := func(typeURL string) []byte {
+		return []byte(fmt.Sprintf(
+			`{"messages":[{"@type":"%s","from_address":"%s","to_address":"%s","amount":[{"denom":"%s","amount":"1000"}]}],"metadata":"ipfs://CID","title":"test prop","summary":"test prop"}`,
+			typeURL, govAcct, addr, s.network.GetBaseDenom(),
+		))
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					newProposerAddr,
+					jsonProposal("/cosmos.bank.v1beta1.MsgSend"),
+					[]cmn.Coin{},
+				}
+			},
+			func([]byte) {},
+			true,
+			"is not the same as the account address",
+		},
+		{
+			"fail - invalid proposal json",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]byte("{"),
+					[]cmn.Coin{},
+				}
+			},
+			func([]byte) {},
+			true,
+			"invalid proposal json",
+		},
+		{
+			"fail - unknown message type",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					jsonProposal("/cosmos.bank.v1beta1.MsgUnknown"),
+					[]cmn.Coin{},
+				}
+			},
+			func([]byte) {},
+			true,
+			"invalid proposal messages",
+		},
+		{
+			"success - submit proposal with deposit",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					jsonProposal("/cosmos.bank.v1beta1.MsgSend"),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+				}
+			},
+			func(bz []byte) {
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				proposalID, ok := out[0].(uint64)
+				s.Require().True(ok)
+
+				proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), proposal.Proposer)
+				s.Require().Equal("test prop", proposal.Title)
+				s.Require().Len(proposal.Messages, 1)
+				s.Require().Equal(govv1.StatusVotingPeriod, proposal.Status)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, err := s.precompile.SubmitProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSubmitProposalWithMessages() {
+	method := s.precompile.Methods[gov.SubmitProposalWithMessagesMethod]
+
+	msgBz, err := s.network.App.AppCodec().Marshal(TestProposalMsgs[0])
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		msgs        []cmn.CosmosMsg
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - unknown message type",
+			[]cmn.CosmosMsg{{TypeUrl: "/cosmos.bank.v1beta1.MsgUnknown", Value: msgBz}},
+			true,
+			"invalid proposal messages",
+		},
+		{
+			"success - submit proposal with protobuf messages",
+			[]cmn.CosmosMsg{{TypeUrl: sdk.MsgTypeURL(TestProposalMsgs[0]), Value: msgBz}},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			args := []interface{}{
+				s.keyring.GetAddr(0),
+				tc.msgs,
+				"ipfs://CID",
+				"test prop",
+				"test prop",
+				false,
+				[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(10)}},
+			}
+			bz, err := s.precompile.SubmitProposalWithMessages(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, out[0].(uint64))
+			s.Require().NoError(err)
+			s.Require().Equal(govv1.StatusDepositPeriod, proposal.Status)
+			s.Require().Equal(sdk.MsgTypeURL(TestProposalMsgs[0]), proposal.Messages[0].TypeUrl)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.DepositMethod]
+	const proposalID uint64 = 2
+
+	testCases := []struct {
+		name        string
+		caller      func() common.Address
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - empty deposit",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), proposalID, []cmn.Coin{}}
+			},
+			true,
+			"invalid deposit",
+		},
+		{
+			"fail - contract caller without authorization",
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(10)}},
+				}
+			},
+			true,
+			"does not exist or is expired",
+		},
+		{
+			"success - contract caller with authorization",
+			func() common.Address {
+				approveMethod := s.precompile.Methods[authorization.ApproveMethod]
+				_, err := s.precompile.Approve(ctx, s.keyring.GetAddr(0), s.network.GetStateDB(), &approveMethod, []interface{}{
+					s.keyring.GetAddr(1), []string{gov.DepositMsgURL},
+				})
+				s.Require().NoError(err)
+				return s.keyring.GetAddr(1)
+			},
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(10)}},
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - deposit on proposal",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(10)}},
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, tc.caller(), s.precompile, 200000)
+
+			_, err := s.precompile.Deposit(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				deposit, err := s.network.App.GovKeeper.Deposits.Get(ctx, collections.Join(proposalID, s.keyring.GetAccAddr(0)))
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(10).String(), sdk.Coins(deposit.Amount).AmountOf(s.network.GetBaseDenom()).String())
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.CancelProposalMethod]
+
+	testCases := []struct {
+		name        string
+		proposalID  uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - not the proposer",
+			2,
+			true,
+			"invalid proposer",
+		},
+		{
+			"success - cancel proposal",
+			1,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+			_, err := s.precompile.CancelProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(0), tc.proposalID})
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				_, err := s.network.App.GovKeeper.Proposals.Get(ctx, tc.proposalID)
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -4,8 +4,12 @@
 package gov
 
 import (
+	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -31,6 +35,36 @@ type EventVoteWeighted struct {
 	Options    WeightedVoteOptions
 }
 
+// EventSubmitProposal defines the event data for the SubmitProposal transaction.
+type EventSubmitProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+}
+
+// EventDeposit defines the event data for the Deposit transaction.
+type EventDeposit struct {
+	Depositor  common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+	Amount     []cmn.Coin
+}
+
+// EventCancelProposal defines the event data for the CancelProposal transaction.
+type EventCancelProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+}
+
+// jsonProposal defines the JSON proposal accepted by the SubmitProposal
+// transaction. It follows the proposal file format of the gov submit-proposal
+// CLI command, with the deposit passed as a separate argument.
+type jsonProposal struct {
+	Messages  []json.RawMessage `json:"messages,omitempty"`
+	Metadata  string            `json:"metadata"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
 // VotesInput defines the input for the Votes query.
 type VotesInput struct {
 	ProposalId uint64 //nolint:revive,stylecheck
@@ -108,6 +142,204 @@ type TallyResultData struct {
 	NoWithVeto string
 }
 
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance from a JSON
+// proposal.
+func NewMsgSubmitProposal(cdc codec.Codec, method *abi.Method, args []interface{}) (*govv1.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	proposalBz, ok := args[1].([]byte)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, args[1])
+	}
+
+	var proposal jsonProposal
+	if err := json.Unmarshal(proposalBz, &proposal); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, err)
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, msgBz := range proposal.Messages {
+		if err := cdc.UnmarshalInterfaceJSON(msgBz, &msgs[i]); err != nil {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalMessages, err)
+		}
+	}
+
+	deposit, err := parseCoinsArg(method.Inputs[2], args[2])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := govv1.NewMsgSubmitProposal(
+		msgs,
+		deposit,
+		sdk.AccAddress(proposerAddress.Bytes()).String(),
+		proposal.Metadata,
+		proposal.Title,
+		proposal.Summary,
+		proposal.Expedited,
+	)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalMessages, err)
+	}
+
+	return msg, proposerAddress, nil
+}
+
+// NewMsgSubmitProposalWithMessages creates a new MsgSubmitProposal instance
+// from ABI-encoded Cosmos messages.
+func NewMsgSubmitProposalWithMessages(cdc codec.Codec, method *abi.Method, args []interface{}) (*govv1.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	var cosmosMsgs []cmn.CosmosMsg
+	arguments := abi.Arguments{method.Inputs[1]}
+	if err := arguments.Copy(&cosmosMsgs, []interface{}{args[1]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to CosmosMsg struct: %s", err)
+	}
+
+	msgs := make([]sdk.Msg, len(cosmosMsgs))
+	for i, cosmosMsg := range cosmosMsgs {
+		anyMsg := &codectypes.Any{TypeUrl: cosmosMsg.TypeUrl, Value: cosmosMsg.Value}
+		if err := cdc.UnpackAny(anyMsg, &msgs[i]); err != nil {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalMessages, err)
+		}
+	}
+
+	metadata, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMetadata, args[2])
+	}
+
+	title, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "title", "", args[3])
+	}
+
+	summary, ok := args[4].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "summary", "", args[4])
+	}
+
+	expedited, ok := args[5].(bool)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "expedited", false, args[5])
+	}
+
+	deposit, err := parseCoinsArg(method.Inputs[6], args[6])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := govv1.NewMsgSubmitProposal(
+		msgs,
+		deposit,
+		sdk.AccAddress(proposerAddress.Bytes()).String(),
+		metadata,
+		title,
+		summary,
+		expedited,
+	)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalMessages, err)
+	}
+
+	return msg, proposerAddress, nil
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance.
+func NewMsgDeposit(method *abi.Method, args []interface{}) (*govv1.MsgDeposit, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	depositorAddress, ok := args[0].(common.Address)
+	if !ok || depositorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDepositor, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	amount, err := parseCoinsArg(method.Inputs[2], args[2])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	if amount.IsZero() {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDeposit, amount)
+	}
+
+	msg := &govv1.MsgDeposit{
+		ProposalId: proposalID,
+		Depositor:  sdk.AccAddress(depositorAddress.Bytes()).String(),
+		Amount:     amount,
+	}
+
+	return msg, depositorAddress, nil
+}
+
+// NewMsgCancelProposal creates a new MsgCancelProposal instance.
+func NewMsgCancelProposal(args []interface{}) (*govv1.MsgCancelProposal, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	msg := &govv1.MsgCancelProposal{
+		ProposalId: proposalID,
+		Proposer:   sdk.AccAddress(proposerAddress.Bytes()).String(),
+	}
+
+	return msg, proposerAddress, nil
+}
+
+// parseCoinsArg unpacks a Coin[] argument into a valid set of sdk.Coins.
+func parseCoinsArg(input abi.Argument, arg interface{}) (sdk.Coins, error) {
+	var coins []cmn.Coin
+	arguments := abi.Arguments{input}
+	if err := arguments.Copy(&coins, []interface{}{arg}); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to Coin struct: %s", err)
+	}
+
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(ErrInvalidDeposit, coins)
+		}
+		// NOTE: sdk.NewCoin panics on invalid coins, they are validated below
+		sdkCoins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidDeposit, err)
+	}
+
+	return sdkCoins, nil
+}
+
 // NewMsgVote creates a new MsgVote instance.
 func NewMsgVote(args []interface{}) (*govv1.MsgVote, common.Address, error) {
 	if len(args) != 4 {
@@ -569,3 +801,67 @@ func (po *ProposalsOutput) FromResponse(res *govv1.QueryProposalsResponse) *Prop
 	}
 	return po
 }
+
+// ParamsOutput defines the output for the Params query.
+type ParamsOutput struct {
+	Params ParamsData
+}
+
+// ParamsData represents the gov module params. The periods are expressed in
+// seconds.
+type ParamsData struct {
+	MinDeposit                 []cmn.Coin `abi:"minDeposit"`
+	MaxDepositPeriod           int64      `abi:"maxDepositPeriod"`
+	VotingPeriod               int64      `abi:"votingPeriod"`
+	Quorum                     string     `abi:"quorum"`
+	Threshold                  string     `abi:"threshold"`
+	VetoThreshold              string     `abi:"vetoThreshold"`
+	MinInitialDepositRatio     string     `abi:"minInitialDepositRatio"`
+	ProposalCancelRatio        string     `abi:"proposalCancelRatio"`
+	ProposalCancelDest         string     `abi:"proposalCancelDest"`
+	ExpeditedVotingPeriod      int64      `abi:"expeditedVotingPeriod"`
+	ExpeditedThreshold         string     `abi:"expeditedThreshold"`
+	ExpeditedMinDeposit        []cmn.Coin `abi:"expeditedMinDeposit"`
+	BurnVoteQuorum             bool       `abi:"burnVoteQuorum"`
+	BurnProposalDepositPrevote bool       `abi:"burnProposalDepositPrevote"`
+	BurnVoteVeto               bool       `abi:"burnVoteVeto"`
+	MinDepositRatio            string     `abi:"minDepositRatio"`
+}
+
+func (po *ParamsOutput) FromResponse(res *govv1.QueryParamsResponse) *ParamsOutput {
+	params := res.Params
+	if params == nil {
+		params = &govv1.Params{}
+	}
+
+	var maxDepositPeriod, votingPeriod, expeditedVotingPeriod int64
+	if params.MaxDepositPeriod != nil {
+		maxDepositPeriod = int64(params.MaxDepositPeriod.Seconds())
+	}
+	if params.VotingPeriod != nil {
+		votingPeriod = int64(params.VotingPeriod.Seconds())
+	}
+	if params.ExpeditedVotingPeriod != nil {
+		expeditedVotingPeriod = int64(params.ExpeditedVotingPeriod.Seconds())
+	}
+
+	po.Params = ParamsData{
+		MinDeposit:                 cmn.NewCoinsResponse(params.MinDeposit),
+		MaxDepositPeriod:           maxDepositPeriod,
+		VotingPeriod:               votingPeriod,
+		Quorum:                     params.Quorum,
+		Threshold:                  params.Threshold,
+		VetoThreshold:              params.VetoThreshold,
+		MinInitialDepositRatio:     params.MinInitialDepositRatio,
+		ProposalCancelRatio:        params.ProposalCancelRatio,
+		ProposalCancelDest:         params.ProposalCancelDest,
+		ExpeditedVotingPeriod:      expeditedVotingPeriod,
+		ExpeditedThreshold:         params.ExpeditedThreshold,
+		ExpeditedMinDeposit:        cmn.NewCoinsResponse(params.ExpeditedMinDeposit),
+		BurnVoteQuorum:             params.BurnVoteQuorum,
+		BurnProposalDepositPrevote: params.BurnProposalDepositPrevote,
+		BurnVoteVeto:               params.BurnVoteVeto,
+		MinDepositRatio:            params.MinDepositRatio,
+	}
+	return po
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IICA contract's address.
+address constant ICA_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The IICA contract's instance.
+IICA constant ICA_CONTRACT = IICA(ICA_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Interchain Accounts Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// ICS-27 interchain accounts controller to register and control accounts on other chains.
+/// @custom:address 0x000000000000000000000000000000000000080a
+interface IICA {
+    /// @dev RegisterInterchainAccount defines an Event emitted when an interchain account is registered.
+    /// @param owner the address of the interchain account owner
+    /// @param connectionId the identifier of the connection to the host chain
+    /// @param portId the identifier of the controller port of the owner
+    event RegisterInterchainAccount(
+        address indexed owner,
+        string connectionId,
+        string portId
+    );
+
+    /// @dev SendTx defines an Event emitted when a transaction is sent to an interchain account.
+    /// @param owner the address of the interchain account owner
+    /// @param connectionId the identifier of the connection to the host chain
+    /// @param sequence the sequence of the sent packet
+    event SendTx(
+        address indexed owner,
+        string connectionId,
+        uint64 sequence
+    );
+
+    /// @dev registerInterchainAccount defines a method for registering an interchain
+    /// account owned by the caller on the host chain of the given connection.
+    /// The caller receives the results of the sent transactions through the
+    /// IICACallbacks interface if it is a contract.
+    /// @param connectionId the identifier of the connection to the host chain
+    /// @return success true if the channel handshake was initiated successfully
+    function registerInterchainAccount(
+        string calldata connectionId
+    ) external returns (bool success);
+
+    /// @dev sendTx defines a method for sending Cosmos messages to be executed by
+    /// the interchain account of the caller on the host chain of the given connection.
+    /// @param connectionId the identifier of the connection to the host chain
+    /// @param msgs the protobuf encoded messages to execute on the host chain
+    /// @param timeoutTimestamp the unix timestamp in nanoseconds after which the packet times out
+    /// @return sequence the sequence of the sent packet
+    function sendTx(
+        string calldata connectionId,
+        CosmosMsg[] calldata msgs,
+        uint64 timeoutTimestamp
+    ) external returns (uint64 sequence);
+
+    /// @dev interchainAccountAddress defines a method for querying the address on
+    /// the host chain of the interchain account of the given owner.
+    /// @param owner the address of the interchain account owner
+    /// @param connectionId the identifier of the connection to the host chain
+    /// @return accountAddress the address of the interchain account, empty if not registered
+    function interchainAccountAddress(
+        address owner,
+        string calldata connectionId
+    ) external view returns (string memory accountAddress);
+}
+
+/// @author Evmos Team
+/// @title Interchain Accounts Callbacks
+/// @dev The interface that the contracts owning interchain accounts implement to
+/// receive the results of the sent transactions. The callbacks are called by the
+/// ICA precompile address with a bounded amount of gas and their failure doesn't
+/// affect the packet lifecycle.
+interface IICACallbacks {
+    /// @dev onICAAcknowledgement is called when a packet sent by the contract is acknowledged.
+    /// @param channelId the identifier of the source channel of the packet
+    /// @param sequence the sequence of the packet
+    /// @param success true if the transaction was executed successfully on the host chain
+    /// @param result the result of the transaction, or the error message if it failed
+    function onICAAcknowledgement(
+        string calldata channelId,
+        uint64 sequence,
+        bool success,
+        bytes calldata result
+    ) external;
+
+    /// @dev onICATimeout is called when a packet sent by the contract times out.
+    /// @param channelId the identifier of the source channel of the packet
+    /// @param sequence the sequence of the packet
+    function onICATimeout(string calldata channelId, uint64 sequence) external;
+}
@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+const (
+	// ErrInvalidConnectionID is raised when the connection identifier is not valid.
+	ErrInvalidConnectionID = "invalid connection ID: %v"
+	// ErrInvalidOwner is raised when the interchain account owner address is not valid.
+	ErrInvalidOwner = "invalid owner address: %v"
+	// ErrInvalidTimeoutTimestamp is raised when the packet timeout timestamp is not valid.
+	ErrInvalidTimeoutTimestamp = "invalid timeout timestamp: %v"
+	// ErrInvalidMsgs is raised when the messages to send cannot be encoded.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrNoMsgs is raised when there are no messages to send.
+	ErrNoMsgs = "no messages to send"
+	// ErrNoActiveChannel is raised when there is no open channel for the interchain account.
+	ErrNoActiveChannel = "no active channel for connection %s and port %s"
+	// ErrUnsupportedEncoding is raised when the interchain account channel does not use protobuf encoding.
+	ErrUnsupportedEncoding = "unsupported interchain account encoding %s, only %s is supported"
+)
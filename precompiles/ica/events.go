@@ -0,0 +1,92 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeRegisterInterchainAccount defines the event type for the
+	// RegisterInterchainAccount transaction.
+	EventTypeRegisterInterchainAccount = "RegisterInterchainAccount"
+	// EventTypeSendTx defines the event type for the SendTx transaction.
+	EventTypeSendTx = "SendTx"
+)
+
+// EmitRegisterInterchainAccountEvent creates a new event emitted on the
+// RegisterInterchainAccount transaction.
+func (p Precompile) EmitRegisterInterchainAccountEvent(ctx sdk.Context, stateDB vm.StateDB, owner common.Address, connectionID, portID string) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeRegisterInterchainAccount]
+	topics, err := createOwnerTopics(event, owner)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(connectionID, portID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitSendTxEvent creates a new event emitted on the SendTx transaction.
+func (p Precompile) EmitSendTxEvent(ctx sdk.Context, stateDB vm.StateDB, owner common.Address, connectionID string, sequence uint64) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeSendTx]
+	topics, err := createOwnerTopics(event, owner)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(connectionID, sequence)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// createOwnerTopics creates the topics for the events with the indexed
+// interchain account owner.
+func createOwnerTopics(event abi.Event, owner common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
@@ -0,0 +1,263 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// CallbackGasLimit defines the maximum amount of gas that the owner
+	// contract can consume when receiving a packet callback.
+	CallbackGasLimit uint64 = 300_000
+
+	// OnAcknowledgementCallback defines the ABI method name of the callback
+	// called on the owner contract when a packet is acknowledged.
+	OnAcknowledgementCallback = "onICAAcknowledgement"
+	// OnTimeoutCallback defines the ABI method name of the callback called
+	// on the owner contract when a packet times out.
+	OnTimeoutCallback = "onICATimeout"
+
+	// EventTypeCallback defines the event type for the packet callbacks.
+	EventTypeCallback = "ica_callback"
+	// AttributeKeyOwner defines the attribute key for the interchain account owner.
+	AttributeKeyOwner = "owner"
+	// AttributeKeyChannel defines the attribute key for the packet source channel.
+	AttributeKeyChannel = "channel"
+	// AttributeKeySequence defines the attribute key for the packet sequence.
+	AttributeKeySequence = "sequence"
+	// AttributeKeyCallback defines the attribute key for the called method.
+	AttributeKeyCallback = "callback"
+	// AttributeKeyError defines the attribute key for the callback error.
+	AttributeKeyError = "error"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// EVMKeeper defines the expected EVM keeper interface used to call back the
+// interchain account owner contracts.
+type EVMKeeper interface {
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	CallEVMWithGasLimit(ctx sdk.Context, from, contract common.Address, data []byte, gasLimit uint64) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// IBCModule is the authentication module underneath the interchain accounts
+// controller middleware for the accounts registered through the precompile.
+// It forwards the packet acknowledgements and timeouts to the owner contracts.
+type IBCModule struct {
+	evmKeeper    EVMKeeper
+	callbacksABI abi.ABI
+}
+
+// NewIBCModule creates a new IBCModule given the EVM keeper.
+func NewIBCModule(evmKeeper EVMKeeper) IBCModule {
+	callbacksABI, err := LoadCallbacksABI()
+	if err != nil {
+		panic(err)
+	}
+
+	return IBCModule{
+		evmKeeper:    evmKeeper,
+		callbacksABI: callbacksABI,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface. The version is
+// negotiated by the controller middleware.
+func (IBCModule) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (IBCModule) OnChanOpenTry(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (IBCModule) OnChanOpenAck(
+	_ sdk.Context,
+	_,
+	_ string,
+	_ string,
+	_ string,
+) error {
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (IBCModule) OnChanOpenConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (IBCModule) OnChanCloseInit(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (IBCModule) OnChanCloseConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The controller chain does
+// not receive packets.
+func (IBCModule) OnRecvPacket(
+	_ sdk.Context,
+	_ channeltypes.Packet,
+	_ sdk.AccAddress,
+) exported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(
+		errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"),
+	)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It calls the
+// onICAAcknowledgement callback on the owner contract with the result or the
+// error of the executed transaction.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+
+	result := ack.GetResult()
+	if !ack.Success() {
+		result = []byte(ack.GetError())
+	}
+
+	im.callback(ctx, packet, OnAcknowledgementCallback, packet.SourceChannel, packet.Sequence, ack.Success(), result)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It calls the
+// onICATimeout callback on the owner contract.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	im.callback(ctx, packet, OnTimeoutCallback, packet.SourceChannel, packet.Sequence)
+	return nil
+}
+
+// callback calls the given method on the owner contract of the packet source
+// port with a bounded amount of gas. The state changes of the callback are
+// only committed if the call succeeds and a failed callback never fails the
+// packet lifecycle, so that a misbehaving contract cannot block the channel.
+func (im IBCModule) callback(ctx sdk.Context, packet channeltypes.Packet, method string, args ...interface{}) {
+	owner, ok := ownerFromPortID(packet.SourcePort)
+	if !ok {
+		return
+	}
+
+	// NOTE: the interchain accounts registered by externally owned accounts
+	// don't receive callbacks.
+	account := im.evmKeeper.GetAccountWithoutBalance(ctx, owner)
+	if account == nil || !account.IsContract() {
+		return
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyOwner, owner.String()),
+		sdk.NewAttribute(AttributeKeyChannel, packet.SourceChannel),
+		sdk.NewAttribute(AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(AttributeKeyCallback, method),
+	}
+
+	err := im.callContract(ctx, owner, method, args...)
+	if err != nil {
+		attrs = append(attrs, sdk.NewAttribute(AttributeKeyError, err.Error()))
+		ctx.Logger().Error(
+			"interchain account callback failed",
+			"owner", owner.String(),
+			"callback", method,
+			"error", err.Error(),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeCallback, attrs...))
+}
+
+// callContract packs the callback data and calls the owner contract from the
+// precompile address with at most CallbackGasLimit gas, which is charged to
+// the relayer.
+func (im IBCModule) callContract(ctx sdk.Context, owner common.Address, method string, args ...interface{}) error {
+	data, err := im.callbacksABI.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+
+	_, err = im.evmKeeper.CallEVMWithGasLimit(
+		ctx,
+		common.HexToAddress(evmtypes.ICAPrecompileAddress),
+		owner,
+		data,
+		CallbackGasLimit,
+	)
+	return err
+}
+
+// ownerFromPortID returns the EVM address of the interchain account owner
+// from the controller port identifier.
+func ownerFromPortID(portID string) (common.Address, bool) {
+	if !strings.HasPrefix(portID, icatypes.ControllerPortPrefix) {
+		return common.Address{}, false
+	}
+
+	owner, err := sdk.AccAddressFromBech32(strings.TrimPrefix(portID, icatypes.ControllerPortPrefix))
+	if err != nil {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(owner), true
+}
@@ -0,0 +1,142 @@
+package ica_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v20/precompiles/ica"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+)
+
+var (
+	// storeCode is the runtime code of a contract that stores 1 in the slot 0 on any call.
+	storeCode = common.FromHex("0x600160005500")
+	// revertCode is the runtime code of a contract that reverts on any call.
+	revertCode = common.FromHex("0x60006000fd")
+)
+
+// setCode sets the given runtime code to the account of the given address.
+func (s *PrecompileTestSuite) setCode(ctx sdk.Context, addr common.Address, code []byte) {
+	codeHash := crypto.Keccak256Hash(code)
+	s.network.App.EvmKeeper.SetCode(ctx, codeHash.Bytes(), code)
+	account := statedb.NewEmptyAccount()
+	account.CodeHash = codeHash.Bytes()
+	s.Require().NoError(s.network.App.EvmKeeper.SetAccount(ctx, addr, *account))
+}
+
+// callbackEvents returns the ICA callback events emitted in the given context.
+func callbackEvents(ctx sdk.Context) []sdk.Event {
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == ica.EventTypeCallback {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (s *PrecompileTestSuite) TestOnAcknowledgementPacket() {
+	owner := common.BytesToAddress([]byte("ica owner contract"))
+	ack := channeltypes.NewResultAcknowledgement([]byte("result")).Acknowledgement()
+
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context) string
+		expEvent  bool
+		expStored bool
+		expError  bool
+	}{
+		{
+			"no callback - not a controller port",
+			func(sdk.Context) string {
+				return "transfer"
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"no callback - owner is not a contract",
+			func(sdk.Context) string {
+				portID, err := icatypes.NewControllerPortID(s.keyring.GetAccAddr(0).String())
+				s.Require().NoError(err)
+				return portID
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"callback - owner contract succeeds",
+			func(ctx sdk.Context) string {
+				s.setCode(ctx, owner, storeCode)
+				portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+				s.Require().NoError(err)
+				return portID
+			},
+			true,
+			true,
+			false,
+		},
+		{
+			"callback - owner contract reverts",
+			func(ctx sdk.Context) string {
+				s.setCode(ctx, owner, revertCode)
+				portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+				s.Require().NoError(err)
+				return portID
+			},
+			true,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext().WithEventManager(sdk.NewEventManager())
+			portID := tc.malleate(ctx)
+
+			packet := channeltypes.Packet{Sequence: 1, SourcePort: portID, SourceChannel: "channel-0"}
+			module := ica.NewIBCModule(s.network.App.EvmKeeper)
+			s.Require().NoError(module.OnAcknowledgementPacket(ctx, packet, ack, s.keyring.GetAccAddr(1)))
+
+			events := callbackEvents(ctx)
+			if !tc.expEvent {
+				s.Require().Empty(events)
+				return
+			}
+
+			s.Require().Len(events, 1)
+			_, hasError := events[0].GetAttribute(ica.AttributeKeyError)
+			s.Require().Equal(tc.expError, hasError)
+
+			stored := s.network.App.EvmKeeper.GetState(ctx, owner, common.Hash{})
+			s.Require().Equal(tc.expStored, stored == common.BigToHash(common.Big1))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestOnTimeoutPacket() {
+	owner := common.BytesToAddress([]byte("ica owner contract"))
+	ctx := s.network.GetContext().WithEventManager(sdk.NewEventManager())
+	s.setCode(ctx, owner, storeCode)
+
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+	s.Require().NoError(err)
+
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: portID, SourceChannel: "channel-0"}
+	module := ica.NewIBCModule(s.network.App.EvmKeeper)
+	s.Require().NoError(module.OnTimeoutPacket(ctx, packet, s.keyring.GetAccAddr(1)))
+
+	events := callbackEvents(ctx)
+	s.Require().Len(events, 1)
+	callback, found := events[0].GetAttribute(ica.AttributeKeyCallback)
+	s.Require().True(found)
+	s.Require().Equal(ica.OnTimeoutCallback, callback.Value)
+	s.Require().Equal(common.BigToHash(common.Big1), s.network.App.EvmKeeper.GetState(ctx, owner, common.Hash{}))
+}
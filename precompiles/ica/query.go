@@ -0,0 +1,39 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// InterchainAccountAddressMethod defines the ABI method name for querying
+	// the address of an interchain account.
+	InterchainAccountAddressMethod = "interchainAccountAddress"
+)
+
+// InterchainAccountAddress returns the address on the host chain of the
+// interchain account of the given owner and connection. An empty string is
+// returned if the interchain account is not registered.
+func (p Precompile) InterchainAccountAddress(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, connectionID, err := ParseInterchainAccountAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+	if err != nil {
+		return nil, err
+	}
+
+	address, _ := p.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+
+	return method.Outputs.Pack(address)
+}
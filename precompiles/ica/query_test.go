@@ -0,0 +1,86 @@
+package ica_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/ica"
+)
+
+func (s *PrecompileTestSuite) TestInterchainAccountAddress() {
+	method := s.precompile.Methods[ica.InterchainAccountAddressMethod]
+	const icaAddress = "cosmos1hostaccountaddress"
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		expAddress  string
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(sdk.Context) []interface{} {
+				return []interface{}{}
+			},
+			"",
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty owner",
+			func(sdk.Context) []interface{} {
+				return []interface{}{common.Address{}, connectionID}
+			},
+			"",
+			"invalid owner address",
+		},
+		{
+			"fail - invalid connection ID",
+			func(sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), "c"}
+			},
+			"",
+			"invalid connection ID",
+		},
+		{
+			"success - interchain account not registered",
+			func(sdk.Context) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), connectionID}
+			},
+			"",
+			"",
+		},
+		{
+			"success - interchain account registered",
+			func(ctx sdk.Context) []interface{} {
+				portID, err := icatypes.NewControllerPortID(s.keyring.GetAccAddr(0).String())
+				s.Require().NoError(err)
+				s.network.App.ICAControllerKeeper.SetInterchainAccountAddress(ctx, connectionID, portID, icaAddress)
+				return []interface{}{s.keyring.GetAddr(0), connectionID}
+			},
+			icaAddress,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+
+			bz, err := s.precompile.InterchainAccountAddress(ctx, &method, tc.malleate(ctx))
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var address string
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&address, ica.InterchainAccountAddressMethod, bz))
+			s.Require().Equal(tc.expAddress, address)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for
+	// registering an interchain account.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for sending a transaction
+	// to be executed by an interchain account.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount registers an interchain account owned by the caller
+// on the host chain of the given connection. The caller receives the result of
+// the channel handshake and of the sent transactions through the callbacks
+// defined in the IICACallbacks interface.
+func (p Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	connectionID, err := ParseRegisterInterchainAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"owner", owner.String(),
+		"connection_id", connectionID,
+	)
+
+	// NOTE: an empty version defaults to the ICS-27 metadata of the connection
+	// with protobuf encoding. The registration through the keeper enables the
+	// controller middleware, so the packet callbacks are routed to the EVM.
+	if err := p.icaControllerKeeper.RegisterInterchainAccount(
+		ctx, connectionID, sdk.AccAddress(owner.Bytes()).String(), "",
+	); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, connectionID, portID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SendTx sends the given Cosmos messages to be executed by the interchain
+// account of the caller on the host chain of the given connection.
+func (p Precompile) SendTx(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	connectionID, packetData, timeoutTimestamp, err := ParseSendTxArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"owner", owner.String(),
+		"connection_id", connectionID,
+		"timeout_timestamp", timeoutTimestamp,
+	)
+
+	if err := p.checkEncoding(ctx, connectionID, portID); err != nil {
+		return nil, err
+	}
+
+	sequence, err := p.icaControllerKeeper.SendTx(ctx, nil, connectionID, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSendTxEvent(ctx, stateDB, owner, connectionID, sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// checkEncoding checks that the active channel of the interchain account uses
+// protobuf encoding, which is the only encoding supported by the precompile.
+func (p Precompile) checkEncoding(ctx sdk.Context, connectionID, portID string) error {
+	channelID, found := p.icaControllerKeeper.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return fmt.Errorf(ErrNoActiveChannel, connectionID, portID)
+	}
+
+	version, found := p.icaControllerKeeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return fmt.Errorf(ErrNoActiveChannel, connectionID, portID)
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(version)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Encoding != icatypes.EncodingProtobuf {
+		return fmt.Errorf(ErrUnsupportedEncoding, metadata.Encoding, icatypes.EncodingProtobuf)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package ica_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/ica"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+)
+
+const connectionID = "connection-0"
+
+func (s *PrecompileTestSuite) TestRegisterInterchainAccount() {
+	method := s.precompile.Methods[ica.RegisterInterchainAccountMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid connection ID type",
+			[]interface{}{common.Address{}},
+			"invalid connection ID",
+		},
+		{
+			"fail - invalid connection ID",
+			[]interface{}{"channel-0"},
+			"invalid connection ID",
+		},
+		{
+			"fail - connection not found",
+			[]interface{}{connectionID},
+			"connection not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+			_, err := s.precompile.RegisterInterchainAccount(ctx, contract, stateDB, &method, tc.args)
+			s.Require().ErrorContains(err, tc.errContains)
+			s.Require().Empty(stateDB.Logs())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSendTx() {
+	method := s.precompile.Methods[ica.SendTxMethod]
+	msgs := []cmn.CosmosMsg{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{}}}
+
+	testCases := []struct {
+		name        string
+		malleate    func(timeout uint64) []interface{}
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(uint64) []interface{} {
+				return []interface{}{}
+			},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid connection ID",
+			func(timeout uint64) []interface{} {
+				return []interface{}{"", msgs, timeout}
+			},
+			"invalid connection ID",
+		},
+		{
+			"fail - no messages",
+			func(timeout uint64) []interface{} {
+				return []interface{}{connectionID, []cmn.CosmosMsg{}, timeout}
+			},
+			ica.ErrNoMsgs,
+		},
+		{
+			"fail - zero timeout timestamp",
+			func(uint64) []interface{} {
+				return []interface{}{connectionID, msgs, uint64(0)}
+			},
+			"invalid timeout timestamp",
+		},
+		{
+			"fail - interchain account not registered",
+			func(timeout uint64) []interface{} {
+				return []interface{}{connectionID, msgs, timeout}
+			},
+			"no active channel",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+			timeout := uint64(ctx.BlockTime().Add(time.Hour).UnixNano()) //nolint:gosec // G115
+
+			_, err := s.precompile.SendTx(ctx, contract, stateDB, &method, tc.malleate(timeout))
+			s.Require().ErrorContains(err, tc.errContains)
+			s.Require().Empty(stateDB.Logs())
+		})
+	}
+}
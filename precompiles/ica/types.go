@@ -0,0 +1,116 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ica
+
+import (
+	"errors"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+// SendTxInput defines the input arguments of the sendTx method.
+type SendTxInput struct {
+	ConnectionId     string //nolint:revive,stylecheck
+	Msgs             []cmn.CosmosMsg
+	TimeoutTimestamp uint64
+}
+
+// ParseRegisterInterchainAccountArgs parses the arguments of the
+// registerInterchainAccount method and returns the connection identifier.
+func ParseRegisterInterchainAccountArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	connectionID, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(ErrInvalidConnectionID, args[0])
+	}
+
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return "", fmt.Errorf(ErrInvalidConnectionID, err)
+	}
+
+	return connectionID, nil
+}
+
+// ParseSendTxArgs parses the arguments of the sendTx method and returns the
+// connection identifier, the interchain account packet data with the protobuf
+// encoded messages and the packet timeout timestamp.
+func ParseSendTxArgs(method *abi.Method, args []interface{}) (string, icatypes.InterchainAccountPacketData, uint64, error) {
+	if len(args) != 3 {
+		return "", icatypes.InterchainAccountPacketData{}, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input SendTxInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return "", icatypes.InterchainAccountPacketData{}, 0, fmt.Errorf("error while unpacking args to SendTxInput struct: %s", err)
+	}
+
+	if err := host.ConnectionIdentifierValidator(input.ConnectionId); err != nil {
+		return "", icatypes.InterchainAccountPacketData{}, 0, fmt.Errorf(ErrInvalidConnectionID, err)
+	}
+
+	if len(input.Msgs) == 0 {
+		return "", icatypes.InterchainAccountPacketData{}, 0, errors.New(ErrNoMsgs)
+	}
+
+	if input.TimeoutTimestamp == 0 {
+		return "", icatypes.InterchainAccountPacketData{}, 0, fmt.Errorf(ErrInvalidTimeoutTimestamp, input.TimeoutTimestamp)
+	}
+
+	// NOTE: the messages are executed on the host chain, so they are forwarded
+	// as they are without being unpacked with the local interface registry.
+	msgs := make([]*codectypes.Any, len(input.Msgs))
+	for i, cosmosMsg := range input.Msgs {
+		msgs[i] = &codectypes.Any{TypeUrl: cosmosMsg.TypeUrl, Value: cosmosMsg.Value}
+	}
+
+	bz, err := icatypes.ModuleCdc.Marshal(&icatypes.CosmosTx{Messages: msgs})
+	if err != nil {
+		return "", icatypes.InterchainAccountPacketData{}, 0, fmt.Errorf(ErrInvalidMsgs, err)
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: bz,
+	}
+	if err := packetData.ValidateBasic(); err != nil {
+		return "", icatypes.InterchainAccountPacketData{}, 0, fmt.Errorf(ErrInvalidMsgs, err)
+	}
+
+	return input.ConnectionId, packetData, input.TimeoutTimestamp, nil
+}
+
+// ParseInterchainAccountAddressArgs parses the arguments of the
+// interchainAccountAddress method and returns the owner address and the
+// connection identifier.
+func ParseInterchainAccountAddressArgs(args []interface{}) (common.Address, string, error) {
+	if len(args) != 2 {
+		return common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidConnectionID, args[1])
+	}
+
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidConnectionID, err)
+	}
+
+	return owner, connectionID, nil
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @author Evmos Team
+/// @title ICS20 Transfer Callbacks
+/// @dev The interface that the contracts sending ICS20 transfers implement to react
+/// to the packet lifecycle. The callbacks are only called on the contract that is the
+/// sender of the transfer, following the ADR-8 source callbacks model. They are called
+/// by the ICS20 precompile address with a bounded amount of gas and their failure
+/// doesn't affect the packet lifecycle.
+interface IICS20Callbacks {
+    /// @dev onIBCAck is called when a transfer sent by the contract is acknowledged.
+    /// The tokens are refunded to the contract before the call if the transfer failed.
+    /// @param channel The source channel of the transfer.
+    /// @param sequence The sequence of the transfer packet.
+    /// @param success True if the tokens were received on the destination chain.
+    function onIBCAck(
+        string calldata channel,
+        uint64 sequence,
+        bool success
+    ) external;
+
+    /// @dev onIBCTimeout is called when a transfer sent by the contract times out.
+    /// The tokens are refunded to the contract before the call.
+    /// @param channel The source channel of the transfer.
+    /// @param sequence The sequence of the transfer packet.
+    function onIBCTimeout(string calldata channel, uint64 sequence) external;
+}
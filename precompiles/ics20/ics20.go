@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -27,14 +28,28 @@ var _ vm.PrecompiledContract = &Precompile{}
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
 //
-//go:embed abi.json
+//go:embed abi.json callbacks_abi.json
 var f embed.FS
 
+// CallbacksKeeper defines the expected IBC callbacks keeper interface used to
+// record the contracts that send ICS-20 transfers, so that they are called back
+// when the packets are acknowledged or time out.
+type CallbacksKeeper interface {
+	SetPacketSender(ctx sdk.Context, portID, channelID string, sequence uint64, contract common.Address)
+}
+
 type Precompile struct {
 	cmn.Precompile
-	stakingKeeper  stakingkeeper.Keeper
-	transferKeeper transferkeeper.Keeper
-	channelKeeper  channelkeeper.Keeper
+	stakingKeeper   stakingkeeper.Keeper
+	transferKeeper  transferkeeper.Keeper
+	channelKeeper   channelkeeper.Keeper
+	callbacksKeeper CallbacksKeeper
+}
+
+// LoadCallbacksABI loads the ABI of the callbacks that the contracts sending
+// ICS-20 transfers implement to receive the packet acknowledgements and timeouts.
+func LoadCallbacksABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "callbacks_abi.json")
 }
 
 // NewPrecompile creates a new ICS-20 Precompile instance as a
@@ -43,6 +58,7 @@ func NewPrecompile(
 	stakingKeeper stakingkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	callbacksKeeper CallbacksKeeper,
 	authzKeeper authzkeeper.Keeper,
 ) (*Precompile, error) {
 	newAbi, err := cmn.LoadABI(f, "abi.json")
@@ -58,9 +74,10 @@ func NewPrecompile(
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
 		},
-		transferKeeper: transferKeeper,
-		channelKeeper:  channelKeeper,
-		stakingKeeper:  stakingKeeper,
+		transferKeeper:  transferKeeper,
+		channelKeeper:   channelKeeper,
+		callbacksKeeper: callbacksKeeper,
+		stakingKeeper:   stakingKeeper,
 	}
 
 	// SetAddress defines the address of the ICS-20 compile contract.
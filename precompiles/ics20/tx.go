@@ -69,6 +69,15 @@ func (p *Precompile) Transfer(
 		return nil, err
 	}
 
+	// Record the contract calling the precompile as the sender of the packet, so
+	// that it is called back once the packet is acknowledged or times out. The
+	// caller is taken from the EVM call instead of the account code, which also
+	// covers the contracts transferring from their constructor and leaves out the
+	// EOAs with a code delegation that send the transfer themselves.
+	if contract.CallerAddress != origin {
+		p.callbacksKeeper.SetPacketSender(ctx, msg.SourcePort, msg.SourceChannel, res.Sequence, contract.CallerAddress)
+	}
+
 	if contract.CallerAddress != origin && msg.Token.Denom == evmtypes.GetEVMCoinDenom() {
 		// escrow address is also changed on this tx, and it is not a module account
 		// so we need to account for this on the UpdateDirties
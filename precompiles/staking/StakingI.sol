@@ -116,6 +116,30 @@ struct UnbondingDelegationOutput {
     UnbondingDelegationEntry[] entries;
 }
 
+/// @dev Represents a delegation of a delegator to a validator with its balance.
+struct DelegationResponse {
+    string delegatorAddress;
+    string validatorAddress;
+    uint256 shares; // TODO: decimal
+    Coin balance;
+}
+
+/// @dev Represents the amount of tokens held by the bonded and not bonded pools.
+struct Pool {
+    uint256 notBondedTokens;
+    uint256 bondedTokens;
+}
+
+/// @dev Represents the parameters of the staking module.
+struct Params {
+    int64 unbondingTime;
+    uint32 maxValidators;
+    uint32 maxEntries;
+    uint32 historicalEntries;
+    string bondDenom;
+    uint256 minCommissionRate;
+}
+
 /// @dev The status of the validator.
 enum BondStatus {
     Unspecified,
@@ -296,6 +320,59 @@ interface StakingI is authorization.AuthorizationI {
             PageResponse calldata pageResponse
         );
 
+    /// @dev Queries all the delegations of a given delegator.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return delegationResponses The delegations of the given delegator with their balances.
+    function delegatorDelegations(
+        address delegatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            DelegationResponse[] calldata delegationResponses,
+            PageResponse calldata pageResponse
+        );
+
+    /// @dev Queries all the unbonding delegations of a given delegator.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return unbondingDelegations The unbonding delegations of the given delegator.
+    function delegatorUnbondingDelegations(
+        address delegatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            UnbondingDelegationOutput[] calldata unbondingDelegations,
+            PageResponse calldata pageResponse
+        );
+
+    /// @dev Queries all the delegations to a given validator.
+    /// @param validatorAddress The address of the validator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return delegationResponses The delegations to the given validator with their balances.
+    function validatorDelegations(
+        string memory validatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            DelegationResponse[] calldata delegationResponses,
+            PageResponse calldata pageResponse
+        );
+
+    /// @dev Queries the amount of tokens held by the bonded and not bonded pools.
+    /// @return pool The bonded and not bonded tokens.
+    function pool() external view returns (Pool calldata pool);
+
+    /// @dev Queries the parameters of the staking module.
+    /// @return params The staking module parameters.
+    function params() external view returns (Params calldata params);
+
     /// @dev CreateValidator defines an Event emitted when a create a new validator.
     /// @param validatorAddress The address of the validator
     /// @param value The amount of coin being self delegated
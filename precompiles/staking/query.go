@@ -36,6 +36,21 @@ const (
 	// RedelegationsMethod defines the ABI method name for the staking
 	// Redelegations query.
 	RedelegationsMethod = "redelegations"
+	// DelegatorDelegationsMethod defines the ABI method name for the staking
+	// DelegatorDelegations query.
+	DelegatorDelegationsMethod = "delegatorDelegations"
+	// DelegatorUnbondingDelegationsMethod defines the ABI method name for the staking
+	// DelegatorUnbondingDelegations query.
+	DelegatorUnbondingDelegationsMethod = "delegatorUnbondingDelegations"
+	// ValidatorDelegationsMethod defines the ABI method name for the staking
+	// ValidatorDelegations query.
+	ValidatorDelegationsMethod = "validatorDelegations"
+	// PoolMethod defines the ABI method name for the staking
+	// Pool query.
+	PoolMethod = "pool"
+	// ParamsMethod defines the ABI method name for the staking
+	// Params query.
+	ParamsMethod = "params"
 )
 
 // Delegation returns the delegation that a delegator has with a specific validator.
@@ -200,6 +215,125 @@ func (p Precompile) Redelegations(
 	return out.Pack(method.Outputs)
 }
 
+// DelegatorDelegations returns all the delegations of a delegator with pagination.
+func (p Precompile) DelegatorDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDelegatorDelegationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: p.stakingKeeper.Keeper}
+
+	res, err := queryServer.DelegatorDelegations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegationsOutput).FromResponse(res.DelegationResponses, res.Pagination)
+
+	return out.Pack(method.Outputs)
+}
+
+// DelegatorUnbondingDelegations returns all the unbonding delegations of a
+// delegator with pagination.
+func (p Precompile) DelegatorUnbondingDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDelegatorUnbondingDelegationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: p.stakingKeeper.Keeper}
+
+	res, err := queryServer.DelegatorUnbondingDelegations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(UnbondingDelegationsOutput).FromResponse(res)
+
+	return out.Pack(method.Outputs)
+}
+
+// ValidatorDelegations returns all the delegations to a validator with pagination.
+func (p Precompile) ValidatorDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewValidatorDelegationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: p.stakingKeeper.Keeper}
+
+	res, err := queryServer.ValidatorDelegations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegationsOutput).FromResponse(res.DelegationResponses, res.Pagination)
+
+	return out.Pack(method.Outputs)
+}
+
+// Pool returns the amount of tokens held by the bonded and not bonded pools.
+func (p Precompile) Pool(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: p.stakingKeeper.Keeper}
+
+	res, err := queryServer.Pool(ctx, &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(PoolOutput).FromResponse(res)
+
+	return method.Outputs.Pack(out.Pool)
+}
+
+// Params returns the parameters of the staking module.
+func (p Precompile) Params(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: p.stakingKeeper.Keeper}
+
+	res, err := queryServer.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(ParamsOutput).FromResponse(res)
+
+	return method.Outputs.Pack(out.Params)
+}
+
 // Allowance returns the remaining allowance of a grantee to the contract.
 func (p Precompile) Allowance(
 	ctx sdk.Context,
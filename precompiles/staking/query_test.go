@@ -702,6 +702,349 @@ func (s *PrecompileTestSuite) TestRedelegations() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestDelegatorDelegations() {
+	method := s.precompile.Methods[staking.DelegatorDelegationsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		gas         uint64
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty delegator address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					query.PageRequest{},
+				}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			"invalid delegator address",
+		},
+		{
+			"success - delegator without delegations",
+			func() []interface{} {
+				addr, _ := testutiltx.NewAddrKey()
+				return []interface{}{
+					addr,
+					query.PageRequest{},
+				}
+			},
+			func(bz []byte) {
+				var delOut staking.DelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&delOut, staking.DelegatorDelegationsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Empty(delOut.DelegationResponses)
+			},
+			100000,
+			false,
+			"",
+		},
+		{
+			"success - all delegations w/pagination & key is []byte{0}",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					query.PageRequest{
+						Key:        []byte{0},
+						Limit:      1,
+						CountTotal: true,
+					},
+				}
+			},
+			func(bz []byte) {
+				var delOut staking.DelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&delOut, staking.DelegatorDelegationsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(delOut.DelegationResponses, 1)
+				s.Require().Equal(len(s.network.GetValidators()), int(delOut.PageResponse.Total)) //nolint:gosec
+				s.Require().NotEmpty(delOut.PageResponse.NextKey)
+
+				delegation := delOut.DelegationResponses[0]
+				s.Require().Equal(sdk.AccAddress(s.keyring.GetAddr(0).Bytes()).String(), delegation.DelegatorAddress)
+				s.Require().Equal(big.NewInt(1e18), delegation.Shares)
+				s.Require().Equal(s.bondDenom, delegation.Balance.Denom)
+				s.Require().Equal(big.NewInt(1e18), delegation.Balance.Amount)
+			},
+			100000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+
+			bz, err := s.precompile.DelegatorDelegations(s.network.GetContext(), &method, contract, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDelegatorUnbondingDelegations() {
+	method := s.precompile.Methods[staking.DelegatorUnbondingDelegationsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		gas         uint64
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty delegator address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					query.PageRequest{},
+				}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			"invalid delegator address",
+		},
+		{
+			"success - delegator without unbonding delegations",
+			func() []interface{} {
+				addr, _ := testutiltx.NewAddrKey()
+				return []interface{}{
+					addr,
+					query.PageRequest{},
+				}
+			},
+			func(bz []byte) {
+				var ubdOut staking.UnbondingDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&ubdOut, staking.DelegatorUnbondingDelegationsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Empty(ubdOut.UnbondingDelegations)
+			},
+			100000,
+			false,
+			"",
+		},
+		{
+			"success - all unbonding delegations w/pagination",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					query.PageRequest{
+						Limit:      1,
+						CountTotal: true,
+					},
+				}
+			},
+			func(bz []byte) {
+				var ubdOut staking.UnbondingDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&ubdOut, staking.DelegatorUnbondingDelegationsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(ubdOut.UnbondingDelegations, 1)
+				s.Require().Equal(uint64(2), ubdOut.PageResponse.Total)
+				s.Require().NotEmpty(ubdOut.PageResponse.NextKey)
+				s.Require().Len(ubdOut.UnbondingDelegations[0].Entries, 1)
+				s.Require().Equal(big.NewInt(1e18), ubdOut.UnbondingDelegations[0].Entries[0].Balance)
+			},
+			100000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+
+			for _, val := range s.network.GetValidators()[:2] {
+				valAddr, err := sdk.ValAddressFromBech32(val.OperatorAddress)
+				s.Require().NoError(err)
+				_, _, err = s.network.App.StakingKeeper.Undelegate(s.network.GetContext(), s.keyring.GetAddr(0).Bytes(), valAddr, math.LegacyNewDec(1))
+				s.Require().NoError(err)
+			}
+
+			bz, err := s.precompile.DelegatorUnbondingDelegations(s.network.GetContext(), &method, contract, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestValidatorDelegations() {
+	method := s.precompile.Methods[staking.ValidatorDelegationsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(operatorAddress string) []interface{}
+		postCheck   func(bz []byte)
+		gas         uint64
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(string) []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid validator address",
+			func(string) []interface{} {
+				return []interface{}{
+					"invalid",
+					query.PageRequest{},
+				}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			"invalid validator address",
+		},
+		{
+			"success - all delegations w/pagination",
+			func(operatorAddress string) []interface{} {
+				return []interface{}{
+					operatorAddress,
+					query.PageRequest{
+						Limit:      10,
+						CountTotal: true,
+					},
+				}
+			},
+			func(bz []byte) {
+				var delOut staking.DelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&delOut, staking.ValidatorDelegationsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().NotEmpty(delOut.DelegationResponses)
+				s.Require().Equal(len(delOut.DelegationResponses), int(delOut.PageResponse.Total)) //nolint:gosec
+
+				for _, delegation := range delOut.DelegationResponses {
+					s.Require().Equal(s.network.GetValidators()[0].OperatorAddress, delegation.ValidatorAddress)
+				}
+			},
+			100000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+
+			bz, err := s.precompile.ValidatorDelegations(s.network.GetContext(), &method, contract, tc.malleate(s.network.GetValidators()[0].OperatorAddress))
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestPool() {
+	method := s.precompile.Methods[staking.PoolMethod]
+
+	s.Run("fail - invalid number of args", func() {
+		_, err := s.precompile.Pool(s.network.GetContext(), &method, nil, []interface{}{"invalid"})
+		s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1))
+	})
+
+	s.Run("success", func() {
+		ctx := s.network.GetContext()
+		bz, err := s.precompile.Pool(ctx, &method, nil, []interface{}{})
+		s.Require().NoError(err)
+
+		var poolOut staking.PoolOutput
+		err = s.precompile.UnpackIntoInterface(&poolOut, staking.PoolMethod, bz)
+		s.Require().NoError(err, "failed to unpack output")
+
+		bondedTokens, err := s.network.App.StakingKeeper.TotalBondedTokens(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(bondedTokens.BigInt(), poolOut.Pool.BondedTokens)
+		s.Require().Equal(int64(0), poolOut.Pool.NotBondedTokens.Int64())
+	})
+}
+
+func (s *PrecompileTestSuite) TestParams() {
+	method := s.precompile.Methods[staking.ParamsMethod]
+
+	s.Run("fail - invalid number of args", func() {
+		_, err := s.precompile.Params(s.network.GetContext(), &method, nil, []interface{}{"invalid"})
+		s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1))
+	})
+
+	s.Run("success", func() {
+		ctx := s.network.GetContext()
+		bz, err := s.precompile.Params(ctx, &method, nil, []interface{}{})
+		s.Require().NoError(err)
+
+		var paramsOut staking.ParamsOutput
+		err = s.precompile.UnpackIntoInterface(&paramsOut, staking.ParamsMethod, bz)
+		s.Require().NoError(err, "failed to unpack output")
+
+		params, err := s.network.App.StakingKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(params.UnbondingTime.Seconds()), paramsOut.Params.UnbondingTime)
+		s.Require().Equal(params.MaxValidators, paramsOut.Params.MaxValidators)
+		s.Require().Equal(params.MaxEntries, paramsOut.Params.MaxEntries)
+		s.Require().Equal(params.HistoricalEntries, paramsOut.Params.HistoricalEntries)
+		s.Require().Equal(params.BondDenom, paramsOut.Params.BondDenom)
+		s.Require().Equal(params.MinCommissionRate.BigInt(), paramsOut.Params.MinCommissionRate)
+	})
+}
+
 func (s *PrecompileTestSuite) TestAllowance() {
 	approvedCoin := sdk.Coin{Denom: s.bondDenom, Amount: math.NewInt(1e18)}
 	granteeAddr := testutiltx.GenerateAddress()
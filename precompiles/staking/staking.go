@@ -131,6 +131,16 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegation(ctx, method, contract, args)
 	case RedelegationsMethod:
 		bz, err = p.Redelegations(ctx, method, contract, args)
+	case DelegatorDelegationsMethod:
+		bz, err = p.DelegatorDelegations(ctx, method, contract, args)
+	case DelegatorUnbondingDelegationsMethod:
+		bz, err = p.DelegatorUnbondingDelegations(ctx, method, contract, args)
+	case ValidatorDelegationsMethod:
+		bz, err = p.ValidatorDelegations(ctx, method, contract, args)
+	case PoolMethod:
+		bz, err = p.Pool(ctx, method, contract, args)
+	case ParamsMethod:
+		bz, err = p.Params(ctx, method, contract, args)
 	// Authorization queries
 	case authorization.AllowanceMethod:
 		bz, err = p.Allowance(ctx, method, contract, args)
@@ -811,6 +811,221 @@ func (ro *RedelegationsOutput) Pack(args abi.Arguments) ([]byte, error) {
 	return args.Pack(ro.Response, ro.PageResponse)
 }
 
+// DelegatorQueryInput is a struct to represent the input information for the
+// delegator delegations and unbonding delegations queries. Needed to unpack
+// arguments into the PageRequest struct.
+type DelegatorQueryInput struct {
+	DelegatorAddress common.Address
+	PageRequest      query.PageRequest
+}
+
+// ValidatorDelegationsInput is a struct to represent the input information for
+// the validator delegations query. Needed to unpack arguments into the
+// PageRequest struct.
+type ValidatorDelegationsInput struct {
+	ValidatorAddress string
+	PageRequest      query.PageRequest
+}
+
+// NewDelegatorDelegationsRequest creates a new QueryDelegatorDelegationsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewDelegatorDelegationsRequest(method *abi.Method, args []interface{}) (*stakingtypes.QueryDelegatorDelegationsRequest, error) {
+	delegatorAddr, pageRequest, err := parseDelegatorQueryArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.QueryDelegatorDelegationsRequest{
+		DelegatorAddr: delegatorAddr,
+		Pagination:    pageRequest,
+	}, nil
+}
+
+// NewDelegatorUnbondingDelegationsRequest creates a new QueryDelegatorUnbondingDelegationsRequest instance and
+// does sanity checks on the given arguments before populating the request.
+func NewDelegatorUnbondingDelegationsRequest(method *abi.Method, args []interface{}) (*stakingtypes.QueryDelegatorUnbondingDelegationsRequest, error) {
+	delegatorAddr, pageRequest, err := parseDelegatorQueryArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+		DelegatorAddr: delegatorAddr,
+		Pagination:    pageRequest,
+	}, nil
+}
+
+// parseDelegatorQueryArgs parses the delegator address and the page request of
+// the delegator delegations and unbonding delegations queries.
+func parseDelegatorQueryArgs(method *abi.Method, args []interface{}) (string, *query.PageRequest, error) {
+	if len(args) != 2 {
+		return "", nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DelegatorQueryInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return "", nil, fmt.Errorf("error while unpacking args to DelegatorQueryInput struct: %s", err)
+	}
+
+	if input.DelegatorAddress == (common.Address{}) {
+		return "", nil, fmt.Errorf(cmn.ErrInvalidDelegator, input.DelegatorAddress)
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return sdk.AccAddress(input.DelegatorAddress.Bytes()).String(), &input.PageRequest, nil
+}
+
+// NewValidatorDelegationsRequest creates a new QueryValidatorDelegationsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewValidatorDelegationsRequest(method *abi.Method, args []interface{}) (*stakingtypes.QueryValidatorDelegationsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input ValidatorDelegationsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to ValidatorDelegationsInput struct: %s", err)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(input.ValidatorAddress); err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidValidator, input.ValidatorAddress)
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &stakingtypes.QueryValidatorDelegationsRequest{
+		ValidatorAddr: input.ValidatorAddress,
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
+// DelegationResponse is a struct to represent the key information from a
+// delegation with its balance.
+type DelegationResponse struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Shares           *big.Int
+	Balance          cmn.Coin
+}
+
+// DelegationsOutput is a struct to represent the key information from
+// a delegator delegations or a validator delegations response.
+type DelegationsOutput struct {
+	DelegationResponses []DelegationResponse
+	PageResponse        query.PageResponse
+}
+
+// FromResponse populates the DelegationsOutput from the delegation responses
+// and the page response of a delegations query.
+func (do *DelegationsOutput) FromResponse(res stakingtypes.DelegationResponses, pageRes *query.PageResponse) *DelegationsOutput {
+	do.DelegationResponses = make([]DelegationResponse, len(res))
+	for i, resp := range res {
+		do.DelegationResponses[i] = DelegationResponse{
+			DelegatorAddress: resp.Delegation.DelegatorAddress,
+			ValidatorAddress: resp.Delegation.ValidatorAddress,
+			Shares:           resp.Delegation.Shares.BigInt(),
+			Balance: cmn.Coin{
+				Denom:  resp.Balance.Denom,
+				Amount: resp.Balance.Amount.BigInt(),
+			},
+		}
+	}
+
+	if pageRes != nil {
+		do.PageResponse.Total = pageRes.Total
+		do.PageResponse.NextKey = pageRes.NextKey
+	}
+
+	return do
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (do *DelegationsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(do.DelegationResponses, do.PageResponse)
+}
+
+// UnbondingDelegationsOutput is a struct to represent the key information from
+// a delegator unbonding delegations response.
+type UnbondingDelegationsOutput struct {
+	UnbondingDelegations []UnbondingDelegationResponse
+	PageResponse         query.PageResponse
+}
+
+// FromResponse populates the UnbondingDelegationsOutput from a QueryDelegatorUnbondingDelegationsResponse.
+func (uo *UnbondingDelegationsOutput) FromResponse(res *stakingtypes.QueryDelegatorUnbondingDelegationsResponse) *UnbondingDelegationsOutput {
+	uo.UnbondingDelegations = make([]UnbondingDelegationResponse, len(res.UnbondingResponses))
+	for i, ubd := range res.UnbondingResponses {
+		out := new(UnbondingDelegationOutput).FromResponse(&stakingtypes.QueryUnbondingDelegationResponse{Unbond: ubd})
+		uo.UnbondingDelegations[i] = out.UnbondingDelegation
+	}
+
+	if res.Pagination != nil {
+		uo.PageResponse.Total = res.Pagination.Total
+		uo.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return uo
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (uo *UnbondingDelegationsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(uo.UnbondingDelegations, uo.PageResponse)
+}
+
+// Pool is a struct to represent the amount of tokens held by the bonded and
+// not bonded pools.
+type Pool struct {
+	NotBondedTokens *big.Int
+	BondedTokens    *big.Int
+}
+
+// PoolOutput is the output response returned by the pool query method.
+type PoolOutput struct {
+	Pool Pool
+}
+
+// FromResponse populates the PoolOutput from a QueryPoolResponse.
+func (po *PoolOutput) FromResponse(res *stakingtypes.QueryPoolResponse) *PoolOutput {
+	po.Pool = Pool{
+		NotBondedTokens: res.Pool.NotBondedTokens.BigInt(),
+		BondedTokens:    res.Pool.BondedTokens.BigInt(),
+	}
+	return po
+}
+
+// Params is a struct to represent the parameters of the staking module.
+type Params struct {
+	UnbondingTime     int64
+	MaxValidators     uint32
+	MaxEntries        uint32
+	HistoricalEntries uint32
+	BondDenom         string
+	MinCommissionRate *big.Int // TODO: Decimal
+}
+
+// ParamsOutput is the output response returned by the params query method.
+type ParamsOutput struct {
+	Params Params
+}
+
+// FromResponse populates the ParamsOutput from a QueryParamsResponse.
+func (po *ParamsOutput) FromResponse(res *stakingtypes.QueryParamsResponse) *ParamsOutput {
+	po.Params = Params{
+		UnbondingTime:     int64(res.Params.UnbondingTime.Seconds()),
+		MaxValidators:     res.Params.MaxValidators,
+		MaxEntries:        res.Params.MaxEntries,
+		HistoricalEntries: res.Params.HistoricalEntries,
+		BondDenom:         res.Params.BondDenom,
+		MinCommissionRate: res.Params.MinCommissionRate.BigInt(),
+	}
+	return po
+}
+
 // NewUnbondingDelegationRequest creates a new QueryUnbondingDelegationRequest instance and does sanity checks
 // on the given arguments before populating the request.
 func NewUnbondingDelegationRequest(args []interface{}) (*stakingtypes.QueryUnbondingDelegationRequest, error) {
@@ -17,8 +17,10 @@ import (
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/miner"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/net"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/personal"
+	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/trace"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/web3"
+	"github.com/evmos/evmos/v20/rpc/namespaces/evmos"
 	"github.com/evmos/evmos/v20/types"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
@@ -29,6 +31,7 @@ const (
 	// Cosmos namespaces
 
 	CosmosNamespace = "cosmos"
+	EvmosNamespace  = "evmos"
 
 	// Ethereum namespaces
 
@@ -39,6 +42,7 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
 
 	apiVersion = "1.0"
 )
@@ -115,12 +119,18 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -157,6 +167,38 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		EvmosNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: EvmosNamespace,
+					Version:   apiVersion,
+					Service:   evmos.NewPublicAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
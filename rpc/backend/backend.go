@@ -48,6 +48,7 @@ type EVMBackend interface {
 	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
+	RPCBlockRangeCap() int32      // RPCBlockRangeCap is the max block range allowed for queries over a range of blocks.
 	RPCMinGasPrice() *big.Int
 
 	// Sign Tx
@@ -100,23 +101,33 @@ type EVMBackend interface {
 	GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
+	GetTransactionsByAddress(address common.Address, fromBlock, toBlock int64, offset, limit uint64) ([]*rpctypes.RPCTransaction, error)
 
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) ([]*rpctypes.SimBlockResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	BlockBloomByHeight(height int64) (ethtypes.Bloom, error)
+	GetBloomBits(section uint64, bit uint) ([]byte, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
+	TraceEthBlock(block *tmrpctypes.ResultBlock, config *evmtypes.TraceConfig) ([]*evmtypes.MsgEthereumTx, []*evmtypes.TxTraceResult, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, config *rpctypes.TraceCallConfig) (interface{}, error)
+
+	// TxPool
+	TxPoolContent() (TxPoolTransactions, TxPoolTransactions, error)
+	TxPoolContentFrom(address common.Address) (map[uint64]*rpctypes.RPCTransaction, map[uint64]*rpctypes.RPCTransaction, error)
 }
 
 var _ BackendI = (*Backend)(nil)
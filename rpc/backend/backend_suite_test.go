@@ -88,7 +88,12 @@ func (suite *BackendTestSuite) SetupTest() {
 	suite.backend.cfg.JSONRPC.GasCap = 0
 	suite.backend.cfg.JSONRPC.EVMTimeout = 0
 	suite.backend.cfg.JSONRPC.AllowInsecureUnlock = true
-	suite.backend.queryClient.QueryClient = mocks.NewEVMQueryClient(suite.T())
+	queryClient := mocks.NewEVMQueryClient(suite.T())
+	// the chain config is built from the evm params
+	queryClient.On("Params", rpctypes.ContextWithHeight(1), &evmtypes.QueryParamsRequest{}).
+		Return(&evmtypes.QueryParamsResponse{Params: evmtypes.DefaultParams()}, nil).
+		Maybe()
+	suite.backend.queryClient.QueryClient = queryClient
 	suite.backend.queryClient.FeeMarket = mocks.NewFeeMarketQueryClient(suite.T())
 	suite.backend.ctx = rpctypes.ContextWithHeight(1)
 
@@ -98,6 +98,19 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+
+	// set code txs can't be decoded as go-ethereum transactions and are always
+	// replay-protected since they're typed transactions
+	if len(data) > 0 && data[0] == evmtypes.SetCodeTxType {
+		if err := ethereumTx.UnmarshalBinary(data); err != nil {
+			b.logger.Error("transaction decoding failed", "error", err.Error())
+			return common.Hash{}, err
+		}
+
+		return b.broadcastEthereumTx(ethereumTx)
+	}
+
 	// RLP decode raw transaction bytes
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(data); err != nil {
@@ -111,12 +124,17 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
 	}
 
-	ethereumTx := &evmtypes.MsgEthereumTx{}
 	if err := ethereumTx.FromEthereumTx(tx); err != nil {
 		b.logger.Error("transaction converting failed", "error", err.Error())
 		return common.Hash{}, err
 	}
 
+	return b.broadcastEthereumTx(ethereumTx)
+}
+
+// broadcastEthereumTx validates the given Ethereum tx, wraps it into a Cosmos
+// tx and broadcasts it in sync mode.
+func (b *Backend) broadcastEthereumTx(ethereumTx *evmtypes.MsgEthereumTx) (common.Hash, error) {
 	if err := ethereumTx.ValidateBasic(); err != nil {
 		b.logger.Debug("tx failed basic validation", "error", err.Error())
 		return common.Hash{}, err
@@ -137,7 +155,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
-	txHash := ethereumTx.AsTransaction().Hash()
+	txHash := ethereumTx.TxHash()
 
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
@@ -265,7 +283,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil, nil)
 		if err != nil {
 			return args, err
 		}
@@ -281,7 +299,12 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -292,6 +315,16 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		return 0, err
 	}
 
+	overridesBz, err := marshalOverrides(overrides)
+	if err != nil {
+		return 0, err
+	}
+
+	blockOverridesBz, err := marshalBlockOverrides(blockOverrides)
+	if err != nil {
+		return 0, err
+	}
+
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -303,6 +336,8 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		Overrides:       overridesBz,
+		BlockOverrides:  blockOverridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -321,7 +356,10 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -333,11 +371,23 @@ func (b *Backend) DoCall(
 		return nil, errors.New("header not found")
 	}
 
+	overridesBz, err := marshalOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	blockOverridesBz, err := marshalBlockOverrides(blockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
 	req := evmtypes.EthCallRequest{
 		Args:            bz,
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		Overrides:       overridesBz,
+		BlockOverrides:  blockOverridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -371,6 +421,118 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 executes the calls of the given simulation options sequentially on
+// top of the state of the given block. Each call sees the state changes left by
+// the previous calls and simulated blocks.
+func (b *Backend) SimulateV1(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) ([]*rpctypes.SimBlockResult, error) {
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*rpctypes.SimBlockResult, 0, len(res.Blocks))
+	for _, block := range res.Blocks {
+		result := &rpctypes.SimBlockResult{
+			Number:    hexutil.Uint64(block.Number),
+			Timestamp: hexutil.Uint64(block.Time),
+			GasUsed:   hexutil.Uint64(block.GasUsed),
+			Calls:     make([]rpctypes.SimCallResult, 0, len(block.Calls)),
+		}
+		if block.BaseFee != nil {
+			result.BaseFeePerGas = (*hexutil.Big)(block.BaseFee.BigInt())
+		}
+
+		for _, call := range block.Calls {
+			callResult := rpctypes.SimCallResult{
+				ReturnData: call.Ret,
+				Logs:       evmtypes.LogsToEthereum(call.Logs),
+				GasUsed:    hexutil.Uint64(call.GasUsed),
+				Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+			}
+			if callResult.ReturnData == nil {
+				callResult.ReturnData = hexutil.Bytes{}
+			}
+			if callResult.Logs == nil {
+				callResult.Logs = []*ethtypes.Log{}
+			}
+
+			if call.VmError != "" {
+				callResult.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+				callResult.Error = newSimCallError(call.VmError, call.Ret)
+			}
+			result.Calls = append(result.Calls, callResult)
+		}
+		blocks = append(blocks, result)
+	}
+
+	return blocks, nil
+}
+
+// simErrCodeVMError is the JSON-RPC error code used by geth for simulated calls
+// that fail with an EVM error other than a revert.
+const simErrCodeVMError = -32015
+
+// newSimCallError returns the error of a failed simulated call, using the
+// same error codes as geth.
+func newSimCallError(vmError string, ret []byte) *rpctypes.SimCallError {
+	if vmError != vm.ErrExecutionReverted.Error() {
+		return &rpctypes.SimCallError{Code: simErrCodeVMError, Message: vmError}
+	}
+
+	revertErr := evmtypes.NewExecErrorWithReason(ret)
+	return &rpctypes.SimCallError{
+		Code:    revertErr.ErrorCode(),
+		Message: revertErr.Error(),
+		Data:    hexutil.Encode(ret),
+	}
+}
+
+// marshalOverrides encodes the state overrides to be sent on an
+// EthCallRequest. It returns nil if no overrides are provided.
+func marshalOverrides(overrides *rpctypes.StateOverride) ([]byte, error) {
+	if overrides == nil || len(*overrides) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(overrides)
+}
+
+// marshalBlockOverrides encodes the given block overrides to be sent on an
+// EthCallRequest. It returns nil if there are no overrides.
+func marshalBlockOverrides(blockOverrides *rpctypes.BlockOverrides) ([]byte, error) {
+	if blockOverrides == nil {
+		return nil, nil
+	}
+	return json.Marshal(blockOverrides)
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
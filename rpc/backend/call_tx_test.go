@@ -13,6 +13,7 @@ import (
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v20/rpc/types"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 	"google.golang.org/grpc/metadata"
 )
@@ -432,7 +433,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, nil, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -443,6 +444,95 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSimulateV1() {
+	_, bz := suite.buildEthereumTx()
+	from := utiltx.GenerateAddress()
+	toAddr := utiltx.GenerateAddress()
+	opts := rpctypes.SimOpts{BlockStateCalls: []evmtypes.SimBlock{
+		{Calls: []evmtypes.TransactionArgs{{From: &from, To: &toAddr}, {From: &from, To: &toAddr}}},
+	}}
+	optsBz, err := json.Marshal(opts)
+	suite.Require().NoError(err)
+	request := &evmtypes.SimulateV1Request{Opts: optsBz, ChainId: suite.backend.chainID.Int64()}
+
+	revertData := common.FromHex("0x08c379a0")
+	baseFee := math.NewInt(1000)
+	response := &evmtypes.SimulateV1Response{
+		Blocks: []evmtypes.SimulatedBlock{
+			{
+				Number:  2,
+				Time:    100,
+				GasUsed: 42000,
+				BaseFee: &baseFee,
+				Calls: []evmtypes.SimulatedCallResult{
+					{Ret: []byte{1}, GasUsed: 21000},
+					{Ret: revertData, GasUsed: 21000, VmError: vm.ErrExecutionReverted.Error()},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - Invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterSimulateV1Error(queryClient, request)
+			},
+			false,
+		},
+		{
+			"pass - Returned simulated blocks",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterSimulateV1(queryClient, request, response)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			blocks, err := suite.backend.SimulateV1(opts, rpctypes.BlockNumber(1))
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(blocks, 1)
+			suite.Require().Equal(hexutil.Uint64(2), blocks[0].Number)
+			suite.Require().Equal(hexutil.Uint64(42000), blocks[0].GasUsed)
+			suite.Require().Equal(baseFee.BigInt(), blocks[0].BaseFeePerGas.ToInt())
+			suite.Require().Len(blocks[0].Calls, 2)
+
+			success := blocks[0].Calls[0]
+			suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), success.Status)
+			suite.Require().Nil(success.Error)
+			suite.Require().NotNil(success.Logs)
+
+			reverted := blocks[0].Calls[1]
+			suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), reverted.Status)
+			suite.Require().NotNil(reverted.Error)
+			suite.Require().Equal(3, reverted.Error.Code)
+			suite.Require().Equal(hexutil.Encode(revertData), reverted.Error.Data)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
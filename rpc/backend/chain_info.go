@@ -41,9 +41,15 @@ func (b *Backend) ChainID() (*hexutil.Big, error) {
 	return nil, fmt.Errorf("chain not synced beyond EIP-155 replay-protection fork block")
 }
 
-// ChainConfig returns the latest ethereum chain configuration
+// ChainConfig returns the latest ethereum chain configuration, including the
+// forks enabled by the evm params
 func (b *Backend) ChainConfig() *params.ChainConfig {
-	return evmtypes.GetEthChainConfig()
+	res, err := b.queryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		b.logger.Debug("failed to query evm params", "error", err.Error())
+		return evmtypes.GetEthChainConfig()
+	}
+	return res.Params.EthereumConfig(evmtypes.GetEthChainConfig())
 }
 
 // GlobalMinGasPrice returns MinGasPrice param from FeeMarket
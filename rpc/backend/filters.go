@@ -5,6 +5,7 @@ package backend
 import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/pkg/errors"
 )
 
@@ -21,7 +22,16 @@ func (b *Backend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
 }
 
 // GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
+// The logs are read from the indexer when available.
 func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	if b.indexer != nil && height != nil {
+		logs, err := b.indexer.GetLogsByBlock(*height)
+		if err == nil {
+			return logs, nil
+		}
+		b.logger.Debug("failed to get logs from the indexer", "height", *height, "error", err.Error())
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.rpcClient.BlockResults(b.ctx, height)
 	if err != nil {
@@ -31,8 +41,38 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// BlockBloomByHeight returns the bloom of the logs emitted in the block of the
+// given height. The bloom is read from the indexer when available.
+func (b *Backend) BlockBloomByHeight(height int64) (ethtypes.Bloom, error) {
+	if b.indexer != nil {
+		bloom, err := b.indexer.GetBlockBloom(height)
+		if err == nil {
+			return bloom, nil
+		}
+		b.logger.Debug("failed to get block bloom from the indexer", "height", height, "error", err.Error())
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return ethtypes.Bloom{}, err
+	}
+	return b.BlockBloom(blockRes)
+}
+
+// GetBloomBits returns the compressed bit vector of the given bloom bit for
+// all the blocks of the given section, as indexed by the indexer.
+func (b *Backend) GetBloomBits(section uint64, bit uint) ([]byte, error) {
+	if b.indexer == nil {
+		return nil, errors.New("indexer is disabled")
+	}
+	return b.indexer.GetBloomBits(section, bit)
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
-	return 4096, 0
+	if b.indexer == nil {
+		return params.BloomBitsBlocks, 0
+	}
+	return b.indexer.BloomStatus()
 }
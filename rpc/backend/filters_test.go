@@ -3,11 +3,17 @@ package backend
 import (
 	"encoding/json"
 
+	"cosmossdk.io/log"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v20/indexer"
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
 	ethrpc "github.com/evmos/evmos/v20/rpc/types"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -120,3 +126,61 @@ func (suite *BackendTestSuite) TestBloomStatus() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetLogsByHeightFromIndexer() {
+	suite.SetupTest()
+	suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), suite.backend.clientCtx)
+
+	_, priv := utiltx.NewAddrKey()
+	bz := suite.buildSignedEthTx(priv, 0)
+	block := cmttypes.MakeBlock(1, []cmttypes.Tx{bz}, nil, nil)
+
+	tx, err := suite.backend.clientCtx.TxConfig.TxDecoder()(bz)
+	suite.Require().NoError(err)
+	msgEthTx := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+
+	txLog := evmtypes.Log{
+		Address:     common.HexToAddress("0x1").Hex(),
+		Topics:      []string{common.HexToHash("0x2").Hex()},
+		BlockNumber: 1,
+		TxHash:      msgEthTx.Hash,
+	}
+	logBz, err := json.Marshal(&txLog)
+	suite.Require().NoError(err)
+
+	txResults := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: msgEthTx.Hash},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+				{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyTxLog, Value: string(logBz)},
+				}},
+			},
+		},
+	}
+
+	err = suite.backend.indexer.IndexBlock(block, txResults)
+	suite.Require().NoError(err)
+
+	// no CometBFT queries are registered, the logs are read from the indexer
+	height := int64(1)
+	logs, err := suite.backend.GetLogsByHeight(&height)
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]*ethtypes.Log{evmtypes.LogsToEthereum([]*evmtypes.Log{&txLog})}, logs)
+
+	bloom, err := suite.backend.BlockBloomByHeight(height)
+	suite.Require().NoError(err)
+	suite.Require().True(ethtypes.BloomLookup(bloom, common.HexToAddress("0x1")))
+
+	sectionSize, sections := suite.backend.BloomStatus()
+	suite.Require().Equal(uint64(4096), sectionSize)
+	suite.Require().Equal(uint64(0), sections)
+
+	_, err = suite.backend.GetBloomBits(0, 0)
+	suite.Require().Error(err)
+}
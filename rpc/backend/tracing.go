@@ -3,6 +3,7 @@
 package backend
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -168,6 +169,45 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		}
 	}
 
+	return b.traceBlockMsgs(height, config, block, txsMessages)
+}
+
+// TraceEthBlock traces the Ethereum transactions of the given block that were
+// executed by the EVM, skipping the ones that failed before their execution.
+// It returns the traced transactions along with one result per transaction.
+func (b *Backend) TraceEthBlock(
+	block *tmrpctypes.ResultBlock,
+	config *evmtypes.TraceConfig,
+) ([]*evmtypes.MsgEthereumTx, []*evmtypes.TxTraceResult, error) {
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
+		return nil, nil, err
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(block, blockRes)
+	if len(msgs) == 0 {
+		return msgs, []*evmtypes.TxTraceResult{}, nil
+	}
+
+	results, err := b.traceBlockMsgs(rpctypes.BlockNumber(block.Block.Height), config, block, msgs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(results) != len(msgs) {
+		return nil, nil, fmt.Errorf("invalid number of trace results: expected %d, got %d", len(msgs), len(results))
+	}
+	return msgs, results, nil
+}
+
+// traceBlockMsgs traces the given Ethereum transactions on top of the state at
+// the beginning of the given block.
+func (b *Backend) traceBlockMsgs(
+	height rpctypes.BlockNumber,
+	config *evmtypes.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+	txsMessages []*evmtypes.MsgEthereumTx,
+) ([]*evmtypes.TxTraceResult, error) {
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
 	if contextHeight < 1 {
@@ -202,10 +242,74 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return nil, err
 	}
 
-	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
+	decodedResults := make([]*evmtypes.TxTraceResult, len(txsMessages))
 	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
 		return nil, err
 	}
 
 	return decodedResults, nil
 }
+
+// TraceCall executes the given call on top of the state of the given block and
+// returns the structured logs created during the execution of EVM as a JSON
+// object. The state and block overrides of the config are applied before
+// executing the call.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	if config != nil {
+		traceCallRequest.TraceConfig = &config.TraceConfig
+		if traceCallRequest.Overrides, err = marshalOverrides(config.StateOverrides); err != nil {
+			return nil, err
+		}
+		if config.BlockOverrides != nil {
+			if traceCallRequest.BlockOverrides, err = json.Marshal(config.BlockOverrides); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Bound the trace with the EVM timeout, as it is done for eth_call, so a
+	// single request cannot hold the node indefinitely.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	var cancel context.CancelFunc
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	traceResult, err := b.queryClient.TraceCall(ctx, &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
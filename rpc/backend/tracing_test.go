@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"cosmossdk.io/log"
@@ -10,10 +11,13 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v20/indexer"
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -260,3 +264,145 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceEthBlock() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	emptyBlock := types.MakeBlock(1, []types.Tx{}, nil, nil)
+	emptyBlock.ChainID = ChainID
+	filledBlock := types.MakeBlock(1, []types.Tx{bz}, nil, nil)
+	filledBlock.ChainID = ChainID
+	resBlockEmpty := tmrpctypes.ResultBlock{Block: emptyBlock, BlockID: emptyBlock.LastBlockID}
+	resBlockFilled := tmrpctypes.ResultBlock{Block: filledBlock, BlockID: filledBlock.LastBlockID}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		resBlock     *tmrpctypes.ResultBlock
+		expMsgs      int
+		expPass      bool
+	}{
+		{
+			"fail - block results not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockResultsError(client, 1)
+			},
+			&resBlockEmpty,
+			0,
+			false,
+		},
+		{
+			"pass - block without Ethereum transactions",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+			},
+			&resBlockEmpty,
+			0,
+			true,
+		},
+		{
+			"fail - cannot unmarshal data",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterTraceBlock(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx})
+				RegisterConsensusParams(client, 1)
+			},
+			&resBlockFilled,
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			msgs, traceResults, err := suite.backend.TraceEthBlock(tc.resBlock, &evmtypes.TraceConfig{})
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(msgs, tc.expMsgs)
+				suite.Require().Len(traceResults, tc.expMsgs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	code := hexutil.Bytes{0x1}
+	config := &rpctypes.TraceCallConfig{
+		TraceConfig:    evmtypes.TraceConfig{Tracer: "callTracer"},
+		StateOverrides: &rpctypes.StateOverride{toAddr: {Code: &code}},
+	}
+	overridesBz, err := json.Marshal(config.StateOverrides)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		registerMock   func()
+		config         *rpctypes.TraceCallConfig
+		expTraceResult interface{}
+		expPass        bool
+	}{
+		{
+			"fail - Invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCallError(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - trace call with config and state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:        argsBz,
+					ChainId:     suite.backend.chainID.Int64(),
+					TraceConfig: &config.TraceConfig,
+					Overrides:   overridesBz,
+				})
+			},
+			config,
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.TraceCall(callArgs, rpctypes.BlockNumber(1), tc.config)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTraceResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -243,7 +243,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(txData.TxType()),
 	}
 
 	if logs == nil {
@@ -255,13 +255,14 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+	switch txData.(type) {
+	case *evmtypes.DynamicFeeTx, *evmtypes.SetCodeTx:
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
 			// tolerate the error for pruned node.
 			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
 		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+			receipt["effectiveGasPrice"] = hexutil.Big(*txData.EffectiveGasPrice(baseFee))
 		}
 	}
 
@@ -353,6 +354,36 @@ func (b *Backend) GetTxByEthHash(hash common.Hash) (*types.TxResult, error) {
 	return txResult, nil
 }
 
+// GetTransactionsByAddress returns the Ethereum format transactions sent from
+// or to the given address, or creating it, within the [fromBlock, toBlock]
+// range. The first `offset` transactions are skipped and at most `limit`
+// transactions are returned. It requires the address index of the custom tx
+// indexer.
+func (b *Backend) GetTransactionsByAddress(
+	address common.Address,
+	fromBlock, toBlock int64,
+	offset, limit uint64,
+) ([]*rpctypes.RPCTransaction, error) {
+	if b.indexer == nil {
+		return nil, errors.New("the custom tx indexer is disabled")
+	}
+
+	hashes, err := b.indexer.GetTxHashesByAddress(address, fromBlock, toBlock, offset, limit)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetTransactionsByAddress %s", address.Hex())
+	}
+
+	txs := make([]*rpctypes.RPCTransaction, 0, len(hashes))
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
 // GetTxByTxIndex uses `/tx_query` to find transaction by tx index of valid ethereum txs
 func (b *Backend) GetTxByTxIndex(height int64, index uint) (*types.TxResult, error) {
 	int32Index := int32(index) //nolint:gosec // G115 G115 -- checked for int overflow already
@@ -12,10 +12,13 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/evmos/v20/indexer"
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmostypes "github.com/evmos/evmos/v20/types"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 	"google.golang.org/grpc/metadata"
 )
@@ -501,6 +504,99 @@ func (suite *BackendTestSuite) TestGetTransactionByTxIndex() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetTransactionsByAddress() {
+	from, priv := utiltx.NewAddrKey()
+	txBz := suite.buildSignedEthTx(priv, 0)
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+
+	tx, err := suite.backend.clientCtx.TxConfig.TxDecoder()(txBz)
+	suite.Require().NoError(err)
+	msgEthereumTx := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: msgEthereumTx.Hash},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		addressIndex bool
+		address      common.Address
+		expTxs       int
+		expPass      bool
+	}{
+		{
+			"fail - address index disabled",
+			func() {},
+			false,
+			from,
+			0,
+			false,
+		},
+		{
+			"pass - no txs for the address",
+			func() {},
+			true,
+			common.HexToAddress("0x1"),
+			0,
+			true,
+		},
+		{
+			"pass - sender txs",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterBaseFee(queryClient, math.NewInt(1))
+			},
+			true,
+			from,
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), suite.backend.clientCtx).
+				WithAddressIndex(tc.addressIndex)
+			err := suite.backend.indexer.IndexBlock(block, responseDeliver)
+			suite.Require().NoError(err)
+
+			txs, err := suite.backend.GetTransactionsByAddress(tc.address, 0, 1, 0, 10)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(txs, tc.expTxs)
+				for _, rpcTx := range txs {
+					suite.Require().Equal(from, rpcTx.From)
+					suite.Require().Equal(common.HexToHash(msgEthereumTx.Hash), rpcTx.Hash)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	suite.SetupTest()
+	suite.backend.indexer = nil
+	_, err = suite.backend.GetTransactionsByAddress(from, 0, 1, 0, 10)
+	suite.Require().Error(err)
+}
+
 func (suite *BackendTestSuite) TestQueryTendermintTxIndexer() {
 	testCases := []struct {
 		name         string
@@ -612,6 +708,92 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetTransactionReceiptSetCodeTx() {
+	suite.SetupTest() // reset
+
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := utiltx.GenerateAddress()
+
+	auth, err := ethutils.SignSetCode(key, ethutils.SetCodeAuthorization{
+		ChainID: suite.backend.chainID,
+		Address: utiltx.GenerateAddress(),
+		Nonce:   1,
+	})
+	suite.Require().NoError(err)
+
+	ethTx := &ethutils.SetCodeTx{
+		ChainID:   suite.backend.chainID,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       100000,
+		To:        to,
+		Value:     big.NewInt(0),
+		AuthList:  []ethutils.SetCodeAuthorization{auth},
+	}
+	sigHash := ethTx.SigHash()
+	sig, err := crypto.Sign(sigHash[:], key)
+	suite.Require().NoError(err)
+	ethTx.R = new(big.Int).SetBytes(sig[:32])
+	ethTx.S = new(big.Int).SetBytes(sig[32:64])
+	ethTx.V = new(big.Int).SetUint64(uint64(sig[64]))
+
+	bz, err := ethTx.MarshalBinary()
+	suite.Require().NoError(err)
+	msgEthereumTx := &evmtypes.MsgEthereumTx{}
+	suite.Require().NoError(msgEthereumTx.UnmarshalBinary(bz))
+
+	tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	suite.Require().NoError(err)
+	txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	var header metadata.MD
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	RegisterParams(queryClient, &header, 1)
+	RegisterBaseFee(queryClient, math.NewInt(1))
+	_, err = RegisterBlock(client, 1, txBz)
+	suite.Require().NoError(err)
+	_, err = RegisterBlockResults(client, 1)
+	suite.Require().NoError(err)
+
+	block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: ethTx.Hash().Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "0"},
+					{Key: "txGasUsed", Value: "46000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: to.Hex()},
+				}},
+			},
+		},
+	}
+
+	db := dbm.NewMemDB()
+	suite.backend.indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), suite.backend.clientCtx)
+	err = suite.backend.indexer.IndexBlock(block, blockResult)
+	suite.Require().NoError(err)
+
+	receipt, err := suite.backend.GetTransactionReceipt(ethTx.Hash())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(receipt)
+	suite.Require().Equal(ethTx.Hash(), receipt["transactionHash"])
+	suite.Require().Equal(hexutil.Uint(ethutils.SetCodeTxType), receipt["type"])
+	suite.Require().Equal(from, receipt["from"])
+	suite.Require().Equal(&to, receipt["to"])
+	suite.Require().Nil(receipt["contractAddress"])
+	suite.Require().Equal(hexutil.Uint64(46000), receipt["gasUsed"])
+	// the effective gas price is the base fee plus the tip
+	suite.Require().Equal(hexutil.Big(*big.NewInt(2)), receipt["effectiveGasPrice"])
+}
+
 func (suite *BackendTestSuite) TestGetGasUsed() {
 	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
@@ -0,0 +1,131 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// TxPoolTransactions are the EVM transactions of the mempool grouped by
+// sender address and nonce.
+type TxPoolTransactions map[common.Address]map[uint64]*rpctypes.RPCTransaction
+
+// TxPoolContent returns the EVM transactions contained in the mempool, split
+// into pending and queued ones.
+//
+// A transaction is considered pending when its nonce follows, without gaps,
+// the committed sequence of the sender account. Otherwise, it is considered
+// queued.
+func (b *Backend) TxPoolContent() (pending, queued TxPoolTransactions, err error) {
+	txsBySender, err := b.pendingEthTxsBySender()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending = make(TxPoolTransactions)
+	queued = make(TxPoolTransactions)
+	for sender, msgs := range txsBySender {
+		senderPending, senderQueued, err := b.splitPendingAndQueued(sender, msgs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(senderPending) > 0 {
+			pending[sender] = senderPending
+		}
+		if len(senderQueued) > 0 {
+			queued[sender] = senderQueued
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// TxPoolContentFrom returns the pending and queued EVM transactions of the
+// mempool sent by the given address.
+func (b *Backend) TxPoolContentFrom(address common.Address) (
+	pending, queued map[uint64]*rpctypes.RPCTransaction, err error,
+) {
+	txsBySender, err := b.pendingEthTxsBySender()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return b.splitPendingAndQueued(address, txsBySender[address])
+}
+
+// pendingEthTxsBySender returns the EVM transactions of the mempool grouped by
+// the sender address. Cosmos transactions are skipped.
+func (b *Backend) pendingEthTxsBySender() (map[common.Address][]*evmtypes.MsgEthereumTx, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to get sender of pending tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			result[sender] = append(result[sender], ethMsg)
+		}
+	}
+
+	return result, nil
+}
+
+// splitPendingAndQueued splits the transactions of a sender into the
+// executable ones (pending) and the ones with a nonce gap (queued) against the
+// committed sequence of the account. Transactions with a nonce lower than the
+// committed sequence are discarded, since they can't be executed anymore.
+func (b *Backend) splitPendingAndQueued(sender common.Address, msgs []*evmtypes.MsgEthereumTx) (
+	pending, queued map[uint64]*rpctypes.RPCTransaction, err error,
+) {
+	pending = make(map[uint64]*rpctypes.RPCTransaction)
+	queued = make(map[uint64]*rpctypes.RPCTransaction)
+	if len(msgs) == 0 {
+		return pending, queued, nil
+	}
+
+	nonce, err := b.getAccountNonce(sender, false, 0, b.logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].AsTransaction().Nonce() < msgs[j].AsTransaction().Nonce()
+	})
+
+	for _, msg := range msgs {
+		txNonce := msg.AsTransaction().Nonce()
+		if txNonce < nonce {
+			continue
+		}
+
+		rpcTx, err := rpctypes.NewTransactionFromMsg(msg, common.Hash{}, uint64(0), uint64(0), nil, b.chainID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if txNonce == nonce {
+			pending[txNonce] = rpcTx
+			nonce++
+			continue
+		}
+		queued[txNonce] = rpcTx
+	}
+
+	return pending, queued, nil
+}
@@ -0,0 +1,131 @@
+package backend
+
+import (
+	"math/big"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v20/rpc/backend/mocks"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// buildSignedEthTx returns an encoded Ethereum transaction with the given nonce
+// signed with the given private key.
+func (suite *BackendTestSuite) buildSignedEthTx(priv *ethsecp256k1.PrivKey, nonce uint64) []byte {
+	msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.backend.chainID,
+		Nonce:    nonce,
+		To:       &common.Address{},
+		Amount:   big.NewInt(1),
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1),
+	})
+	msgEthereumTx.From = common.BytesToAddress(priv.PubKey().Address()).Hex()
+
+	ethSigner := ethtypes.LatestSignerForChainID(suite.backend.chainID)
+	err := msgEthereumTx.Sign(ethSigner, utiltx.NewSigner(priv))
+	suite.Require().NoError(err)
+
+	tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	suite.Require().NoError(err)
+
+	bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	return bz
+}
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	from, priv := utiltx.NewAddrKey()
+
+	testCases := []struct {
+		name         string
+		sequence     uint64
+		nonces       []uint64
+		expPending   []uint64
+		expQueued    []uint64
+		registerMock bool
+	}{
+		{
+			"pass - no txs in the mempool",
+			0,
+			nil,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - consecutive nonces are pending",
+			1,
+			[]uint64{2, 1, 3},
+			[]uint64{1, 2, 3},
+			nil,
+			true,
+		},
+		{
+			"pass - txs after a nonce gap are queued",
+			1,
+			[]uint64{1, 2, 4, 5},
+			[]uint64{1, 2},
+			[]uint64{4, 5},
+			true,
+		},
+		{
+			"pass - stale txs are skipped",
+			3,
+			[]uint64{1, 2, 3, 5},
+			[]uint64{3},
+			[]uint64{5},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.backend.clientCtx.InterfaceRegistry = network.New().GetEncodingConfig().InterfaceRegistry
+
+			txs := make(types.Txs, 0, len(tc.nonces))
+			for _, nonce := range tc.nonces {
+				txs = append(txs, suite.buildSignedEthTx(priv, nonce))
+			}
+
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			RegisterUnconfirmedTxs(client, nil, txs)
+			if tc.registerMock {
+				request := &authtypes.QueryAccountRequest{Address: sdk.AccAddress(from.Bytes()).String()}
+				requestMarshal, err := request.Marshal()
+				suite.Require().NoError(err)
+				RegisterABCIQueryAccount(
+					client,
+					requestMarshal,
+					tmrpcclient.ABCIQueryOptions{Height: int64(1), Prove: false},
+					authtypes.NewBaseAccount(from.Bytes(), nil, 1, tc.sequence),
+				)
+			}
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			suite.Require().NoError(err)
+
+			suite.Require().Len(pending[from], len(tc.expPending))
+			for _, nonce := range tc.expPending {
+				suite.Require().Contains(pending[from], nonce)
+			}
+			suite.Require().Len(queued[from], len(tc.expQueued))
+			for _, nonce := range tc.expQueued {
+				suite.Require().Contains(queued[from], nonce)
+			}
+
+			fromPending, fromQueued, err := suite.backend.TxPoolContentFrom(from)
+			suite.Require().NoError(err)
+			suite.Require().Len(fromPending, len(tc.expPending))
+			suite.Require().Len(fromQueued, len(tc.expQueued))
+		})
+	}
+}
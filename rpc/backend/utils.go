@@ -3,7 +3,6 @@
 package backend
 
 import (
-	"encoding/json"
 	"fmt"
 	"math/big"
 	"sort"
@@ -212,18 +211,14 @@ func (b *Backend) processBlock(
 
 // AllTxLogsFromEvents parses all ethereum logs from cosmos events
 func AllTxLogsFromEvents(events []abci.Event) ([][]*ethtypes.Log, error) {
-	allLogs := make([][]*ethtypes.Log, 0, 4)
-	for _, event := range events {
-		if event.Type != evmtypes.EventTypeTxLog {
-			continue
-		}
-
-		logs, err := ParseTxLogsFromEvent(event)
-		if err != nil {
-			return nil, err
-		}
+	msgsLogs, err := types.AllTxLogsFromEvents(events)
+	if err != nil {
+		return nil, err
+	}
 
-		allLogs = append(allLogs, logs)
+	allLogs := make([][]*ethtypes.Log, 0, len(msgsLogs))
+	for _, logs := range msgsLogs {
+		allLogs = append(allLogs, evmtypes.LogsToEthereum(logs))
 	}
 	return allLogs, nil
 }
@@ -248,18 +243,9 @@ func TxLogsFromEvents(events []abci.Event, msgIndex int) ([]*ethtypes.Log, error
 
 // ParseTxLogsFromEvent parse tx logs from one event
 func ParseTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
-	logs := make([]*evmtypes.Log, 0, len(event.Attributes))
-	for _, attr := range event.Attributes {
-		if attr.Key != evmtypes.AttributeKeyTxLog {
-			continue
-		}
-
-		var log evmtypes.Log
-		if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
-			return nil, err
-		}
-
-		logs = append(logs, &log)
+	logs, err := types.TxLogsFromEvent(event)
+	if err != nil {
+		return nil, err
 	}
 	return evmtypes.LogsToEthereum(logs), nil
 }
@@ -46,6 +46,8 @@ type Backend interface {
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
+	BlockBloomByHeight(height int64) (ethtypes.Bloom, error)
+	GetBloomBits(section uint64, bit uint) ([]byte, error)
 
 	BloomStatus() (uint64, uint64)
 
@@ -176,7 +178,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 					for _, msg := range tx.GetMsgs() {
 						ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
 						if ok {
-							f.hashes = append(f.hashes, ethTx.AsTransaction().Hash())
+							f.hashes = append(f.hashes, ethTx.TxHash())
 						}
 					}
 				}
@@ -240,7 +242,7 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 				for _, msg := range tx.GetMsgs() {
 					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
 					if ok {
-						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash()) // #nosec G703
+						_ = notifier.Notify(rpcSub.ID, ethTx.TxHash()) // #nosec G703
 					}
 				}
 			case <-rpcSub.Err():
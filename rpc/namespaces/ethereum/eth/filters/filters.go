@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/filters"
@@ -35,6 +36,7 @@ type Filter struct {
 	criteria filters.FilterCriteria
 
 	bloomFilters [][]BloomIV // Filter the system is matching for
+	bloomBits    [][][3]uint // Bloom bit indexes of the filter, used to match the bloom bits sections
 }
 
 // NewBlockFilter creates a new filter which directly inspects the contents of
@@ -75,7 +77,9 @@ func NewRangeFilter(logger log.Logger, backend Backend, begin, end int64, addres
 		Topics:    topics,
 	}
 
-	return newFilter(logger, backend, criteria, createBloomFilters(filtersBz, logger))
+	filter := newFilter(logger, backend, criteria, createBloomFilters(filtersBz, logger))
+	filter.bloomBits = createBloomBits(filtersBz)
+	return filter
 }
 
 // newFilter returns a new Filter
@@ -156,30 +160,105 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
-	for height := from; height <= to; height++ {
-		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
-		if err != nil {
-			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
-			return nil, nil
+	sectionSize, sections := f.backend.BloomStatus()
+	for height := from; height <= to; {
+		// skip the blocks of the indexed bloom bits sections that can't match the filter
+		section := uint64(height) / sectionSize // #nosec G115 -- height is positive
+		if matches := f.sectionMatches(section, sections); matches != nil {
+			sectionStart := int64(section * sectionSize)             // #nosec G115 -- fits int64
+			sectionEnd := min(sectionStart+int64(sectionSize)-1, to) // #nosec G115 -- fits int64
+			for ; height <= sectionEnd; height++ {
+				index := height - sectionStart
+				if matches[index/8]&(1<<(7-index%8)) == 0 {
+					continue
+				}
+				if logs, err = f.appendHeightLogs(logs, height, logLimit); err != nil || logs == nil {
+					return nil, err
+				}
+			}
+			continue
 		}
 
-		bloom, err := f.backend.BlockBloom(blockRes)
-		if err != nil {
+		if logs, err = f.appendHeightLogs(logs, height, logLimit); err != nil || logs == nil {
 			return nil, err
 		}
+		height++
+	}
+	return logs, nil
+}
 
-		filtered, err := f.blockLogs(blockRes, bloom)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
-		}
+// appendHeightLogs appends the logs matching the filter criteria within the
+// block of the given height, checking the logs limit. It returns nil logs if
+// the block couldn't be fetched.
+func (f *Filter) appendHeightLogs(logs []*ethtypes.Log, height int64, logLimit int) ([]*ethtypes.Log, error) {
+	bloom, err := f.backend.BlockBloomByHeight(height)
+	if err != nil {
+		f.logger.Debug("failed to fetch block bloom", "height", height, "error", err.Error())
+		return nil, nil
+	}
+
+	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
+		return logs, nil
+	}
+
+	logsList, err := f.backend.GetLogsByHeight(&height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch logs block number %d", height)
+	}
+
+	unfiltered := make([]*ethtypes.Log, 0)
+	for _, txLogs := range logsList {
+		unfiltered = append(unfiltered, txLogs...)
+	}
+	filtered := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
+
+	// check logs limit
+	if len(logs)+len(filtered) > logLimit {
+		return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+	return append(logs, filtered...), nil
+}
+
+// sectionMatches returns the bit vector of the blocks of the given bloom bits
+// section that may match the filter criteria. It returns nil if the section is
+// not indexed or the filter has no bloom bits to match.
+func (f *Filter) sectionMatches(section, sections uint64) []byte {
+	if section >= sections || len(f.bloomBits) == 0 {
+		return nil
+	}
 
-		// check logs limit
-		if len(logs)+len(filtered) > logLimit {
-			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	var matches []byte
+	for _, clauses := range f.bloomBits {
+		// any of the clauses of the rule can match
+		var ruleMatches []byte
+		for _, bits := range clauses {
+			// all the bits of the clause must be set
+			var clauseMatches []byte
+			for _, bit := range bits {
+				bitset, err := f.backend.GetBloomBits(section, bit)
+				if err != nil {
+					f.logger.Debug("failed to fetch bloom bits", "section", section, "bit", bit, "error", err.Error())
+					return nil
+				}
+				if clauseMatches == nil {
+					clauseMatches = bitset
+				} else {
+					bitutil.ANDBytes(clauseMatches, clauseMatches, bitset)
+				}
+			}
+			if ruleMatches == nil {
+				ruleMatches = clauseMatches
+			} else {
+				bitutil.ORBytes(ruleMatches, ruleMatches, clauseMatches)
+			}
+		}
+		if matches == nil {
+			matches = ruleMatches
+		} else {
+			bitutil.ANDBytes(matches, matches, ruleMatches)
 		}
-		logs = append(logs, filtered...)
 	}
-	return logs, nil
+	return matches
 }
 
 // blockLogs returns the logs matching the filter criteria within a single block.
@@ -206,6 +285,42 @@ func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtyp
 	return logs, nil
 }
 
+// createBloomBits returns the bloom bit indexes of every clause of the filter
+// rules. The rules with nil clauses are ignored since they match any block.
+func createBloomBits(filters [][][]byte) [][][3]uint {
+	bloomBits := make([][][3]uint, 0)
+	for _, filter := range filters {
+		if len(filter) == 0 {
+			continue
+		}
+
+		bits := make([][3]uint, len(filter))
+		for i, clause := range filter {
+			if clause == nil {
+				bits = nil
+				break
+			}
+			bits[i] = calcBloomBitIndexes(clause)
+		}
+		if bits != nil {
+			bloomBits = append(bloomBits, bits)
+		}
+	}
+	return bloomBits
+}
+
+// calcBloomBitIndexes returns the bloom bit indexes set by the given data,
+// revised from https://github.com/ethereum/go-ethereum/blob/v1.10.26/core/bloombits/matcher.go#L44
+func calcBloomBitIndexes(data []byte) [3]uint {
+	hash := crypto.Keccak256(data)
+
+	var idxs [3]uint
+	for i := 0; i < len(idxs); i++ {
+		idxs[i] = (uint(hash[2*i])<<8)&2047 + uint(hash[2*i+1])
+	}
+	return idxs
+}
+
 func createBloomFilters(filters [][][]byte, logger log.Logger) [][]BloomIV {
 	bloomFilters := make([][]BloomIV, 0)
 	for _, filter := range filters {
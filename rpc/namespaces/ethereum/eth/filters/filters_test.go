@@ -0,0 +1,34 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcBloomBitIndexes(t *testing.T) {
+	address := common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2")
+
+	var bloom ethtypes.Bloom
+	bloom.Add(address.Bytes())
+
+	for _, bit := range calcBloomBitIndexes(address.Bytes()) {
+		byteIndex := ethtypes.BloomByteLength - 1 - bit/8
+		require.NotZero(t, bloom[byteIndex]&(1<<(bit%8)), "bit %d", bit)
+	}
+}
+
+func TestCreateBloomBits(t *testing.T) {
+	address := common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2")
+	topic := common.HexToHash("0x01")
+
+	bloomBits := createBloomBits([][][]byte{
+		{address.Bytes()},
+		{topic.Bytes(), nil}, // a nil clause matches any block
+		{},
+	})
+	require.Len(t, bloomBits, 1)
+	require.Equal(t, calcBloomBitIndexes(address.Bytes()), bloomBits[0][0])
+}
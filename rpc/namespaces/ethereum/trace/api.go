@@ -0,0 +1,368 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package trace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v20/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// API is the collection of Parity-style tracing APIs exposed over the trace
+// namespace. The traces are built from the output of the native EVM tracers.
+type API struct {
+	ctx     *server.Context
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the trace methods of the Ethereum service.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		ctx:     ctx,
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+var (
+	callTracerConfig     = &evmtypes.TraceConfig{Tracer: "callTracer"}
+	stateDiffTraceConfig = &evmtypes.TraceConfig{Tracer: "prestateTracer", TracerJsonConfig: `{"diffMode":true}`}
+	vmTraceConfig        = &evmtypes.TraceConfig{Tracer: "vmTracer"}
+)
+
+// Block returns the flat traces of all the transactions of the given block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) ([]*rpctypes.LocalizedTrace, error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+
+	resBlock, err := a.getBlock(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	return a.blockTraces(resBlock)
+}
+
+// Transaction returns the flat traces of the given transaction.
+func (a *API) Transaction(hash common.Hash) ([]*rpctypes.LocalizedTrace, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+
+	res, err := a.backend.GetTxByEthHash(hash)
+	if err != nil {
+		a.logger.Debug("tx not found", "hash", hash)
+		return nil, err
+	}
+
+	resBlock, err := a.getBlock(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.backend.TraceTransaction(hash, callTracerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var frame rpctypes.CallFrame
+	if err := decodeTraceResult(result, &frame); err != nil {
+		return nil, err
+	}
+
+	txIndex := uint64(0)
+	if res.EthTxIndex > 0 {
+		txIndex = uint64(res.EthTxIndex)
+	}
+	blockHash := common.BytesToHash(resBlock.Block.Hash())
+	return localizeTraces(rpctypes.FlattenCallFrame(frame), blockHash, resBlock.Block.Height, hash, txIndex), nil
+}
+
+// Get returns the flat trace of the given transaction at the given trace
+// address. It returns nil if there is no trace at the given address.
+func (a *API) Get(hash common.Hash, indices []hexutil.Uint64) (*rpctypes.LocalizedTrace, error) {
+	a.logger.Debug("trace_get", "hash", hash, "indices", indices)
+
+	traces, err := a.Transaction(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, trace := range traces {
+		if matchTraceAddress(trace.TraceAddress, indices) {
+			return trace, nil
+		}
+	}
+	return nil, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and
+// returns the requested trace types of each of them. The supported trace
+// types are "trace", "stateDiff" and "vmTrace".
+func (a *API) ReplayBlockTransactions(blockNr rpctypes.BlockNumber, traceTypes []string) ([]*rpctypes.TraceResults, error) {
+	a.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "types", traceTypes)
+
+	if len(traceTypes) == 0 {
+		return nil, errors.New("no trace types requested")
+	}
+
+	var withTrace, withStateDiff, withVMTrace bool
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case rpctypes.TraceTypeTrace:
+			withTrace = true
+		case rpctypes.TraceTypeStateDiff:
+			withStateDiff = true
+		case rpctypes.TraceTypeVMTrace:
+			withVMTrace = true
+		default:
+			return nil, fmt.Errorf("invalid trace type: %s", traceType)
+		}
+	}
+
+	resBlock, err := a.getBlock(blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the call traces are always needed for the output of the transactions
+	msgs, callResults, err := a.backend.TraceEthBlock(resBlock, callTracerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*rpctypes.TraceResults, len(msgs))
+	for i, msg := range msgs {
+		var frame rpctypes.CallFrame
+		if err := decodeTxTraceResult(callResults[i], &frame); err != nil {
+			return nil, err
+		}
+
+		results[i] = &rpctypes.TraceResults{
+			Output:          frame.Output,
+			TransactionHash: common.HexToHash(msg.Hash),
+		}
+		if results[i].Output == nil {
+			results[i].Output = hexutil.Bytes{}
+		}
+		if withTrace {
+			results[i].Trace = rpctypes.FlattenCallFrame(frame)
+		}
+	}
+
+	if withStateDiff {
+		_, diffResults, err := a.backend.TraceEthBlock(resBlock, stateDiffTraceConfig)
+		if err != nil {
+			return nil, err
+		}
+		for i, result := range diffResults {
+			var diff rpctypes.PrestateDiff
+			if err := decodeTxTraceResult(result, &diff); err != nil {
+				return nil, err
+			}
+			results[i].StateDiff = rpctypes.NewStateDiff(diff)
+		}
+	}
+
+	if withVMTrace {
+		_, vmResults, err := a.backend.TraceEthBlock(resBlock, vmTraceConfig)
+		if err != nil {
+			return nil, err
+		}
+		for i, result := range vmResults {
+			if err := decodeTxTraceResult(result, &results[i].VMTrace); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// Filter returns the flat traces of the given block range that match the
+// given addresses. Only the blocks that contain Ethereum transactions
+// according to the transaction indexer are traced.
+func (a *API) Filter(args rpctypes.TraceFilterArgs) ([]*rpctypes.LocalizedTrace, error) {
+	a.logger.Debug("trace_filter", "args", args)
+
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from := resolveBlockNumber(args.FromBlock, int64(latest)) //#nosec G115 -- block height fits int64
+	to := resolveBlockNumber(args.ToBlock, int64(latest))     //#nosec G115 -- block height fits int64
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from block %d is greater than to block %d", from, to)
+	}
+	if blockRange := a.backend.RPCBlockRangeCap(); blockRange > 0 && to-from > int64(blockRange) {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockRange)
+	}
+	// genesis is not traceable
+	if from < 1 {
+		from = 1
+	}
+
+	var after, count uint64
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	traces := []*rpctypes.LocalizedTrace{}
+	for height := from; height <= to; height++ {
+		// skip the blocks without Ethereum transactions
+		if _, err := a.backend.GetTxByTxIndex(height, 0); err != nil {
+			continue
+		}
+
+		resBlock, err := a.getBlock(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		blockTraces, err := a.blockTraces(resBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !trace.MatchAddresses(args.FromAddress, args.ToAddress) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			traces = append(traces, trace)
+			if count > 0 && uint64(len(traces)) == count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// getBlock returns the block of the given height, failing for the genesis
+// block since it is not traceable.
+func (a *API) getBlock(blockNr rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		a.logger.Debug("get block failed", "height", blockNr, "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "height", blockNr)
+		return nil, errors.New("block not found")
+	}
+	return resBlock, nil
+}
+
+// blockTraces returns the flat traces of all the Ethereum transactions of the
+// given block.
+func (a *API) blockTraces(resBlock *tmrpctypes.ResultBlock) ([]*rpctypes.LocalizedTrace, error) {
+	msgs, results, err := a.backend.TraceEthBlock(resBlock, callTracerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Hash())
+	traces := []*rpctypes.LocalizedTrace{}
+	for i, msg := range msgs {
+		var frame rpctypes.CallFrame
+		if err := decodeTxTraceResult(results[i], &frame); err != nil {
+			return nil, err
+		}
+
+		txTraces := localizeTraces(
+			rpctypes.FlattenCallFrame(frame),
+			blockHash,
+			resBlock.Block.Height,
+			common.HexToHash(msg.Hash),
+			uint64(i),
+		)
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// localizeTraces adds the block and transaction details to the given traces.
+func localizeTraces(
+	traces []*rpctypes.Trace,
+	blockHash common.Hash,
+	height int64,
+	txHash common.Hash,
+	txIndex uint64,
+) []*rpctypes.LocalizedTrace {
+	localized := make([]*rpctypes.LocalizedTrace, len(traces))
+	for i, trace := range traces {
+		localized[i] = &rpctypes.LocalizedTrace{
+			Trace:               *trace,
+			BlockHash:           blockHash,
+			BlockNumber:         uint64(height), //#nosec G115 -- block height is positive
+			TransactionHash:     txHash,
+			TransactionPosition: txIndex,
+		}
+	}
+	return localized
+}
+
+// decodeTxTraceResult decodes the result of a traced transaction into the
+// given value, failing if the transaction couldn't be traced.
+func decodeTxTraceResult(result *evmtypes.TxTraceResult, v interface{}) error {
+	if result == nil {
+		return errors.New("missing trace result")
+	}
+	if result.Error != "" {
+		return fmt.Errorf("failed to trace transaction: %s", result.Error)
+	}
+	return decodeTraceResult(result.Result, v)
+}
+
+// decodeTraceResult decodes the generic JSON result of a tracer into the
+// given value.
+func decodeTraceResult(result interface{}, v interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
+
+// resolveBlockNumber returns the height of the given block number, defaulting
+// to the latest block.
+func resolveBlockNumber(blockNr *rpctypes.BlockNumber, latest int64) int64 {
+	if blockNr == nil || *blockNr < 0 {
+		return latest
+	}
+	return blockNr.Int64()
+}
+
+// matchTraceAddress returns true if the trace address equals the given indices.
+func matchTraceAddress(traceAddress []int, indices []hexutil.Uint64) bool {
+	if len(traceAddress) != len(indices) {
+		return false
+	}
+	for i, index := range indices {
+		if uint64(traceAddress[i]) != uint64(index) { //#nosec G115 -- trace addresses are positive
+			return false
+		}
+	}
+	return true
+}
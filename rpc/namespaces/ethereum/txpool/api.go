@@ -3,42 +3,87 @@
 package txpool
 
 import (
+	"fmt"
+
 	"cosmossdk.io/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/evmos/evmos/v20/rpc/backend"
 	"github.com/evmos/evmos/v20/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The transactions are read from the CometBFT mempool of the node. A transaction is reported as
+// queued when there's a nonce gap against the committed sequence of the sender account.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+		"pending": make(map[string]map[string]*types.RPCTransaction, len(pending)),
+		"queued":  make(map[string]map[string]*types.RPCTransaction, len(queued)),
+	}
+	for addr, txs := range pending {
+		content["pending"][addr.Hex()] = flattenTxs(txs)
+	}
+	for addr, txs := range queued {
+		content["queued"][addr.Hex()] = flattenTxs(txs)
 	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool
+// sent by the given address.
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+	pending, queued, err := api.backend.TxPoolContentFrom(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": flattenTxs(pending),
+		"queued":  flattenTxs(queued),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+		"pending": make(map[string]map[string]string, len(pending)),
+		"queued":  make(map[string]map[string]string, len(queued)),
+	}
+	for addr, txs := range pending {
+		content["pending"][addr.Hex()] = inspectTxs(txs)
+	}
+	for addr, txs := range queued {
+		content["queued"][addr.Hex()] = inspectTxs(txs)
 	}
 	return content, nil
 }
@@ -46,8 +91,48 @@ func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error)
 // Status returns the number of pending and queued transaction in the pool.
 func (api *PublicAPI) Status() map[string]hexutil.Uint {
 	api.logger.Debug("txpool_status")
-	return map[string]hexutil.Uint{
+	status := map[string]hexutil.Uint{
 		"pending": hexutil.Uint(0),
 		"queued":  hexutil.Uint(0),
 	}
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		api.logger.Debug("failed to get txpool content", "error", err.Error())
+		return status
+	}
+
+	for _, txs := range pending {
+		status["pending"] += hexutil.Uint(len(txs))
+	}
+	for _, txs := range queued {
+		status["queued"] += hexutil.Uint(len(txs))
+	}
+	return status
+}
+
+// flattenTxs converts the nonce keys of the given transactions to strings.
+func flattenTxs(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	result := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprintf("%d", nonce)] = tx
+	}
+	return result
+}
+
+// inspectTxs returns a summary of the given transactions indexed by nonce.
+func inspectTxs(txs map[uint64]*types.RPCTransaction) map[string]string {
+	result := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprintf("%d", nonce)] = formatTx(tx)
+	}
+	return result
+}
+
+// formatTx returns a summary of the transaction following the go-ethereum format.
+func formatTx(tx *types.RPCTransaction) string {
+	if tx.To != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
 }
@@ -0,0 +1,79 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evmos
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/log"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/rpc/backend"
+	"github.com/evmos/evmos/v20/rpc/types"
+)
+
+const (
+	// DefaultPageSize is the number of transactions returned per page when no
+	// page size is given.
+	DefaultPageSize = 100
+	// MaxPageSize is the maximum number of transactions returned per page.
+	MaxPageSize = 1000
+)
+
+// PublicAPI offers the Evmos specific APIs that extend the Ethereum ones,
+// like the transaction history of an address.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates a new API definition for the Evmos specific methods.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "evmos"),
+		backend: backend,
+	}
+}
+
+// GetTransactionsByAddress returns the transactions sent from or to the given
+// address, or creating it, within the given block range in ascending order.
+// The block range defaults to the whole chain and the results are paginated.
+// It requires the address index of the custom tx indexer to be enabled.
+func (api *PublicAPI) GetTransactionsByAddress(address common.Address, args types.AddressTxsArgs) ([]*types.RPCTransaction, error) {
+	api.logger.Debug("evmos_getTransactionsByAddress", "address", address, "args", args)
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from := int64(0)
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = args.FromBlock.Int64()
+	}
+	to := int64(latest) //#nosec G115 -- block height fits int64
+	if args.ToBlock != nil && *args.ToBlock >= 0 && args.ToBlock.Int64() < to {
+		to = args.ToBlock.Int64()
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from block %d is greater than to block %d", from, to)
+	}
+
+	page, pageSize := uint64(1), uint64(DefaultPageSize)
+	if args.Page != nil {
+		page = uint64(*args.Page)
+	}
+	if args.PageSize != nil {
+		pageSize = uint64(*args.PageSize)
+	}
+	if page == 0 {
+		return nil, errors.New("page must be greater than 0")
+	}
+	if pageSize == 0 || pageSize > MaxPageSize {
+		return nil, fmt.Errorf("page size must be between 1 and %d", MaxPageSize)
+	}
+
+	return api.backend.GetTransactionsByAddress(address, from, to, (page-1)*pageSize, pageSize)
+}
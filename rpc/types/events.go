@@ -3,6 +3,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -260,3 +261,40 @@ func fillTxAttributes(tx *ParsedTx, attrs []abci.EventAttribute) error {
 	}
 	return nil
 }
+
+// AllTxLogsFromEvents parses the logs of every eth msg of a tx from its
+// events, in the order of the msgs.
+func AllTxLogsFromEvents(events []abci.Event) ([][]*evmtypes.Log, error) {
+	allLogs := make([][]*evmtypes.Log, 0, 4)
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+
+		logs, err := TxLogsFromEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		allLogs = append(allLogs, logs)
+	}
+	return allLogs, nil
+}
+
+// TxLogsFromEvent parses the logs of an eth msg from its tx_log event.
+func TxLogsFromEvent(event abci.Event) ([]*evmtypes.Log, error) {
+	logs := make([]*evmtypes.Log, 0, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		if attr.Key != evmtypes.AttributeKeyTxLog {
+			continue
+		}
+
+		var log evmtypes.Log
+		if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, &log)
+	}
+	return logs, nil
+}
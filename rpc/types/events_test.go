@@ -188,3 +188,61 @@ func TestParseTxResult(t *testing.T) {
 		})
 	}
 }
+
+func TestAllTxLogsFromEvents(t *testing.T) {
+	address := "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"
+	log := `{"address":"` + address + `"}`
+
+	testCases := []struct {
+		name    string
+		events  []abci.Event
+		expLogs int
+		expPass bool
+	}{
+		{
+			"no tx log events",
+			[]abci.Event{{Type: evmtypes.EventTypeEthereumTx}},
+			0,
+			true,
+		},
+		{
+			"logs of two msgs",
+			[]abci.Event{
+				{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyTxLog, Value: log},
+					{Key: evmtypes.AttributeKeyTxLog, Value: log},
+				}},
+				{Type: evmtypes.EventTypeTxLog},
+			},
+			2,
+			true,
+		},
+		{
+			"invalid log",
+			[]abci.Event{
+				{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyTxLog, Value: "invalid"},
+				}},
+			},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := AllTxLogsFromEvents(tc.events)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, logs, tc.expLogs)
+			if tc.expLogs > 0 {
+				require.Len(t, logs[0], 2)
+				require.Equal(t, address, logs[0][0].Address)
+				require.Empty(t, logs[1])
+			}
+		})
+	}
+}
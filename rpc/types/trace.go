@@ -0,0 +1,364 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Parity trace types, as returned by the trace namespace.
+// Ref: https://openethereum.github.io/JSONRPC-trace-module
+
+const (
+	// TraceTypeTrace requests the flat call traces of a transaction.
+	TraceTypeTrace = "trace"
+	// TraceTypeStateDiff requests the state modifications of a transaction.
+	TraceTypeStateDiff = "stateDiff"
+	// TraceTypeVMTrace requests the executed operations of a transaction.
+	TraceTypeVMTrace = "vmTrace"
+)
+
+// CallFrame is the nested call trace returned by the native callTracer.
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+}
+
+// TraceAction is the action performed by a traced call, contract creation or
+// self destruct.
+type TraceAction struct {
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+	Address        *common.Address `json:"address,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// TraceResult is the result of a successful traced call or contract creation.
+type TraceResult struct {
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+}
+
+// Trace is a single flat trace of a transaction.
+type Trace struct {
+	Action       TraceAction  `json:"action"`
+	Error        string       `json:"error,omitempty"`
+	Result       *TraceResult `json:"result"`
+	Subtraces    int          `json:"subtraces"`
+	TraceAddress []int        `json:"traceAddress"`
+	Type         string       `json:"type"`
+}
+
+// LocalizedTrace is a flat trace along with the block and transaction that
+// produced it.
+type LocalizedTrace struct {
+	Trace
+	BlockHash           common.Hash `json:"blockHash"`
+	BlockNumber         uint64      `json:"blockNumber"`
+	TransactionHash     common.Hash `json:"transactionHash"`
+	TransactionPosition uint64      `json:"transactionPosition"`
+}
+
+// TraceResults are the traces of a transaction replayed with
+// trace_replayBlockTransactions. The fields of the trace types that were not
+// requested are left empty.
+type TraceResults struct {
+	Output          hexutil.Bytes   `json:"output"`
+	StateDiff       StateDiff       `json:"stateDiff"`
+	Trace           []*Trace        `json:"trace"`
+	VMTrace         json.RawMessage `json:"vmTrace"`
+	TransactionHash common.Hash     `json:"transactionHash"`
+}
+
+// TraceFilterArgs are the arguments of trace_filter.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// FlattenCallFrame converts the nested call frames returned by the callTracer
+// into a list of flat traces, sorted in depth-first order.
+func FlattenCallFrame(frame CallFrame) []*Trace {
+	return flattenCallFrame(frame, []int{}, nil)
+}
+
+func flattenCallFrame(frame CallFrame, traceAddress []int, traces []*Trace) []*Trace {
+	traces = append(traces, newTrace(frame, traceAddress))
+	for i, call := range frame.Calls {
+		subAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(subAddress, traceAddress)
+		traces = flattenCallFrame(call, append(subAddress, i), traces)
+	}
+	return traces
+}
+
+// newTrace returns the flat trace of the given call frame, ignoring its sub
+// calls.
+func newTrace(frame CallFrame, traceAddress []int) *Trace {
+	trace := &Trace{
+		Error:        frame.Error,
+		Subtraces:    len(frame.Calls),
+		TraceAddress: traceAddress,
+	}
+
+	from := frame.From
+	gas := frame.Gas
+	value := frame.Value
+	if value == nil {
+		value = (*hexutil.Big)(new(big.Int))
+	}
+
+	switch frame.Type {
+	case "CREATE", "CREATE2":
+		trace.Type = "create"
+		trace.Action = TraceAction{
+			CreationMethod: strings.ToLower(frame.Type),
+			From:           &from,
+			Gas:            &gas,
+			Init:           &frame.Input,
+			Value:          value,
+		}
+		if frame.Error == "" {
+			trace.Result = &TraceResult{
+				GasUsed: frame.GasUsed,
+				Address: frame.To,
+				Code:    &frame.Output,
+			}
+		}
+	case "SELFDESTRUCT":
+		trace.Type = "suicide"
+		trace.Action = TraceAction{
+			Address:       &from,
+			RefundAddress: frame.To,
+			Balance:       value,
+		}
+	default:
+		trace.Type = "call"
+		trace.Action = TraceAction{
+			CallType: strings.ToLower(frame.Type),
+			From:     &from,
+			To:       frame.To,
+			Gas:      &gas,
+			Input:    &frame.Input,
+			Value:    value,
+		}
+		if frame.Error == "" {
+			output := frame.Output
+			if output == nil {
+				output = hexutil.Bytes{}
+			}
+			trace.Result = &TraceResult{
+				GasUsed: frame.GasUsed,
+				Output:  &output,
+			}
+		}
+	}
+
+	return trace
+}
+
+// MatchAddresses returns true if the trace was sent from one of the from
+// addresses and to one of the to addresses. An empty list matches any address.
+func (t *Trace) MatchAddresses(fromAddresses, toAddresses []common.Address) bool {
+	var from, to *common.Address
+	switch t.Type {
+	case "create":
+		from = t.Action.From
+		if t.Result != nil {
+			to = t.Result.Address
+		}
+	case "suicide":
+		from, to = t.Action.Address, t.Action.RefundAddress
+	default:
+		from, to = t.Action.From, t.Action.To
+	}
+
+	return containsAddress(fromAddresses, from) && containsAddress(toAddresses, to)
+}
+
+func containsAddress(addresses []common.Address, addr *common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	for _, a := range addresses {
+		if a == *addr {
+			return true
+		}
+	}
+	return false
+}
+
+// PrestateAccount is an account as returned by the prestateTracer.
+type PrestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   *uint64                     `json:"nonce,omitempty"`
+	Code    *hexutil.Bytes              `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// PrestateDiff is the result of the prestateTracer on diff mode.
+type PrestateDiff struct {
+	Pre  map[common.Address]*PrestateAccount `json:"pre"`
+	Post map[common.Address]*PrestateAccount `json:"post"`
+}
+
+// DiffValue is the change of a single value of the state. It is marshaled as
+// "=" if the value didn't change, {"+": value} if it was created, {"-": value}
+// if it was deleted and {"*": {"from": value, "to": value}} if it was modified.
+type DiffValue struct {
+	Kind string
+	From interface{}
+	To   interface{}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d DiffValue) MarshalJSON() ([]byte, error) {
+	switch d.Kind {
+	case "+":
+		return json.Marshal(map[string]interface{}{"+": d.To})
+	case "-":
+		return json.Marshal(map[string]interface{}{"-": d.From})
+	case "*":
+		return json.Marshal(map[string]interface{}{"*": map[string]interface{}{"from": d.From, "to": d.To}})
+	default:
+		return json.Marshal("=")
+	}
+}
+
+// AccountDiff is the change of the state of a single account.
+type AccountDiff struct {
+	Balance DiffValue                 `json:"balance"`
+	Code    DiffValue                 `json:"code"`
+	Nonce   DiffValue                 `json:"nonce"`
+	Storage map[common.Hash]DiffValue `json:"storage"`
+}
+
+// StateDiff is the change of the state produced by a transaction.
+type StateDiff map[common.Address]*AccountDiff
+
+// NewStateDiff converts the result of the prestateTracer on diff mode into a
+// state diff. Accounts only present on the post state were created and
+// accounts only present on the pre state were deleted.
+func NewStateDiff(diff PrestateDiff) StateDiff {
+	stateDiff := make(StateDiff, len(diff.Pre)+len(diff.Post))
+
+	for addr, pre := range diff.Pre {
+		post, ok := diff.Post[addr]
+		if !ok {
+			stateDiff[addr] = deletedAccountDiff(pre)
+			continue
+		}
+		stateDiff[addr] = modifiedAccountDiff(pre, post)
+	}
+	for addr, post := range diff.Post {
+		if _, ok := diff.Pre[addr]; !ok {
+			stateDiff[addr] = createdAccountDiff(post)
+		}
+	}
+
+	return stateDiff
+}
+
+func createdAccountDiff(post *PrestateAccount) *AccountDiff {
+	balance, nonce, code := post.values()
+	accountDiff := &AccountDiff{
+		Balance: DiffValue{Kind: "+", To: balance},
+		Code:    DiffValue{Kind: "+", To: code},
+		Nonce:   DiffValue{Kind: "+", To: nonce},
+		Storage: make(map[common.Hash]DiffValue, len(post.Storage)),
+	}
+	for key, value := range post.Storage {
+		accountDiff.Storage[key] = DiffValue{Kind: "+", To: value}
+	}
+	return accountDiff
+}
+
+func deletedAccountDiff(pre *PrestateAccount) *AccountDiff {
+	balance, nonce, code := pre.values()
+	accountDiff := &AccountDiff{
+		Balance: DiffValue{Kind: "-", From: balance},
+		Code:    DiffValue{Kind: "-", From: code},
+		Nonce:   DiffValue{Kind: "-", From: nonce},
+		Storage: make(map[common.Hash]DiffValue, len(pre.Storage)),
+	}
+	for key, value := range pre.Storage {
+		accountDiff.Storage[key] = DiffValue{Kind: "-", From: value}
+	}
+	return accountDiff
+}
+
+func modifiedAccountDiff(pre, post *PrestateAccount) *AccountDiff {
+	preBalance, preNonce, preCode := pre.values()
+	accountDiff := &AccountDiff{
+		Balance: DiffValue{Kind: "="},
+		Code:    DiffValue{Kind: "="},
+		Nonce:   DiffValue{Kind: "="},
+		Storage: make(map[common.Hash]DiffValue, len(pre.Storage)),
+	}
+	if post.Balance != nil {
+		accountDiff.Balance = DiffValue{Kind: "*", From: preBalance, To: post.Balance}
+	}
+	if post.Nonce != nil {
+		accountDiff.Nonce = DiffValue{Kind: "*", From: preNonce, To: hexutil.Uint64(*post.Nonce)}
+	}
+	if post.Code != nil {
+		accountDiff.Code = DiffValue{Kind: "*", From: preCode, To: post.Code}
+	}
+	// The post state omits the slots that were cleared
+	for key, value := range pre.Storage {
+		accountDiff.Storage[key] = DiffValue{Kind: "*", From: value, To: post.Storage[key]}
+	}
+	for key, value := range post.Storage {
+		if _, ok := pre.Storage[key]; !ok {
+			accountDiff.Storage[key] = DiffValue{Kind: "*", From: common.Hash{}, To: value}
+		}
+	}
+	return accountDiff
+}
+
+// values returns the balance, nonce and code of the account, defaulting to
+// zero values for the missing fields.
+func (a *PrestateAccount) values() (*hexutil.Big, hexutil.Uint64, hexutil.Bytes) {
+	balance := a.Balance
+	if balance == nil {
+		balance = (*hexutil.Big)(new(big.Int))
+	}
+	var nonce hexutil.Uint64
+	if a.Nonce != nil {
+		nonce = hexutil.Uint64(*a.Nonce)
+	}
+	code := hexutil.Bytes{}
+	if a.Code != nil {
+		code = *a.Code
+	}
+	return balance, nonce, code
+}
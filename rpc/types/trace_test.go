@@ -0,0 +1,149 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	sender := common.HexToAddress("0x1")
+	contract := common.HexToAddress("0x2")
+	created := common.HexToAddress("0x3")
+	beneficiary := common.HexToAddress("0x4")
+
+	frame := CallFrame{
+		Type:    "CALL",
+		From:    sender,
+		To:      &contract,
+		Value:   (*hexutil.Big)(big.NewInt(10)),
+		Gas:     100000,
+		GasUsed: 50000,
+		Input:   hexutil.Bytes{0x01},
+		Output:  hexutil.Bytes{0x02},
+		Calls: []CallFrame{
+			{
+				Type:    "CREATE2",
+				From:    contract,
+				To:      &created,
+				Gas:     40000,
+				GasUsed: 30000,
+				Input:   hexutil.Bytes{0x60},
+				Output:  hexutil.Bytes{0x00},
+				Calls: []CallFrame{
+					{Type: "SELFDESTRUCT", From: created, To: &beneficiary, Value: (*hexutil.Big)(big.NewInt(1))},
+				},
+			},
+			{
+				Type:  "STATICCALL",
+				From:  contract,
+				To:    &sender,
+				Error: "execution reverted",
+			},
+		},
+	}
+
+	traces := FlattenCallFrame(frame)
+	require.Len(t, traces, 4)
+
+	require.Equal(t, "call", traces[0].Type)
+	require.Equal(t, "call", traces[0].Action.CallType)
+	require.Equal(t, []int{}, traces[0].TraceAddress)
+	require.Equal(t, 2, traces[0].Subtraces)
+	require.Equal(t, hexutil.Uint64(50000), traces[0].Result.GasUsed)
+	require.Equal(t, hexutil.Bytes{0x02}, *traces[0].Result.Output)
+
+	require.Equal(t, "create", traces[1].Type)
+	require.Equal(t, "create2", traces[1].Action.CreationMethod)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, hexutil.Bytes{0x60}, *traces[1].Action.Init)
+	require.Equal(t, created, *traces[1].Result.Address)
+	require.Equal(t, big.NewInt(0), traces[1].Action.Value.ToInt())
+
+	require.Equal(t, "suicide", traces[2].Type)
+	require.Equal(t, []int{0, 0}, traces[2].TraceAddress)
+	require.Equal(t, created, *traces[2].Action.Address)
+	require.Equal(t, beneficiary, *traces[2].Action.RefundAddress)
+	require.Nil(t, traces[2].Result)
+
+	require.Equal(t, "staticcall", traces[3].Action.CallType)
+	require.Equal(t, []int{1}, traces[3].TraceAddress)
+	require.Equal(t, "execution reverted", traces[3].Error)
+	require.Nil(t, traces[3].Result)
+
+	require.True(t, traces[0].MatchAddresses(nil, nil))
+	require.True(t, traces[0].MatchAddresses([]common.Address{sender}, []common.Address{contract}))
+	require.False(t, traces[0].MatchAddresses([]common.Address{contract}, nil))
+	require.True(t, traces[1].MatchAddresses(nil, []common.Address{created}))
+	require.True(t, traces[2].MatchAddresses([]common.Address{created}, []common.Address{beneficiary}))
+}
+
+func TestNewStateDiff(t *testing.T) {
+	modified := common.HexToAddress("0x1")
+	created := common.HexToAddress("0x2")
+	deleted := common.HexToAddress("0x3")
+	slot := common.HexToHash("0x1")
+	clearedSlot := common.HexToHash("0x2")
+	nonce := uint64(1)
+	newNonce := uint64(2)
+	code := hexutil.Bytes{0x60}
+
+	diff := PrestateDiff{
+		Pre: map[common.Address]*PrestateAccount{
+			modified: {
+				Balance: (*hexutil.Big)(big.NewInt(100)),
+				Nonce:   &nonce,
+				Code:    &hexutil.Bytes{},
+				Storage: map[common.Hash]common.Hash{slot: common.HexToHash("0xa"), clearedSlot: common.HexToHash("0xb")},
+			},
+			deleted: {
+				Balance: (*hexutil.Big)(big.NewInt(5)),
+				Nonce:   &nonce,
+				Code:    &code,
+			},
+		},
+		Post: map[common.Address]*PrestateAccount{
+			modified: {
+				Nonce:   &newNonce,
+				Storage: map[common.Hash]common.Hash{slot: common.HexToHash("0xc")},
+			},
+			created: {
+				Balance: (*hexutil.Big)(big.NewInt(1)),
+				Code:    &code,
+			},
+		},
+	}
+
+	stateDiff := NewStateDiff(diff)
+	require.Len(t, stateDiff, 3)
+
+	bz, err := json.Marshal(stateDiff[modified])
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"balance": "=",
+		"code": "=",
+		"nonce": {"*": {"from": "0x1", "to": "0x2"}},
+		"storage": {
+			"0x0000000000000000000000000000000000000000000000000000000000000001": {"*": {
+				"from": "0x000000000000000000000000000000000000000000000000000000000000000a",
+				"to": "0x000000000000000000000000000000000000000000000000000000000000000c"
+			}},
+			"0x0000000000000000000000000000000000000000000000000000000000000002": {"*": {
+				"from": "0x000000000000000000000000000000000000000000000000000000000000000b",
+				"to": "0x0000000000000000000000000000000000000000000000000000000000000000"
+			}}
+		}
+	}`, string(bz))
+
+	bz, err = json.Marshal(stateDiff[created])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"balance": {"+": "0x1"}, "code": {"+": "0x60"}, "nonce": {"+": "0x0"}, "storage": {}}`, string(bz))
+
+	bz, err = json.Marshal(stateDiff[deleted])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"balance": {"-": "0x5"}, "code": {"-": "0x60"}, "nonce": {"-": "0x1"}, "storage": {}}`, string(bz))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -33,42 +34,77 @@ type StorageResult struct {
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
-	BlockHash        *common.Hash         `json:"blockHash"`
-	BlockNumber      *hexutil.Big         `json:"blockNumber"`
-	From             common.Address       `json:"from"`
-	Gas              hexutil.Uint64       `json:"gas"`
-	GasPrice         *hexutil.Big         `json:"gasPrice"`
-	GasFeeCap        *hexutil.Big         `json:"maxFeePerGas,omitempty"`
-	GasTipCap        *hexutil.Big         `json:"maxPriorityFeePerGas,omitempty"`
-	Hash             common.Hash          `json:"hash"`
-	Input            hexutil.Bytes        `json:"input"`
-	Nonce            hexutil.Uint64       `json:"nonce"`
-	To               *common.Address      `json:"to"`
-	TransactionIndex *hexutil.Uint64      `json:"transactionIndex"`
-	Value            *hexutil.Big         `json:"value"`
-	Type             hexutil.Uint64       `json:"type"`
-	Accesses         *ethtypes.AccessList `json:"accessList,omitempty"`
-	ChainID          *hexutil.Big         `json:"chainId,omitempty"`
-	V                *hexutil.Big         `json:"v"`
-	R                *hexutil.Big         `json:"r"`
-	S                *hexutil.Big         `json:"s"`
+	BlockHash         *common.Hash           `json:"blockHash"`
+	BlockNumber       *hexutil.Big           `json:"blockNumber"`
+	From              common.Address         `json:"from"`
+	Gas               hexutil.Uint64         `json:"gas"`
+	GasPrice          *hexutil.Big           `json:"gasPrice"`
+	GasFeeCap         *hexutil.Big           `json:"maxFeePerGas,omitempty"`
+	GasTipCap         *hexutil.Big           `json:"maxPriorityFeePerGas,omitempty"`
+	Hash              common.Hash            `json:"hash"`
+	Input             hexutil.Bytes          `json:"input"`
+	Nonce             hexutil.Uint64         `json:"nonce"`
+	To                *common.Address        `json:"to"`
+	TransactionIndex  *hexutil.Uint64        `json:"transactionIndex"`
+	Value             *hexutil.Big           `json:"value"`
+	Type              hexutil.Uint64         `json:"type"`
+	Accesses          *ethtypes.AccessList   `json:"accessList,omitempty"`
+	AuthorizationList []SetCodeAuthorization `json:"authorizationList,omitempty"`
+	ChainID           *hexutil.Big           `json:"chainId,omitempty"`
+	V                 *hexutil.Big           `json:"v"`
+	R                 *hexutil.Big           `json:"r"`
+	S                 *hexutil.Big           `json:"s"`
 }
 
+// SetCodeAuthorization represents an EIP-7702 authorization tuple that will
+// serialize to the RPC representation of an authorization.
+type SetCodeAuthorization = evmtypes.SetCodeAuthorizationArgs
+
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override when executing a
+// message call.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// TraceCallConfig is the config for debug_traceCall. It extends the TraceConfig
+// with the state and block overrides to apply before tracing the call.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts = evmtypes.SimOpts
+
+// SimBlockResult is the result of a block simulated with eth_simulateV1.
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+	Calls         []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a call simulated with eth_simulateV1.
+type SimCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	Logs       []*ethtypes.Log `json:"logs"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Status     hexutil.Uint64  `json:"status"`
+	Error      *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
 }
 
 type FeeHistoryResult struct {
@@ -89,3 +125,12 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// AddressTxsArgs are the arguments of evmos_getTransactionsByAddress. The
+// pages are numbered from 1.
+type AddressTxsArgs struct {
+	FromBlock *BlockNumber    `json:"fromBlock"`
+	ToBlock   *BlockNumber    `json:"toBlock"`
+	Page      *hexutil.Uint64 `json:"page"`
+	PageSize  *hexutil.Uint64 `json:"pageSize"`
+}
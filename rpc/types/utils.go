@@ -48,7 +48,7 @@ func RawTxToEthTx(clientCtx client.Context, txBz cmttypes.Tx) ([]*evmtypes.MsgEt
 		if !ok {
 			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgEthereumTx{})
 		}
-		ethTx.Hash = ethTx.AsTransaction().Hash().Hex()
+		ethTx.Hash = ethTx.TxHash().Hex()
 		ethTxs[i] = ethTx
 	}
 	return ethTxs, nil
@@ -160,10 +160,60 @@ func NewTransactionFromMsg(
 	baseFee *big.Int,
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		return NewRPCSetCodeTransaction(setCodeTx, blockHash, blockNumber, index, baseFee)
+	}
+
 	tx := msg.AsTransaction()
 	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
 }
 
+// NewRPCSetCodeTransaction returns an EIP-7702 set code transaction that will
+// serialize to the RPC representation, with the given location metadata set
+// (if available).
+func NewRPCSetCodeTransaction(
+	txData *evmtypes.SetCodeTx,
+	blockHash common.Hash,
+	blockNumber,
+	index uint64,
+	baseFee *big.Int,
+) (*RPCTransaction, error) {
+	// the execution fields are shared with the dynamic fee txs
+	result, err := NewRPCTransaction(ethtypes.NewTx(txData.AsEthereumData()), blockHash, blockNumber, index, baseFee, txData.GetChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	setCodeTx := txData.AsSetCodeTx()
+	from, _ := setCodeTx.Sender() // #nosec G703
+
+	result.Type = hexutil.Uint64(evmtypes.SetCodeTxType)
+	result.Hash = setCodeTx.Hash()
+	result.From = from
+	result.AuthorizationList = make([]SetCodeAuthorization, len(setCodeTx.AuthList))
+	for i, auth := range setCodeTx.AuthList {
+		result.AuthorizationList[i] = SetCodeAuthorization{
+			ChainID: hexutil.Big(*auth.ChainID),
+			Address: auth.Address,
+			Nonce:   hexutil.Uint64(auth.Nonce),
+			YParity: hexutil.Uint64(auth.V),
+		}
+		if auth.R != nil {
+			result.AuthorizationList[i].R = hexutil.Big(*auth.R)
+		}
+		if auth.S != nil {
+			result.AuthorizationList[i].S = hexutil.Big(*auth.S)
+		}
+	}
+
+	return result, nil
+}
+
 // NewTransactionFromData returns a transaction that will serialize to the RPC
 // representation, with the given location metadata set (if available).
 func NewRPCTransaction(
@@ -106,6 +106,21 @@ const (
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
+	// IndexerBackendKV is the custom indexer backend storing the txs on a KV db
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendSQL is the custom indexer backend storing the txs on normalized SQL tables
+	IndexerBackendSQL = "sql"
+
+	// DefaultIndexerBackend is the default backend of the custom indexer
+	DefaultIndexerBackend = IndexerBackendKV
+
+	// DefaultIndexerSQLDriver is the default database/sql driver of the SQL indexer backend
+	DefaultIndexerSQLDriver = "sqlite3"
+
+	// IndexerSQLDriverPostgres is the database/sql driver of the SQL indexer backend for PostgreSQL
+	IndexerSQLDriverPostgres = "postgres"
+
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
@@ -216,6 +231,15 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableAddressIndex defines if the custom indexer also indexes the txs by address.
+	EnableAddressIndex bool `mapstructure:"enable-address-index"`
+	// IndexerBackend defines the backend of the custom indexer, either "kv" or "sql".
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerSQLDriver defines the database/sql driver used by the SQL indexer backend.
+	IndexerSQLDriver string `mapstructure:"indexer-sql-driver"`
+	// IndexerSQLDSN defines the data source name of the SQL indexer backend. It defaults
+	// to a SQLite database in the node data directory.
+	IndexerSQLDSN string `mapstructure:"indexer-sql-dsn"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -323,7 +347,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "evmos"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -346,6 +370,10 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		EnableAddressIndex:       false,
+		IndexerBackend:           DefaultIndexerBackend,
+		IndexerSQLDriver:         DefaultIndexerSQLDriver,
+		IndexerSQLDSN:            "",
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
@@ -389,6 +417,17 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.IndexerBackend != IndexerBackendKV && c.IndexerBackend != IndexerBackendSQL {
+		return fmt.Errorf("invalid JSON-RPC indexer backend '%s', expected '%s' or '%s'", c.IndexerBackend, IndexerBackendKV, IndexerBackendSQL)
+	}
+
+	if c.IndexerBackend == IndexerBackendSQL && c.IndexerSQLDriver != DefaultIndexerSQLDriver && c.IndexerSQLDriver != IndexerSQLDriverPostgres {
+		return fmt.Errorf(
+			"unsupported JSON-RPC SQL indexer driver '%s', expected '%s' or '%s'",
+			c.IndexerSQLDriver, DefaultIndexerSQLDriver, IndexerSQLDriverPostgres,
+		)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
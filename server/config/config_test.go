@@ -54,6 +54,21 @@ func TestGetConfig(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"test unmarshal JSONRPC indexer backend",
+			func() *viper.Viper {
+				v := viper.New()
+				v.Set("json-rpc.indexer-backend", IndexerBackendSQL)
+				return v
+			},
+			func() Config {
+				cfg := DefaultConfig()
+				require.Equal(t, IndexerBackendKV, cfg.JSONRPC.IndexerBackend)
+				cfg.JSONRPC.IndexerBackend = IndexerBackendSQL
+				return *cfg
+			},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
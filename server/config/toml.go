@@ -78,6 +78,23 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableAddressIndex enables the indexing of the EVM transactions by sender, recipient and created
+# contract address on the custom indexer, required by the evmos_getTransactionsByAddress method.
+enable-address-index = {{ .JSONRPC.EnableAddressIndex }}
+
+# IndexerBackend defines the backend of the custom transaction indexer: "kv" stores the transactions
+# on a key-value database and "sql" on normalized tables (blocks, transactions, logs, token_transfers)
+# of a SQL database. The SQL backend always indexes the transactions by address.
+indexer-backend = "{{ .JSONRPC.IndexerBackend }}"
+
+# IndexerSQLDriver defines the database/sql driver used by the SQL indexer backend, either "sqlite3"
+# or "postgres".
+indexer-sql-driver = "{{ .JSONRPC.IndexerSQLDriver }}"
+
+# IndexerSQLDSN defines the data source name of the SQL indexer backend. It defaults to a SQLite
+# database in the node data directory.
+indexer-sql-dsn = "{{ .JSONRPC.IndexerSQLDSN }}"
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
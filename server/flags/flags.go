@@ -53,6 +53,10 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCEnableAddressIndex  = "json-rpc.enable-address-index"
+	JSONRPCIndexerBackend      = "json-rpc.indexer-backend"
+	JSONRPCIndexerSQLDriver    = "json-rpc.indexer-sql-driver"
+	JSONRPCIndexerSQLDSN       = "json-rpc.indexer-sql-dsn"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -7,12 +7,22 @@ import (
 
 	"github.com/spf13/cobra"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtconfig "github.com/cometbft/cometbft/config"
 	sm "github.com/cometbft/cometbft/state"
 	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/evmos/evmos/v20/indexer"
+	"github.com/evmos/evmos/v20/server/config"
+	evmostypes "github.com/evmos/evmos/v20/types"
+)
+
+const (
+	flagFrom       = "from"
+	flagTo         = "to"
+	flagKeepRecent = "keep-recent"
 )
 
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
@@ -26,60 +36,22 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		Use the reindex, prune and verify subcommands to maintain the indexed blocks.
+		All of them use the indexer backend set on the app config (json-rpc.indexer-backend).
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			serverCtx := server.GetServerContextFromCmd(cmd)
-			clientCtx, err := client.GetClientQueryContext(cmd)
-			if err != nil {
-				return err
-			}
-
 			direction := args[0]
 			if direction != "backward" && direction != "forward" {
 				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
 
-			cfg := serverCtx.Config
-			home := cfg.RootDir
-			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
-			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
-				return err
-			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
-
-			// open local tendermint db, because the local rpc won't be available.
-			cmtdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
-			if err != nil {
-				return err
-			}
-			blockStore := cmtstore.NewBlockStore(cmtdb)
-
-			stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+			stores, err := openIndexerStores(cmd)
 			if err != nil {
 				return err
 			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
-
-			indexBlock := func(height int64) error {
-				blk := blockStore.LoadBlock(height)
-				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
-				}
-				resBlk, err := stateStore.LoadFinalizeBlockResponse(height)
-				if err != nil {
-					return err
-				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
-					return err
-				}
-				fmt.Println(height)
-				return nil
-			}
+			idxer, blockStore := stores.idxer, stores.blockStore
 
 			switch args[0] {
 			case "backward":
@@ -92,7 +64,7 @@ func NewIndexTxCmd() *cobra.Command {
 					first = blockStore.Height()
 				}
 				for i := first - 1; i > 0; i-- {
-					if err := indexBlock(i); err != nil {
+					if err := stores.indexBlock(i); err != nil {
 						return err
 					}
 				}
@@ -106,7 +78,7 @@ func NewIndexTxCmd() *cobra.Command {
 					latest = 0
 				}
 				for i := latest + 1; i <= blockStore.Height(); i++ {
-					if err := indexBlock(i); err != nil {
+					if err := stores.indexBlock(i); err != nil {
 						return err
 					}
 				}
@@ -117,5 +89,248 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.AddCommand(
+		newReindexTxCmd(),
+		newPruneTxCmd(),
+		newVerifyTxCmd(),
+	)
+	return cmd
+}
+
+// newReindexTxCmd creates a new Cobra command to rebuild the indexer entries of a range of blocks.
+func newReindexTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reindex",
+		Short: "Rebuild the indexed eth txs of a range of blocks",
+		Long:  "Delete the indexer entries of the blocks within the [from, to] range and index them again from the local CometBFT block results.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			from, _ := cmd.Flags().GetInt64(flagFrom)
+			to, _ := cmd.Flags().GetInt64(flagTo)
+
+			stores, err := openIndexerStores(cmd)
+			if err != nil {
+				return err
+			}
+			if from <= 0 {
+				from = stores.blockStore.Base()
+			}
+			if to <= 0 {
+				to = stores.blockStore.Height()
+			}
+			if from < 1 || from > to {
+				return fmt.Errorf("invalid block range: [%d, %d]", from, to)
+			}
+			if base := stores.blockStore.Base(); from < base {
+				return fmt.Errorf("from block %d is lower than the first block available in the block store %d", from, base)
+			}
+
+			if err := stores.idxer.DeleteBlocks(from, to); err != nil {
+				return err
+			}
+			for i := from; i <= to; i++ {
+				if err := stores.indexBlock(i); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "The first block of the range to reindex (default: the first block available in the block store)")
+	cmd.Flags().Int64(flagTo, 0, "The last block of the range to reindex (default: the latest block)")
 	return cmd
 }
+
+// newPruneTxCmd creates a new Cobra command to drop the indexer entries of the old blocks.
+func newPruneTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the indexed eth txs of the old blocks",
+		Long:  "Delete the indexer entries of all the blocks except the latest keep-recent indexed blocks.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keepRecent, _ := cmd.Flags().GetUint64(flagKeepRecent)
+			if keepRecent == 0 {
+				return fmt.Errorf("--%s must be greater than 0", flagKeepRecent)
+			}
+
+			stores, err := openIndexerStores(cmd)
+			if err != nil {
+				return err
+			}
+			latest, err := stores.idxer.LastIndexedBlock()
+			if err != nil {
+				return err
+			}
+
+			pruneHeight := latest - int64(keepRecent) //nolint:gosec // G115
+			if latest == -1 || pruneHeight < 1 {
+				cmd.Println("nothing to prune")
+				return nil
+			}
+			if err := stores.idxer.DeleteBlocks(1, pruneHeight); err != nil {
+				return err
+			}
+			cmd.Printf("pruned the indexed blocks up to %d\n", pruneHeight)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagKeepRecent, 0, "The number of latest indexed blocks to keep")
+	return cmd
+}
+
+// newVerifyTxCmd creates a new Cobra command to check the indexer entries against the CometBFT block results.
+func newVerifyTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the indexed eth txs of a range of blocks",
+		Long:  "Compare the indexer entries of the blocks within the [from, to] range with the local CometBFT block results and report the mismatches, the range defaults to all the indexed blocks.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			from, _ := cmd.Flags().GetInt64(flagFrom)
+			to, _ := cmd.Flags().GetInt64(flagTo)
+
+			stores, err := openIndexerStores(cmd)
+			if err != nil {
+				return err
+			}
+			if from <= 0 {
+				if from, err = stores.idxer.FirstIndexedBlock(); err != nil {
+					return err
+				}
+			}
+			if to <= 0 {
+				if to, err = stores.idxer.LastIndexedBlock(); err != nil {
+					return err
+				}
+			}
+			if from < 1 || from > to {
+				return fmt.Errorf("invalid block range: [%d, %d]", from, to)
+			}
+
+			mismatches := 0
+			for i := from; i <= to; i++ {
+				blk, txResults, err := stores.loadBlock(i)
+				if err != nil {
+					return err
+				}
+				if err := stores.idxer.VerifyBlock(blk, txResults); err != nil {
+					mismatches++
+					cmd.Printf("block %d: %s\n", i, err)
+				}
+				if (i-from+1)%1000 == 0 {
+					cmd.Printf("verified blocks %d-%d\n", from, i)
+				}
+			}
+
+			if mismatches > 0 {
+				return fmt.Errorf("found %d blocks with mismatches in [%d, %d]", mismatches, from, to)
+			}
+			cmd.Printf("verified blocks %d-%d, no mismatches found\n", from, to)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "The first block of the range to verify (default: the first indexed block)")
+	cmd.Flags().Int64(flagTo, 0, "The last block of the range to verify (default: the latest indexed block)")
+	return cmd
+}
+
+// maintainedIndexer is a custom indexer backend that can be maintained by the
+// indexer commands.
+type maintainedIndexer interface {
+	evmostypes.EVMTxIndexer
+
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
+	DeleteBlocks(from, to int64) error
+	VerifyBlock(*cmttypes.Block, []*abci.ExecTxResult) error
+}
+
+var (
+	_ maintainedIndexer = &indexer.KVIndexer{}
+	_ maintainedIndexer = &indexer.SQLIndexer{}
+)
+
+// indexerStores holds the indexer and the local CometBFT stores used by the
+// indexer commands, the local rpc isn't available while they run.
+type indexerStores struct {
+	idxer      maintainedIndexer
+	blockStore *cmtstore.BlockStore
+	stateStore sm.Store
+}
+
+// openIndexerStores opens the indexer with the backend set on the config and
+// the local CometBFT block and state stores.
+func openIndexerStores(cmd *cobra.Command) (*indexerStores, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	appConfig, err := config.GetConfig(serverCtx.Viper)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := serverCtx.Config
+	home := cfg.RootDir
+	logger := serverCtx.Logger
+	idxer, err := openEVMIndexer(home, serverCtx, appConfig.JSONRPC, logger.With("module", "evmindex"), clientCtx)
+	if err != nil {
+		logger.Error("failed to open evm indexer", "backend", appConfig.JSONRPC.IndexerBackend, "error", err.Error())
+		return nil, err
+	}
+
+	// open local tendermint db, because the local rpc won't be available.
+	cmtdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	blockStore := cmtstore.NewBlockStore(cmtdb)
+
+	stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+
+	return &indexerStores{
+		idxer:      idxer,
+		blockStore: blockStore,
+		stateStore: stateStore,
+	}, nil
+}
+
+// loadBlock loads the block of the given height and its results from the
+// local CometBFT stores.
+func (s *indexerStores) loadBlock(height int64) (*cmttypes.Block, []*abci.ExecTxResult, error) {
+	blk := s.blockStore.LoadBlock(height)
+	if blk == nil {
+		return nil, nil, fmt.Errorf("block not found %d", height)
+	}
+	resBlk, err := s.stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blk, resBlk.TxResults, nil
+}
+
+// indexBlock indexes the block of the given height, printing its height.
+func (s *indexerStores) indexBlock(height int64) error {
+	blk, txResults, err := s.loadBlock(height)
+	if err != nil {
+		return err
+	}
+	if err := s.idxer.IndexBlock(blk, txResults); err != nil {
+		return err
+	}
+	fmt.Println(height)
+	return nil
+}
@@ -4,6 +4,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net"
@@ -38,6 +39,7 @@ import (
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -202,6 +204,10 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAddressIndex, false, "Enable the indexing of the txs by address on the custom tx indexer")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, config.DefaultIndexerBackend, "Sets the backend of the custom tx indexer (kv|sql)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerSQLDriver, config.DefaultIndexerSQLDriver, "Sets the database/sql driver of the sql indexer backend")
+	cmd.Flags().String(srvflags.JSONRPCIndexerSQLDSN, "", "Sets the data source name of the sql indexer backend (default: a SQLite db in the node data directory)")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -424,14 +430,12 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer evmostypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		idxer, err = openEVMIndexer(home, svrCtx, config.JSONRPC, idxLogger, clientCtx)
 		if err != nil {
 			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -503,6 +507,46 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenSQLIndexerDB opens the database of the SQL indexer backend with the
+// given database/sql driver. The SQLite database in the node data directory
+// is used if the data source name is empty.
+func OpenSQLIndexerDB(rootDir, driver, dsn string) (*sql.DB, error) {
+	if dsn == "" {
+		if driver != config.DefaultIndexerSQLDriver {
+			return nil, fmt.Errorf("the data source name is required for the %s driver", driver)
+		}
+		dataDir := filepath.Join(rootDir, "data")
+		if err := os.MkdirAll(dataDir, 0o755); err != nil {
+			return nil, err
+		}
+		dsn = fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000", filepath.Join(dataDir, "evmindexer.sqlite"))
+	}
+	return sql.Open(driver, dsn)
+}
+
+// openEVMIndexer opens the custom indexer with the backend set on the config.
+func openEVMIndexer(
+	home string,
+	svrCtx *server.Context,
+	cfg config.JSONRPCConfig,
+	logger log.Logger,
+	clientCtx client.Context,
+) (maintainedIndexer, error) {
+	if cfg.IndexerBackend == config.IndexerBackendSQL {
+		sqlDB, err := OpenSQLIndexerDB(home, cfg.IndexerSQLDriver, cfg.IndexerSQLDSN)
+		if err != nil {
+			return nil, err
+		}
+		return indexer.NewSQLIndexer(sqlDB, cfg.IndexerSQLDriver, logger, clientCtx)
+	}
+
+	idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+	if err != nil {
+		return nil, err
+	}
+	return indexer.NewKVIndexer(idxDB, logger, clientCtx).WithAddressIndex(cfg.EnableAddressIndex), nil
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
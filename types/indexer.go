@@ -6,6 +6,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
@@ -18,4 +19,16 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// GetBlockBloom returns an error if the block logs are not indexed.
+	GetBlockBloom(int64) (ethtypes.Bloom, error)
+	// GetLogsByBlock returns an error if the block logs are not indexed.
+	GetLogsByBlock(int64) ([][]*ethtypes.Log, error)
+	// GetBloomBits returns an error if the bloom bits section is not indexed.
+	GetBloomBits(section uint64, bit uint) ([]byte, error)
+	// BloomStatus returns the section size and the number of indexed sections.
+	BloomStatus() (uint64, uint64)
+
+	// GetTxHashesByAddress returns an error if the address index is disabled.
+	GetTxHashesByAddress(address common.Address, fromBlock, toBlock int64, offset, limit uint64) ([]common.Hash, error)
 }
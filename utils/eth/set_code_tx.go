@@ -0,0 +1,178 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package eth
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// SetCodeTxType is the EIP-2718 type of the EIP-7702 set code transactions.
+	SetCodeTxType = 0x04
+	// setCodeAuthorizationMagic is the prefix of the hash signed by an
+	// authorization tuple.
+	setCodeAuthorizationMagic = 0x05
+)
+
+// ErrInvalidSig is returned when the signature values of a set code
+// transaction or authorization are invalid.
+var ErrInvalidSig = errors.New("invalid set code signature values")
+
+// SetCodeTx is the consensus representation of an EIP-7702 set code
+// transaction. It is used to compute the transaction hashes, recover the
+// sender and encode the transaction, since the go-ethereum version used by
+// Evmos does not support this transaction type.
+//
+// Ref: https://eips.ethereum.org/EIPS/eip-7702
+type SetCodeTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []SetCodeAuthorization
+	// signature values
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// SetCodeAuthorization is an authorization tuple of an EIP-7702 set code
+// transaction. It is signed by the authority to delegate the execution of
+// its code to the given address.
+type SetCodeAuthorization struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	// signature values
+	V uint8
+	R *big.Int
+	S *big.Int
+}
+
+// Hash returns the hash of the signed transaction, which identifies it.
+func (tx *SetCodeTx) Hash() common.Hash {
+	return prefixedRlpHash(SetCodeTxType, tx)
+}
+
+// SigHash returns the hash to be signed by the sender of the transaction.
+func (tx *SetCodeTx) SigHash() common.Hash {
+	return prefixedRlpHash(SetCodeTxType, []interface{}{
+		tx.ChainID,
+		tx.Nonce,
+		tx.GasTipCap,
+		tx.GasFeeCap,
+		tx.Gas,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		tx.AccessList,
+		tx.AuthList,
+	})
+}
+
+// Sender recovers the address of the account that signed the transaction.
+func (tx *SetCodeTx) Sender() (common.Address, error) {
+	if tx.V == nil || !tx.V.IsUint64() || tx.V.Uint64() > 1 {
+		return common.Address{}, ErrInvalidSig
+	}
+	return recoverAddress(tx.SigHash(), byte(tx.V.Uint64()), tx.R, tx.S)
+}
+
+// MarshalBinary returns the canonical encoding of the transaction, given by
+// the transaction type followed by the RLP encoded payload.
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(SetCodeTxType)
+	if err := rlp.Encode(&buf, tx); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes the canonical encoding of a set code transaction.
+func (tx *SetCodeTx) UnmarshalBinary(b []byte) error {
+	if len(b) == 0 || b[0] != SetCodeTxType {
+		return fmt.Errorf("invalid set code transaction type prefix")
+	}
+	return rlp.DecodeBytes(b[1:], tx)
+}
+
+// SigHash returns the hash to be signed by the authority of the authorization.
+func (a SetCodeAuthorization) SigHash() common.Hash {
+	return prefixedRlpHash(setCodeAuthorizationMagic, []interface{}{
+		a.ChainID,
+		a.Address,
+		a.Nonce,
+	})
+}
+
+// Authority recovers the address of the account that signed the
+// authorization.
+func (a SetCodeAuthorization) Authority() (common.Address, error) {
+	return recoverAddress(a.SigHash(), a.V, a.R, a.S)
+}
+
+// SignSetCode signs the authorization with the given private key.
+func SignSetCode(prv *ecdsa.PrivateKey, auth SetCodeAuthorization) (SetCodeAuthorization, error) {
+	sighash := auth.SigHash()
+	sig, err := crypto.Sign(sighash[:], prv)
+	if err != nil {
+		return SetCodeAuthorization{}, err
+	}
+
+	auth.R = new(big.Int).SetBytes(sig[:32])
+	auth.S = new(big.Int).SetBytes(sig[32:64])
+	auth.V = sig[64]
+	return auth, nil
+}
+
+// recoverAddress returns the address that signed the given hash, rejecting
+// signatures with malleable s values.
+func recoverAddress(sighash common.Hash, v byte, r, s *big.Int) (common.Address, error) {
+	if r == nil || s == nil || !crypto.ValidateSignatureValues(v, r, s, true) {
+		return common.Address{}, ErrInvalidSig
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = v
+
+	pub, err := crypto.Ecrecover(sighash[:], sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(pub) == 0 || pub[0] != 4 {
+		return common.Address{}, errors.New("invalid public key")
+	}
+
+	var addr common.Address
+	copy(addr[:], crypto.Keccak256(pub[1:])[12:])
+	return addr, nil
+}
+
+// prefixedRlpHash returns the keccak256 hash of the RLP encoding of x,
+// prefixed by the given byte.
+func prefixedRlpHash(prefix byte, x interface{}) (h common.Hash) {
+	sha := sha3.NewLegacyKeccak256()
+	sha.Write([]byte{prefix})
+	_ = rlp.Encode(sha, x)
+	sha.Sum(h[:0])
+	return h
+}
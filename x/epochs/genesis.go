@@ -15,6 +15,10 @@ import (
 // InitGenesis initializes the epochs module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
 	// set epoch info from genesis
 	for _, epoch := range genState.Epochs {
 		// Initialize empty epoch values via Cosmos SDK
@@ -32,5 +36,6 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Epochs: k.AllEpochInfos(ctx),
+		Params: k.GetParams(ctx),
 	}
 }
@@ -89,6 +89,7 @@ func TestEpochsInitGenesis(t *testing.T) {
 				EpochCountingStarted:    true,
 			},
 		},
+		Params: types.DefaultParams(),
 	}
 	require.EqualError(t, genesisState.Validate(), "duplicated epoch entry monthly")
 
@@ -104,6 +105,7 @@ func TestEpochsInitGenesis(t *testing.T) {
 				EpochCountingStarted:    true,
 			},
 		},
+		Params: types.DefaultParams(),
 	}
 
 	epochs.InitGenesis(ctx, app.EpochsKeeper, genesisState)
@@ -69,3 +69,13 @@ func (k Keeper) CurrentEpoch(
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// Params returns the params of the epochs module
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
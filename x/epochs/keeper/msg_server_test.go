@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	hookContracts := []string{"0x29fDcB7b64B84fD54D0fB0E04A8f6B062046fc6F"}
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateParams{Authority: "foobar"},
+			expectErr: true,
+		},
+		{
+			name: "fail - invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(hookContracts, 0),
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - valid Update msg",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(hookContracts, types.DefaultHookGasLimit),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := SetupTest([]types.EpochInfo{})
+			ctx := s.network.GetContext()
+
+			_, err := s.network.App.EpochsKeeper.UpdateParams(ctx, tc.request)
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Empty(t, s.network.App.EpochsKeeper.GetParams(ctx).HookContracts)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.request.Params, s.network.App.EpochsKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
@@ -9,8 +9,11 @@ import (
 )
 
 // NewGenesisState creates a new genesis state instance
-func NewGenesisState(epochs []EpochInfo) *GenesisState {
-	return &GenesisState{Epochs: epochs}
+func NewGenesisState(epochs []EpochInfo, params Params) *GenesisState {
+	return &GenesisState{
+		Epochs: epochs,
+		Params: params,
+	}
 }
 
 // DefaultGenesisState returns the default epochs genesis state
@@ -35,12 +38,16 @@ func DefaultGenesisState() *GenesisState {
 			EpochCountingStarted:    false,
 		},
 	}
-	return NewGenesisState(epochs)
+	return NewGenesisState(epochs, DefaultParams())
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	epochIdentifiers := make(map[string]bool)
 
 	for _, epoch := range gs.Epochs {
@@ -5,23 +5,26 @@ package types
 
 import (
 	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-var _ = time.Kitchen
+var (
+	_ = proto.Marshal
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -54,9 +57,11 @@ func (*EpochInfo) ProtoMessage()    {}
 func (*EpochInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c74bc0b3e7fa01c2, []int{0}
 }
+
 func (m *EpochInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
+
 func (m *EpochInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_EpochInfo.Marshal(b, m, deterministic)
@@ -69,12 +74,15 @@ func (m *EpochInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
+
 func (m *EpochInfo) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_EpochInfo.Merge(m, src)
 }
+
 func (m *EpochInfo) XXX_Size() int {
 	return m.Size()
 }
+
 func (m *EpochInfo) XXX_DiscardUnknown() {
 	xxx_messageInfo_EpochInfo.DiscardUnknown(m)
 }
@@ -134,6 +142,8 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 type GenesisState struct {
 	// epochs is a slice of EpochInfo that defines the epochs in the genesis state
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -142,9 +152,11 @@ func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c74bc0b3e7fa01c2, []int{1}
 }
+
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
+
 func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
@@ -157,12 +169,15 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
+
 func (m *GenesisState) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_GenesisState.Merge(m, src)
 }
+
 func (m *GenesisState) XXX_Size() int {
 	return m.Size()
 }
+
 func (m *GenesisState) XXX_DiscardUnknown() {
 	xxx_messageInfo_GenesisState.DiscardUnknown(m)
 }
@@ -176,46 +191,120 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Params defines the epochs module parameters
+type Params struct {
+	// hook_contracts defines the hex addresses of the contracts that are called
+	// with onEpochEnd at the end of every epoch
+	HookContracts []string `protobuf:"bytes,1,rep,name=hook_contracts,json=hookContracts,proto3" json:"hook_contracts,omitempty"`
+	// hook_gas_limit defines the maximum amount of gas that each hook contract
+	// can consume on every epoch end
+	HookGasLimit uint64 `protobuf:"varint,2,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c74bc0b3e7fa01c2, []int{2}
+}
+
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetHookContracts() []string {
+	if m != nil {
+		return m.HookContracts
+	}
+	return nil
+}
+
+func (m *Params) GetHookGasLimit() uint64 {
+	if m != nil {
+		return m.HookGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EpochInfo)(nil), "evmos.epochs.v1.EpochInfo")
 	proto.RegisterType((*GenesisState)(nil), "evmos.epochs.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "evmos.epochs.v1.Params")
 }
 
 func init() { proto.RegisterFile("evmos/epochs/v1/genesis.proto", fileDescriptor_c74bc0b3e7fa01c2) }
 
 var fileDescriptor_c74bc0b3e7fa01c2 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0x8e, 0x49, 0x09, 0x8d, 0x29, 0xaa, 0x6a, 0x15, 0x38, 0x22, 0x71, 0x77, 0x3a, 0x96, 0x80,
-	0xd0, 0xb9, 0x2d, 0x88, 0x01, 0xc4, 0x92, 0x82, 0x80, 0x81, 0x25, 0x61, 0x42, 0x42, 0xd1, 0x25,
-	0x71, 0x1c, 0x4b, 0xb5, 0x7d, 0xba, 0x7b, 0x17, 0x91, 0x1f, 0xc0, 0xde, 0x11, 0xfe, 0x01, 0x23,
-	0x3f, 0xa3, 0x63, 0x47, 0xa6, 0x80, 0x92, 0x01, 0x89, 0xb1, 0xbf, 0x00, 0x9d, 0xed, 0x0b, 0xa1,
-	0x05, 0xb1, 0x9c, 0x7c, 0xef, 0x7b, 0xdf, 0xf7, 0xf9, 0x7d, 0x7a, 0xc6, 0xb7, 0xd9, 0x54, 0xea,
-	0x9c, 0xb2, 0x54, 0x0f, 0x27, 0x39, 0x9d, 0xee, 0x53, 0xce, 0x14, 0xcb, 0x45, 0x1e, 0xa7, 0x99,
-	0x06, 0x4d, 0xb6, 0x0d, 0x1c, 0x5b, 0x38, 0x9e, 0xee, 0xb7, 0x76, 0x12, 0x29, 0x94, 0xa6, 0xe6,
-	0x6b, 0x7b, 0x5a, 0xbb, 0x5c, 0x73, 0x6d, 0x8e, 0xb4, 0x3c, 0xb9, 0xaa, 0xcf, 0xb5, 0xe6, 0x47,
-	0x8c, 0x9a, 0xbf, 0x41, 0x31, 0xa6, 0xa3, 0x22, 0x4b, 0x40, 0x68, 0xe5, 0xf0, 0xe0, 0x3c, 0x0e,
-	0x42, 0xb2, 0x1c, 0x12, 0x99, 0xda, 0x86, 0xe8, 0xd3, 0x06, 0x6e, 0x3e, 0x2f, 0x7d, 0x5f, 0xa9,
-	0xb1, 0x26, 0x3e, 0xc6, 0x62, 0xc4, 0x14, 0x88, 0xb1, 0x60, 0x99, 0x87, 0x42, 0xd4, 0x6e, 0x76,
-	0xd7, 0x2a, 0xe4, 0x1d, 0xc6, 0x39, 0x24, 0x19, 0xf4, 0x4b, 0x19, 0xef, 0x52, 0x88, 0xda, 0x57,
-	0x0f, 0x5a, 0xb1, 0xf5, 0x88, 0x2b, 0x8f, 0xf8, 0x4d, 0xe5, 0xd1, 0x89, 0x4e, 0xe6, 0x41, 0xed,
-	0x6c, 0x1e, 0xec, 0xcc, 0x12, 0x79, 0xf4, 0x38, 0xfa, 0xcd, 0x8d, 0x8e, 0xbf, 0x05, 0xe8, 0xf3,
-	0x8f, 0x2f, 0xf7, 0x50, 0xb7, 0x69, 0xaa, 0x25, 0x87, 0x48, 0xbc, 0x59, 0xdd, 0xdf, 0xab, 0x1b,
-	0xf1, 0x5b, 0x17, 0xc4, 0x9f, 0xb9, 0x86, 0xce, 0xa3, 0x52, 0xfb, 0xe7, 0x3c, 0x20, 0x15, 0xe5,
-	0xbe, 0x96, 0x02, 0x98, 0x4c, 0x61, 0x76, 0x36, 0x0f, 0xb6, 0xad, 0x63, 0x85, 0x45, 0x1f, 0x57,
-	0x7e, 0x2b, 0x0b, 0x72, 0x07, 0x5f, 0x1b, 0x16, 0x59, 0xc6, 0x14, 0xf4, 0x4d, 0xf4, 0xde, 0x46,
-	0x88, 0xda, 0xf5, 0xee, 0x96, 0x2b, 0x9a, 0x58, 0xc8, 0x07, 0x84, 0xbd, 0x3f, 0xba, 0xfa, 0x6b,
-	0x09, 0x5c, 0xfe, 0x6f, 0x02, 0x7b, 0x2e, 0x81, 0xc0, 0xde, 0xe7, 0x5f, 0x4a, 0x6b, 0x79, 0x5c,
-	0x5f, 0xb7, 0xef, 0xad, 0xb2, 0x79, 0x88, 0x6f, 0x58, 0xd2, 0x50, 0x17, 0x0a, 0x84, 0xe2, 0x96,
-	0xcd, 0x46, 0x5e, 0x23, 0x44, 0xed, 0xcd, 0xee, 0xae, 0x41, 0x0f, 0x1d, 0xd8, 0xb3, 0x18, 0x79,
-	0x82, 0x5b, 0x7f, 0xb3, 0x9c, 0x30, 0xc1, 0x27, 0xe0, 0x5d, 0x31, 0xf3, 0xde, 0xbc, 0x60, 0xf8,
-	0xd2, 0xc0, 0xd1, 0x6b, 0xbc, 0xf5, 0xc2, 0xee, 0x69, 0x0f, 0x12, 0x60, 0xe4, 0x29, 0x6e, 0xd8,
-	0x15, 0xf5, 0x50, 0x58, 0x37, 0x73, 0x9f, 0xdb, 0xdb, 0x78, 0xb5, 0x49, 0x9d, 0x66, 0x39, 0xb7,
-	0x1d, 0xc8, 0x91, 0x3a, 0x87, 0x27, 0x0b, 0x1f, 0x9d, 0x2e, 0x7c, 0xf4, 0x7d, 0xe1, 0xa3, 0xe3,
-	0xa5, 0x5f, 0x3b, 0x5d, 0xfa, 0xb5, 0xaf, 0x4b, 0xbf, 0xf6, 0xf6, 0x2e, 0x17, 0x30, 0x29, 0x06,
-	0xf1, 0x50, 0x4b, 0xea, 0x5e, 0x8a, 0xf9, 0x4e, 0x0f, 0xf6, 0xe8, 0xfb, 0xea, 0xd5, 0xc0, 0x2c,
-	0x65, 0xf9, 0xa0, 0x61, 0x32, 0x7e, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xf1, 0x62, 0x17,
-	0x52, 0x03, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0x59, 0x29, 0xab, 0xe9, 0x98, 0x66, 0x0d, 0x16, 0x2a, 0x91, 0x54, 0x01, 0xa4, 0x82,
+	0x50, 0xb2, 0x0d, 0xc4, 0x61, 0x88, 0x4b, 0x0b, 0x1a, 0x48, 0x1c, 0x50, 0x0a, 0x17, 0x24, 0x54,
+	0xb9, 0xa9, 0x9b, 0x5a, 0xd4, 0x71, 0x14, 0x3b, 0x15, 0xfd, 0x01, 0x1c, 0xb8, 0xed, 0x08, 0xff,
+	0x80, 0x23, 0x3f, 0x63, 0xc7, 0x1d, 0x39, 0x15, 0xd4, 0x1e, 0x90, 0x38, 0xee, 0x17, 0xa0, 0xd8,
+	0x4e, 0xe9, 0x56, 0x10, 0x97, 0xc8, 0x7d, 0xef, 0x7b, 0xdf, 0xf3, 0xf7, 0xfa, 0x19, 0xde, 0x20,
+	0x63, 0xc6, 0x85, 0x4f, 0x12, 0x1e, 0x0e, 0x85, 0x3f, 0xde, 0xf3, 0x23, 0x12, 0x13, 0x41, 0x85,
+	0x97, 0xa4, 0x5c, 0x72, 0xb4, 0xa9, 0x68, 0x4f, 0xd3, 0xde, 0x78, 0xaf, 0xbe, 0x85, 0x19, 0x8d,
+	0xb9, 0xaf, 0xbe, 0xba, 0xa6, 0xbe, 0x1d, 0xf1, 0x88, 0xab, 0xa3, 0x9f, 0x9f, 0x0c, 0x6a, 0x47,
+	0x9c, 0x47, 0x23, 0xe2, 0xab, 0x5f, 0xbd, 0x6c, 0xe0, 0xf7, 0xb3, 0x14, 0x4b, 0xca, 0x63, 0xc3,
+	0x3b, 0xe7, 0x79, 0x49, 0x19, 0x11, 0x12, 0xb3, 0x44, 0x17, 0xb8, 0x9f, 0xcb, 0xb0, 0xfa, 0x34,
+	0xf7, 0x7d, 0x1e, 0x0f, 0x38, 0xb2, 0x21, 0xa4, 0x7d, 0x12, 0x4b, 0x3a, 0xa0, 0x24, 0xb5, 0x40,
+	0x03, 0x34, 0xab, 0xc1, 0x12, 0x82, 0xde, 0x42, 0x28, 0x24, 0x4e, 0x65, 0x37, 0x6f, 0x63, 0x5d,
+	0x68, 0x80, 0xe6, 0xe5, 0xfd, 0xba, 0xa7, 0x3d, 0xbc, 0xc2, 0xc3, 0x7b, 0x55, 0x78, 0xb4, 0xdc,
+	0xe3, 0xa9, 0x53, 0x3a, 0x9d, 0x3a, 0x5b, 0x13, 0xcc, 0x46, 0x07, 0xee, 0x1f, 0xad, 0x7b, 0xf4,
+	0xdd, 0x01, 0x5f, 0x7e, 0x7e, 0xbd, 0x0b, 0x82, 0xaa, 0x42, 0x73, 0x0d, 0x62, 0x70, 0xbd, 0xb8,
+	0xbf, 0xb5, 0xa6, 0x9a, 0x5f, 0x5f, 0x69, 0xfe, 0xc4, 0x14, 0xb4, 0x1e, 0xe6, 0xbd, 0x7f, 0x4d,
+	0x1d, 0x54, 0x48, 0xee, 0x71, 0x46, 0x25, 0x61, 0x89, 0x9c, 0x9c, 0x4e, 0x9d, 0x4d, 0xed, 0x58,
+	0x70, 0xee, 0xa7, 0x85, 0xdf, 0xc2, 0x02, 0xdd, 0x84, 0x1b, 0x61, 0x96, 0xa6, 0x24, 0x96, 0x5d,
+	0x15, 0xbd, 0x55, 0x6e, 0x80, 0xe6, 0x5a, 0x50, 0x33, 0xa0, 0x8a, 0x05, 0x7d, 0x00, 0xd0, 0x3a,
+	0x53, 0xd5, 0x5d, 0x4a, 0xe0, 0xe2, 0x7f, 0x13, 0xd8, 0x35, 0x09, 0x38, 0xfa, 0x3e, 0xff, 0xea,
+	0xb4, 0x94, 0xc7, 0xd5, 0x65, 0xfb, 0xce, 0x22, 0x9b, 0x07, 0xf0, 0x9a, 0x16, 0x85, 0x3c, 0x8b,
+	0x25, 0x8d, 0x23, 0xad, 0x26, 0x7d, 0xab, 0xd2, 0x00, 0xcd, 0xf5, 0x60, 0x5b, 0xb1, 0x6d, 0x43,
+	0x76, 0x34, 0x87, 0x1e, 0xc1, 0xfa, 0xdf, 0x2c, 0x87, 0x84, 0x46, 0x43, 0x69, 0x5d, 0x52, 0xf3,
+	0xee, 0xac, 0x18, 0x3e, 0x53, 0xb4, 0xfb, 0x11, 0xc0, 0xda, 0xa1, 0x5e, 0xd4, 0x8e, 0xc4, 0x92,
+	0xa0, 0xc7, 0xb0, 0xa2, 0x77, 0xd4, 0x02, 0x8d, 0x35, 0x35, 0xf8, 0xb9, 0xc5, 0xf5, 0x16, 0xab,
+	0xd4, 0xaa, 0xe6, 0x83, 0xeb, 0x89, 0x8c, 0x08, 0x1d, 0xc0, 0x4a, 0x82, 0x53, 0xcc, 0x84, 0xd9,
+	0x9c, 0x9d, 0x15, 0xf9, 0x4b, 0x45, 0x9f, 0xd1, 0x6a, 0x85, 0xfb, 0x1a, 0x56, 0x34, 0x89, 0x6e,
+	0xc3, 0x2b, 0x43, 0xce, 0xdf, 0x75, 0x43, 0x1e, 0xcb, 0x14, 0x87, 0x52, 0x5f, 0xa6, 0x1a, 0x6c,
+	0xe4, 0x68, 0xbb, 0x00, 0xd1, 0x2d, 0x53, 0x16, 0x61, 0xd1, 0x1d, 0x51, 0x46, 0xa5, 0x32, 0x2d,
+	0x07, 0xb5, 0x1c, 0x3d, 0xc4, 0xe2, 0x45, 0x8e, 0xb5, 0xda, 0xc7, 0x33, 0x1b, 0x9c, 0xcc, 0x6c,
+	0xf0, 0x63, 0x66, 0x83, 0xa3, 0xb9, 0x5d, 0x3a, 0x99, 0xdb, 0xa5, 0x6f, 0x73, 0xbb, 0xf4, 0xe6,
+	0x4e, 0x44, 0xe5, 0x30, 0xeb, 0x79, 0x21, 0x67, 0xbe, 0x79, 0xbd, 0xea, 0x3b, 0xde, 0xdf, 0xf5,
+	0xdf, 0x17, 0x2f, 0x59, 0x4e, 0x12, 0x22, 0x7a, 0x15, 0xf5, 0xbf, 0xdf, 0xff, 0x3d, 0x00, 0xc8,
+	0xc9, 0xb2, 0xad, 0xe6, 0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -312,6 +401,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -329,6 +428,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HookGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HookGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HookContracts) > 0 {
+		for iNdEx := len(m.HookContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HookContracts[iNdEx])
+			copy(dAtA[i:], m.HookContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.HookContracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -340,6 +476,7 @@ func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+
 func (m *EpochInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -380,15 +517,37 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HookContracts) > 0 {
+		for _, s := range m.HookContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.HookGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.HookGasLimit))
+	}
 	return n
 }
 
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
+
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+
 func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -628,6 +787,7 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -691,6 +851,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -712,6 +905,109 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookContracts = append(m.HookContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookGasLimit", wireType)
+			}
+			m.HookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
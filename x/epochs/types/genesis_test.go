@@ -1,9 +1,11 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -16,7 +18,7 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
-	newGen := NewGenesisState([]EpochInfo{})
+	newGen := NewGenesisState([]EpochInfo{}, DefaultParams())
 
 	testCases := []struct {
 		name     string
@@ -37,6 +39,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			"valid genesis",
 			&GenesisState{
 				Epochs: []EpochInfo{},
+				Params: DefaultParams(),
 			},
 			true,
 		},
@@ -63,6 +66,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 						EpochCountingStarted:    false,
 					},
 				},
+				Params: DefaultParams(),
 			},
 			true,
 		},
@@ -89,6 +93,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 						EpochCountingStarted:    false,
 					},
 				},
+				Params: DefaultParams(),
 			},
 			false,
 		},
@@ -106,9 +111,80 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 						EpochCountingStarted:    false,
 					},
 				},
+				Params: DefaultParams(),
 			},
 			false,
 		},
+		{
+			"invalid genesis - empty params",
+			&GenesisState{
+				Epochs: []EpochInfo{},
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid hook contract",
+			&GenesisState{
+				Epochs: []EpochInfo{},
+				Params: NewParams([]string{"invalid"}, DefaultHookGasLimit),
+			},
+			false,
+		},
+		{
+			"invalid genesis - duplicated hook contract",
+			&GenesisState{
+				Epochs: []EpochInfo{},
+				Params: NewParams(
+					[]string{
+						"0x29fDcB7b64B84fD54D0fB0E04A8f6B062046fc6F",
+						"0x29fdcb7b64b84fd54d0fb0e04a8f6b062046fc6f",
+					},
+					DefaultHookGasLimit,
+				),
+			},
+			false,
+		},
+		{
+			"invalid genesis - zero hook gas limit",
+			&GenesisState{
+				Epochs: []EpochInfo{},
+				Params: NewParams([]string{}, 0),
+			},
+			false,
+		},
+		{
+			"invalid genesis - hook gas limit above the maximum",
+			&GenesisState{
+				Epochs: []EpochInfo{},
+				Params: NewParams([]string{}, MaxHookGasLimit+1),
+			},
+			false,
+		},
+		{
+			"invalid genesis - too many hook contracts",
+			&GenesisState{
+				Epochs: []EpochInfo{},
+				Params: NewParams(
+					func() []string {
+						contracts := make([]string, MaxHookContracts+1)
+						for i := range contracts {
+							contracts[i] = common.BigToAddress(big.NewInt(int64(i + 1))).Hex()
+						}
+						return contracts
+					}(),
+					DefaultHookGasLimit,
+				),
+			},
+			false,
+		},
+		{
+			"valid genesis - with hook contracts",
+			&GenesisState{
+				Epochs: []EpochInfo{},
+				Params: NewParams([]string{"0x29fDcB7b64B84fD54D0fB0E04A8f6B062046fc6F"}, DefaultHookGasLimit),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
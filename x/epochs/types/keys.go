@@ -17,7 +17,12 @@ const (
 // prefix bytes for the epochs persistent store
 const (
 	prefixEpoch = iota + 1
+	prefixParams
 )
 
-// KeyPrefixEpoch defines prefix key for storing epochs
-var KeyPrefixEpoch = []byte{prefixEpoch}
+var (
+	// KeyPrefixEpoch defines prefix key for storing epochs
+	KeyPrefixEpoch = []byte{prefixEpoch}
+	// ParamsKey defines the key for storing the module parameters
+	ParamsKey = []byte{prefixParams}
+)
@@ -0,0 +1,92 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// DefaultHookGasLimit defines the default maximum amount of gas that each hook
+	// contract can consume on every epoch end.
+	DefaultHookGasLimit uint64 = 300_000
+	// MaxHookGasLimit defines the maximum gas limit that can be set for each
+	// hook contract. The hooks run in BeginBlock, outside of any block gas
+	// limit, so the gas they can consume must be bounded.
+	MaxHookGasLimit uint64 = 3_000_000
+	// MaxHookContracts defines the maximum number of hook contracts that can
+	// be registered.
+	MaxHookContracts = 10
+)
+
+// NewParams creates a new Params instance
+func NewParams(hookContracts []string, hookGasLimit uint64) Params {
+	return Params{
+		HookContracts: hookContracts,
+		HookGasLimit:  hookGasLimit,
+	}
+}
+
+// DefaultParams returns the default epochs module parameters
+func DefaultParams() Params {
+	return Params{
+		HookContracts: []string{},
+		HookGasLimit:  DefaultHookGasLimit,
+	}
+}
+
+// Validate performs a stateless validation of the epochs module parameters
+func (p Params) Validate() error {
+	if err := ValidateHookContracts(p.HookContracts); err != nil {
+		return err
+	}
+
+	return validateHookGasLimit(p.HookGasLimit)
+}
+
+// ValidateHookContracts checks that the hook contracts are valid and unique
+// hex addresses, and that there are no more than MaxHookContracts of them.
+func ValidateHookContracts(contracts []string) error {
+	if len(contracts) > MaxHookContracts {
+		return fmt.Errorf("too many hook contracts: %d > %d", len(contracts), MaxHookContracts)
+	}
+
+	seen := make(map[string]bool, len(contracts))
+	for _, contract := range contracts {
+		if !common.IsHexAddress(contract) {
+			return fmt.Errorf("invalid hook contract address %s", contract)
+		}
+
+		address := common.HexToAddress(contract).Hex()
+		if seen[address] {
+			return fmt.Errorf("duplicated hook contract %s", contract)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+func validateHookGasLimit(gasLimit uint64) error {
+	if gasLimit == 0 {
+		return fmt.Errorf("hook gas limit cannot be zero")
+	}
+
+	if gasLimit > MaxHookGasLimit {
+		return fmt.Errorf("hook gas limit %d exceeds the maximum %d", gasLimit, MaxHookGasLimit)
+	}
+
+	return nil
+}
+
+// GetHookContractAddresses returns the hook contracts as EVM addresses.
+func (p Params) GetHookContractAddresses() []common.Address {
+	addresses := make([]common.Address, len(p.HookContracts))
+	for i, contract := range p.HookContracts {
+		addresses[i] = common.HexToAddress(contract)
+	}
+	return addresses
+}
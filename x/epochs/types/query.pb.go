@@ -6,6 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -15,15 +19,14 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	io "io"
-	math "math"
-	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
+var (
+	_ = proto.Marshal
+	_ = fmt.Errorf
+	_ = math.Inf
+)
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -44,9 +47,11 @@ func (*QueryEpochsInfoRequest) ProtoMessage()    {}
 func (*QueryEpochsInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d13f5778acd937ff, []int{0}
 }
+
 func (m *QueryEpochsInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
+
 func (m *QueryEpochsInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_QueryEpochsInfoRequest.Marshal(b, m, deterministic)
@@ -59,12 +64,15 @@ func (m *QueryEpochsInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
+
 func (m *QueryEpochsInfoRequest) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_QueryEpochsInfoRequest.Merge(m, src)
 }
+
 func (m *QueryEpochsInfoRequest) XXX_Size() int {
 	return m.Size()
 }
+
 func (m *QueryEpochsInfoRequest) XXX_DiscardUnknown() {
 	xxx_messageInfo_QueryEpochsInfoRequest.DiscardUnknown(m)
 }
@@ -93,9 +101,11 @@ func (*QueryEpochsInfoResponse) ProtoMessage()    {}
 func (*QueryEpochsInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d13f5778acd937ff, []int{1}
 }
+
 func (m *QueryEpochsInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
+
 func (m *QueryEpochsInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_QueryEpochsInfoResponse.Marshal(b, m, deterministic)
@@ -108,12 +118,15 @@ func (m *QueryEpochsInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
+
 func (m *QueryEpochsInfoResponse) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_QueryEpochsInfoResponse.Merge(m, src)
 }
+
 func (m *QueryEpochsInfoResponse) XXX_Size() int {
 	return m.Size()
 }
+
 func (m *QueryEpochsInfoResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_QueryEpochsInfoResponse.DiscardUnknown(m)
 }
@@ -147,9 +160,11 @@ func (*QueryCurrentEpochRequest) ProtoMessage()    {}
 func (*QueryCurrentEpochRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d13f5778acd937ff, []int{2}
 }
+
 func (m *QueryCurrentEpochRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
+
 func (m *QueryCurrentEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_QueryCurrentEpochRequest.Marshal(b, m, deterministic)
@@ -162,12 +177,15 @@ func (m *QueryCurrentEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
+
 func (m *QueryCurrentEpochRequest) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_QueryCurrentEpochRequest.Merge(m, src)
 }
+
 func (m *QueryCurrentEpochRequest) XXX_Size() int {
 	return m.Size()
 }
+
 func (m *QueryCurrentEpochRequest) XXX_DiscardUnknown() {
 	xxx_messageInfo_QueryCurrentEpochRequest.DiscardUnknown(m)
 }
@@ -194,9 +212,11 @@ func (*QueryCurrentEpochResponse) ProtoMessage()    {}
 func (*QueryCurrentEpochResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d13f5778acd937ff, []int{3}
 }
+
 func (m *QueryCurrentEpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
+
 func (m *QueryCurrentEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_QueryCurrentEpochResponse.Marshal(b, m, deterministic)
@@ -209,12 +229,15 @@ func (m *QueryCurrentEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
+
 func (m *QueryCurrentEpochResponse) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_QueryCurrentEpochResponse.Merge(m, src)
 }
+
 func (m *QueryCurrentEpochResponse) XXX_Size() int {
 	return m.Size()
 }
+
 func (m *QueryCurrentEpochResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_QueryCurrentEpochResponse.DiscardUnknown(m)
 }
@@ -228,52 +251,151 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct{}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{4}
+}
+
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{5}
+}
+
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "evmos.epochs.v1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "evmos.epochs.v1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "evmos.epochs.v1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "evmos.epochs.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.epochs.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.epochs.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("evmos/epochs/v1/query.proto", fileDescriptor_d13f5778acd937ff) }
 
 var fileDescriptor_d13f5778acd937ff = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x29, 0x16, 0x3a, 0xad, 0x88, 0x83, 0xd8, 0x74, 0xd5, 0x6d, 0x88, 0xd0, 0xa6,
-	0x39, 0xcc, 0x98, 0x78, 0x13, 0x04, 0x69, 0x51, 0xf1, 0xa6, 0x7b, 0xf4, 0xa2, 0x9b, 0xf5, 0x75,
-	0x3a, 0x60, 0x66, 0xb6, 0x3b, 0x93, 0xc5, 0xde, 0xc4, 0xbb, 0x20, 0x78, 0xf3, 0xe6, 0xcd, 0xa3,
-	0x7f, 0x46, 0x8f, 0x05, 0x2f, 0x9e, 0x44, 0x12, 0xc1, 0x7f, 0x43, 0xf6, 0xcd, 0x44, 0xf3, 0x0b,
-	0xe2, 0x65, 0x19, 0xe6, 0xbd, 0xef, 0xf7, 0x7d, 0xde, 0x77, 0x87, 0xde, 0x80, 0x72, 0x60, 0xac,
-	0x80, 0xdc, 0x64, 0x27, 0x56, 0x94, 0x5d, 0x71, 0x3a, 0x84, 0xe2, 0x8c, 0xe7, 0x85, 0x71, 0x86,
-	0x5d, 0xc1, 0x22, 0xf7, 0x45, 0x5e, 0x76, 0xa3, 0xab, 0xe9, 0x40, 0x69, 0x23, 0xf0, 0xeb, 0x7b,
-	0xa2, 0x4e, 0x66, 0x6c, 0xe5, 0xd0, 0x4f, 0x2d, 0x78, 0xb1, 0x28, 0xbb, 0x7d, 0x70, 0x69, 0x57,
-	0xe4, 0xa9, 0x54, 0x3a, 0x75, 0xca, 0xe8, 0xd0, 0x7b, 0x6b, 0x7e, 0x98, 0x04, 0x0d, 0x56, 0xd9,
-	0x50, 0xbe, 0x26, 0x8d, 0x34, 0x78, 0x14, 0xd5, 0x29, 0xdc, 0xde, 0x94, 0xc6, 0xc8, 0xd7, 0x20,
-	0xd2, 0x5c, 0x89, 0x54, 0x6b, 0xe3, 0xd0, 0x31, 0x68, 0x5a, 0x2f, 0xe9, 0xf5, 0x67, 0xd5, 0xd0,
-	0x87, 0xe8, 0xf9, 0x44, 0x1f, 0x9b, 0x04, 0x4e, 0x87, 0x60, 0x1d, 0x7b, 0x44, 0xe9, 0x3f, 0x80,
-	0x06, 0x69, 0x92, 0xf6, 0x66, 0x6f, 0x8f, 0x7b, 0x5a, 0x5e, 0xd1, 0x72, 0xbf, 0x6a, 0xa0, 0xe5,
-	0x4f, 0x53, 0x09, 0x41, 0x9b, 0x4c, 0x29, 0x5b, 0x9f, 0x09, 0xdd, 0x5e, 0x18, 0x61, 0x73, 0xa3,
-	0x2d, 0xb0, 0xfb, 0x74, 0xdd, 0x2f, 0xd3, 0x20, 0xcd, 0xb5, 0xf6, 0x66, 0x2f, 0xe2, 0x73, 0x89,
-	0x71, 0x14, 0x55, 0x9a, 0xc3, 0x8d, 0xf3, 0x1f, 0xbb, 0xb5, 0x2f, 0xbf, 0xbf, 0x76, 0x48, 0x12,
-	0x44, 0xec, 0xf1, 0x0c, 0x62, 0x1d, 0x11, 0xf7, 0x57, 0x22, 0xfa, 0xd9, 0x33, 0x8c, 0xf7, 0x68,
-	0x03, 0x11, 0x8f, 0x86, 0x45, 0x01, 0xda, 0xe1, 0xd0, 0x49, 0x0e, 0x31, 0xa5, 0xea, 0x15, 0x68,
-	0xa7, 0x8e, 0x15, 0x14, 0x98, 0xc3, 0x46, 0x32, 0x75, 0xd3, 0x7a, 0x40, 0x77, 0x96, 0x68, 0xc3,
-	0x82, 0xb7, 0xe9, 0xe5, 0xcc, 0xdf, 0xbf, 0x40, 0x66, 0xd4, 0xaf, 0x25, 0x5b, 0xd9, 0x54, 0x73,
-	0xef, 0x53, 0x9d, 0x5e, 0x42, 0x0b, 0xf6, 0x96, 0x50, 0xfa, 0x77, 0x63, 0xcb, 0xf6, 0x17, 0xe2,
-	0x58, 0xfe, 0xaf, 0xa2, 0xf6, 0xea, 0x46, 0x0f, 0xd4, 0xda, 0x7d, 0xf7, 0xed, 0xd7, 0xc7, 0xfa,
-	0x0e, 0xdb, 0x16, 0xf3, 0x6f, 0x29, 0x64, 0xfa, 0x9e, 0xd0, 0xad, 0xe9, 0x55, 0xd8, 0xc1, 0x72,
-	0xef, 0x25, 0x51, 0x45, 0x9d, 0xff, 0x69, 0x0d, 0x20, 0x7b, 0x08, 0xd2, 0x64, 0xf1, 0x02, 0xc8,
-	0x4c, 0x60, 0x87, 0x47, 0xe7, 0xa3, 0x98, 0x5c, 0x8c, 0x62, 0xf2, 0x73, 0x14, 0x93, 0x0f, 0xe3,
-	0xb8, 0x76, 0x31, 0x8e, 0x6b, 0xdf, 0xc7, 0x71, 0xed, 0xf9, 0x81, 0x54, 0xee, 0x64, 0xd8, 0xe7,
-	0x99, 0x19, 0x4c, 0x3c, 0xf0, 0x5b, 0xf6, 0xee, 0x88, 0x37, 0x13, 0x3f, 0x77, 0x96, 0x83, 0xed,
-	0xaf, 0xe3, 0x63, 0xbf, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xc4, 0xde, 0x7f, 0xae, 0x03,
-	0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x0d, 0x06, 0xfa, 0x5a, 0x11, 0xc7, 0x62, 0xd2, 0x55, 0xb7, 0x61, 0x2b, 0x6d,
+	0x9a, 0xc3, 0x8c, 0x89, 0xb7, 0x82, 0x20, 0x2d, 0x2a, 0xde, 0xda, 0x3d, 0x7a, 0xd1, 0xc9, 0x3a,
+	0xdd, 0x2e, 0x98, 0x99, 0xed, 0xce, 0x64, 0xb1, 0x37, 0xf1, 0x2e, 0x14, 0xfc, 0x04, 0xde, 0x3c,
+	0xfa, 0x31, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0x08, 0x7e, 0x0d, 0xd9, 0x99, 0x89, 0x66, 0x93,
+	0xc5, 0x78, 0x59, 0x96, 0x37, 0xef, 0xff, 0x7f, 0xbf, 0xf9, 0xbf, 0x5d, 0xb8, 0xc3, 0xf3, 0xa1,
+	0x54, 0x94, 0xa7, 0x32, 0x3a, 0x55, 0x34, 0xef, 0xd1, 0xb3, 0x11, 0xcf, 0xce, 0x49, 0x9a, 0x49,
+	0x2d, 0xf1, 0x0d, 0x73, 0x48, 0xec, 0x21, 0xc9, 0x7b, 0xde, 0x4d, 0x36, 0x4c, 0x84, 0xa4, 0xe6,
+	0x69, 0x7b, 0xbc, 0x6e, 0x24, 0x55, 0xe1, 0x30, 0x60, 0x8a, 0x5b, 0x31, 0xcd, 0x7b, 0x03, 0xae,
+	0x59, 0x8f, 0xa6, 0x2c, 0x4e, 0x04, 0xd3, 0x89, 0x14, 0xae, 0xf7, 0xde, 0xfc, 0xb0, 0x98, 0x0b,
+	0xae, 0x12, 0xe5, 0x8e, 0x37, 0x62, 0x19, 0x4b, 0xf3, 0x4a, 0x8b, 0x37, 0x57, 0xbd, 0x1b, 0x4b,
+	0x19, 0xbf, 0xe1, 0x94, 0xa5, 0x09, 0x65, 0x42, 0x48, 0x6d, 0x1c, 0x9d, 0x26, 0x78, 0x05, 0xb7,
+	0x8f, 0x8b, 0xa1, 0x4f, 0x8c, 0xe7, 0x73, 0x71, 0x22, 0x43, 0x7e, 0x36, 0xe2, 0x4a, 0xe3, 0xa7,
+	0x00, 0x7f, 0x01, 0x5a, 0xa8, 0x8d, 0x3a, 0x6b, 0xfd, 0x1d, 0x62, 0x69, 0x49, 0x41, 0x4b, 0xec,
+	0x55, 0x1d, 0x2d, 0x39, 0x62, 0x31, 0x77, 0xda, 0x70, 0x46, 0x19, 0x7c, 0x42, 0xd0, 0x5c, 0x18,
+	0xa1, 0x52, 0x29, 0x14, 0xc7, 0x8f, 0xa0, 0x61, 0x2f, 0xd3, 0x42, 0xed, 0x7a, 0x67, 0xad, 0xef,
+	0x91, 0xb9, 0xc4, 0x88, 0x11, 0x15, 0x9a, 0x83, 0xd5, 0xcb, 0xef, 0x5b, 0xb5, 0xcf, 0xbf, 0xbe,
+	0x74, 0x51, 0xe8, 0x44, 0xf8, 0x59, 0x09, 0x71, 0xc5, 0x20, 0xee, 0x2e, 0x45, 0xb4, 0xb3, 0x4b,
+	0x8c, 0xfb, 0xd0, 0x32, 0x88, 0x87, 0xa3, 0x2c, 0xe3, 0x42, 0x9b, 0xa1, 0xd3, 0x1c, 0x7c, 0x80,
+	0xe4, 0x35, 0x17, 0x3a, 0x39, 0x49, 0x78, 0x66, 0x72, 0x58, 0x0d, 0x67, 0x2a, 0xc1, 0x63, 0xd8,
+	0xac, 0xd0, 0xba, 0x0b, 0x6e, 0xc3, 0xf5, 0xc8, 0xd6, 0x5f, 0x1a, 0x66, 0xa3, 0xaf, 0x87, 0xeb,
+	0xd1, 0x4c, 0x73, 0xb0, 0x01, 0xd8, 0x38, 0x1c, 0xb1, 0x8c, 0x0d, 0x95, 0x9b, 0x1b, 0x1c, 0xc3,
+	0xad, 0x52, 0xd5, 0x39, 0xee, 0x43, 0x23, 0x35, 0x15, 0xb7, 0x92, 0xe6, 0x42, 0x64, 0x56, 0x50,
+	0xca, 0xcb, 0x2a, 0xfa, 0x17, 0x75, 0xb8, 0x66, 0x3c, 0xf1, 0x3b, 0x04, 0xf0, 0x27, 0x5a, 0x85,
+	0x77, 0x17, 0x4c, 0xaa, 0x3f, 0x0a, 0xaf, 0xb3, 0xbc, 0xd1, 0x72, 0x06, 0x5b, 0xef, 0xbf, 0xfe,
+	0xfc, 0xb8, 0xb2, 0x89, 0x9b, 0x74, 0xfe, 0xa3, 0x75, 0xcb, 0xfb, 0x80, 0x60, 0x7d, 0x36, 0x33,
+	0xbc, 0x57, 0xed, 0x5d, 0xb1, 0x13, 0xaf, 0xfb, 0x3f, 0xad, 0x0e, 0x64, 0xc7, 0x80, 0xb4, 0xb1,
+	0xbf, 0x00, 0x52, 0xda, 0x0c, 0xd6, 0xd0, 0xb0, 0xc9, 0xe1, 0xed, 0x6a, 0xf7, 0xd2, 0x7a, 0xbc,
+	0xfb, 0xff, 0x6e, 0x5a, 0x9a, 0x82, 0x5d, 0xc9, 0xc1, 0xe1, 0xe5, 0xd8, 0x47, 0x57, 0x63, 0x1f,
+	0xfd, 0x18, 0xfb, 0xe8, 0x62, 0xe2, 0xd7, 0xae, 0x26, 0x7e, 0xed, 0xdb, 0xc4, 0xaf, 0xbd, 0xd8,
+	0x8b, 0x13, 0x7d, 0x3a, 0x1a, 0x90, 0x48, 0x0e, 0xa7, 0x62, 0xf3, 0xcc, 0xfb, 0x0f, 0xe8, 0xdb,
+	0xa9, 0x91, 0x3e, 0x4f, 0xb9, 0x1a, 0x34, 0xcc, 0xbf, 0xfc, 0xf0, 0xf7, 0x00, 0x46, 0xc3, 0x56,
+	0x2e, 0x8d, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var (
+	_ context.Context
+	_ grpc.ClientConn
+)
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
@@ -287,6 +409,8 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// Params retrieves the epochs module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -315,25 +439,40 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.epochs.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// Params retrieves the epochs module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
-}
+type UnimplementedQueryServer struct{}
 
 func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochInfos not implemented")
 }
+
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
@@ -374,23 +513,48 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.epochs.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EpochInfos",
-			Handler:    _Query_EpochInfos_Handler,
-		},
-		{
-			MethodName: "CurrentEpoch",
-			Handler:    _Query_CurrentEpoch_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/epochs/v1/query.proto",
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.epochs.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
+var (
+	Query_serviceDesc  = _Query_serviceDesc
+	_Query_serviceDesc = grpc.ServiceDesc{
+		ServiceName: "evmos.epochs.v1.Query",
+		HandlerType: (*QueryServer)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "EpochInfos",
+				Handler:    _Query_EpochInfos_Handler,
+			},
+			{
+				MethodName: "CurrentEpoch",
+				Handler:    _Query_CurrentEpoch_Handler,
+			},
+			{
+				MethodName: "Params",
+				Handler:    _Query_Params_Handler,
+			},
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: "evmos/epochs/v1/query.proto",
+	}
+)
+
 func (m *QueryEpochsInfoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -533,6 +697,62 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -544,6 +764,7 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+
 func (m *QueryEpochsInfoRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -601,12 +822,34 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
+
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+
 func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -693,6 +936,7 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -813,6 +1057,7 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -895,6 +1140,7 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -964,6 +1210,142 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
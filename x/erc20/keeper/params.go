@@ -20,7 +20,8 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	dynamicPrecompiles := k.getDynamicPrecompiles(ctx)
 	nativePrecompiles := k.getNativePrecompiles(ctx)
-	return types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	permissionlessRegistration := k.IsPermissionlessRegistrationEnabled(ctx)
+	return types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles, permissionlessRegistration)
 }
 
 // UpdateCodeHash takes in the updated parameters and
@@ -88,6 +89,7 @@ func (k Keeper) SetParams(ctx sdk.Context, newParams types.Params) error {
 	k.setERC20Enabled(ctx, newParams.EnableErc20)
 	k.setDynamicPrecompiles(ctx, newParams.DynamicPrecompiles)
 	k.setNativePrecompiles(ctx, newParams.NativePrecompiles)
+	k.setPermissionlessRegistration(ctx, newParams.PermissionlessRegistration)
 	return nil
 }
 
@@ -107,6 +109,23 @@ func (k Keeper) setERC20Enabled(ctx sdk.Context, enable bool) {
 	store.Delete(types.ParamStoreKeyEnableErc20)
 }
 
+// IsPermissionlessRegistrationEnabled returns true if any account can register
+// the token pair of an ERC20 contract without a governance proposal
+func (k Keeper) IsPermissionlessRegistrationEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ParamStoreKeyPermissionlessRegistration)
+}
+
+// setPermissionlessRegistration sets the PermissionlessRegistration param in the store
+func (k Keeper) setPermissionlessRegistration(ctx sdk.Context, enable bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enable {
+		store.Set(types.ParamStoreKeyPermissionlessRegistration, isTrue)
+		return
+	}
+	store.Delete(types.ParamStoreKeyPermissionlessRegistration)
+}
+
 // setDynamicPrecompiles sets the DynamicPrecompiles param in the store
 func (k Keeper) setDynamicPrecompiles(ctx sdk.Context, dynamicPrecompiles []string) {
 	store := ctx.KVStore(k.storeKey)
@@ -54,6 +54,20 @@ func (suite *KeeperTestSuite) TestParams() {
 			},
 			true,
 		},
+		{
+			"success - Checks if permissionless registration is set correctly",
+			func() interface{} {
+				params := types.DefaultParams()
+				params.PermissionlessRegistration = true
+				err := suite.network.App.Erc20Keeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+				return params.PermissionlessRegistration
+			},
+			func() interface{} {
+				return suite.network.App.Erc20Keeper.IsPermissionlessRegistrationEnabled(ctx)
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
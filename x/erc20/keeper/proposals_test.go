@@ -190,6 +190,63 @@ func (suite *KeeperTestSuite) TestRegisterERC20() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRegisterERC20Permissionless() {
+	var ctx sdk.Context
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - erc20 module disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableErc20 = false
+				params.PermissionlessRegistration = true
+				suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+			},
+			false,
+		},
+		{
+			"fail - permissionless registration disabled",
+			func() {},
+			false,
+		},
+		{
+			"ok",
+			func() {
+				params := types.DefaultParams()
+				params.PermissionlessRegistration = true
+				suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			contractAddr, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err, "failed to deploy contract")
+			suite.Require().NoError(suite.network.NextBlock(), "failed to advance block")
+
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			pair, err := suite.network.App.Erc20Keeper.RegisterERC20Permissionless(ctx, contractAddr)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(types.CreateDenom(contractAddr.String()), pair.Denom)
+				suite.Require().True(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contractAddr))
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().False(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contractAddr))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestToggleConverision() {
 	var (
 		ctx          sdk.Context
@@ -68,7 +68,7 @@ func TestMigrate(t *testing.T) {
 		nativePrecompiles = append(nativePrecompiles, string(bz[i:i+v4.AddressLength]))
 	}
 
-	params := types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params := types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles, false)
 	defaultParams := types.DefaultParams()
 	require.Equal(t, params, defaultParams)
 }
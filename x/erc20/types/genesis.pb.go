@@ -5,18 +5,21 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
-	_ "github.com/cosmos/gogoproto/gogoproto"
-	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
+var (
+	_ = proto.Marshal
+	_ = fmt.Errorf
+	_ = math.Inf
+)
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,9 +41,11 @@ func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2f4674601b0d6987, []int{0}
 }
+
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
+
 func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
@@ -53,12 +58,15 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
+
 func (m *GenesisState) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_GenesisState.Merge(m, src)
 }
+
 func (m *GenesisState) XXX_Size() int {
 	return m.Size()
 }
+
 func (m *GenesisState) XXX_DiscardUnknown() {
 	xxx_messageInfo_GenesisState.DiscardUnknown(m)
 }
@@ -89,6 +97,9 @@ type Params struct {
 	// dynamic_precompiles defines the slice of hex addresses of the
 	// active precompiles that are used to interact with Bank coins as ERC20s
 	DynamicPrecompiles []string `protobuf:"bytes,4,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// permissionless_registration is the parameter to allow any account to register
+	// the token pair of an ERC20 contract without a governance proposal.
+	PermissionlessRegistration bool `protobuf:"varint,5,opt,name=permissionless_registration,json=permissionlessRegistration,proto3" json:"permissionless_registration,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -97,9 +108,11 @@ func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2f4674601b0d6987, []int{1}
 }
+
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
+
 func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
@@ -112,12 +125,15 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
+
 func (m *Params) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_Params.Merge(m, src)
 }
+
 func (m *Params) XXX_Size() int {
 	return m.Size()
 }
+
 func (m *Params) XXX_DiscardUnknown() {
 	xxx_messageInfo_Params.DiscardUnknown(m)
 }
@@ -145,6 +161,13 @@ func (m *Params) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetPermissionlessRegistration() bool {
+	if m != nil {
+		return m.PermissionlessRegistration
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -153,29 +176,31 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4e, 0xfa, 0x40,
-	0x10, 0xc6, 0xbb, 0x94, 0x10, 0xd8, 0x92, 0x7f, 0xfe, 0xac, 0xc6, 0x20, 0x31, 0x15, 0x39, 0x35,
-	0x26, 0x76, 0xa1, 0x9e, 0xbc, 0x92, 0x10, 0x13, 0x4f, 0x04, 0x3d, 0x79, 0x21, 0x4b, 0x9d, 0xd4,
-	0x8d, 0xb4, 0xdb, 0x74, 0xd7, 0x46, 0xde, 0x02, 0xdf, 0xc2, 0xa3, 0x8f, 0xc1, 0x91, 0xa3, 0x27,
-	0x63, 0xe0, 0xe0, 0x6b, 0x18, 0x76, 0x6b, 0x04, 0x2e, 0x93, 0xc9, 0xf7, 0xfb, 0xbe, 0x99, 0xcd,
-	0x2c, 0x3e, 0x81, 0x3c, 0x16, 0x92, 0x42, 0x16, 0x06, 0x5d, 0x9a, 0xf7, 0x68, 0x04, 0x09, 0x48,
-	0x2e, 0xfd, 0x34, 0x13, 0x4a, 0x90, 0x7f, 0x9a, 0xfa, 0x9a, 0xfa, 0x79, 0xaf, 0xd5, 0x60, 0x31,
-	0x4f, 0x04, 0xd5, 0xd5, 0x58, 0x5a, 0xad, 0xbd, 0x01, 0xc6, 0x6b, 0xd8, 0x61, 0x24, 0x22, 0xa1,
-	0x5b, 0xba, 0xe9, 0x8c, 0xda, 0x99, 0x23, 0x5c, 0xbf, 0x36, 0x6b, 0x6e, 0x15, 0x53, 0x40, 0xae,
-	0x70, 0x25, 0x65, 0x19, 0x8b, 0x65, 0x13, 0xb5, 0x91, 0xe7, 0x04, 0x47, 0xfe, 0xee, 0x5a, 0x7f,
-	0xa8, 0x69, 0xbf, 0xb6, 0xf8, 0x3c, 0xb5, 0xde, 0xbe, 0xdf, 0xcf, 0xd1, 0xa8, 0x08, 0x90, 0x01,
-	0x76, 0x94, 0x78, 0x82, 0x64, 0x9c, 0x32, 0x9e, 0xc9, 0x66, 0xa9, 0x6d, 0x7b, 0x4e, 0x70, 0xbc,
-	0x9f, 0xbf, 0xdb, 0x58, 0x86, 0x8c, 0x67, 0xdb, 0x23, 0xb0, 0xfa, 0x55, 0x65, 0xe7, 0x15, 0xe1,
-	0x8a, 0x59, 0x42, 0xce, 0x70, 0x1d, 0x12, 0x36, 0x99, 0xc2, 0x58, 0xc7, 0xf5, 0x93, 0xaa, 0x23,
-	0xc7, 0x68, 0x83, 0x8d, 0x44, 0x2e, 0x30, 0x49, 0x98, 0xe2, 0x39, 0x8c, 0xd3, 0x0c, 0x42, 0x11,
-	0xa7, 0x7c, 0x0a, 0xb2, 0x69, 0xb7, 0x6d, 0xaf, 0x36, 0x6a, 0x18, 0x32, 0xfc, 0x03, 0x84, 0xe2,
-	0x83, 0x87, 0x59, 0xc2, 0x62, 0x1e, 0xee, 0xf8, 0xcb, 0xda, 0x4f, 0x0a, 0xb4, 0x15, 0xb8, 0x29,
-	0x57, 0x4b, 0xff, 0xed, 0x7e, 0x7f, 0xb1, 0x72, 0xd1, 0x72, 0xe5, 0xa2, 0xaf, 0x95, 0x8b, 0xe6,
-	0x6b, 0xd7, 0x5a, 0xae, 0x5d, 0xeb, 0x63, 0xed, 0x5a, 0xf7, 0x5e, 0xc4, 0xd5, 0xe3, 0xf3, 0xc4,
-	0x0f, 0x45, 0x4c, 0x8b, 0xeb, 0xeb, 0x9a, 0x07, 0x5d, 0xfa, 0x52, 0xfc, 0x84, 0x9a, 0xa5, 0x20,
-	0x27, 0x15, 0x7d, 0xf1, 0xcb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x6f, 0x0a, 0xc1, 0xe6,
-	0x01, 0x00, 0x00,
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x8a, 0xdb, 0x30,
+	0x10, 0x86, 0xad, 0x38, 0x0d, 0x89, 0x1c, 0x4a, 0xa3, 0x96, 0xe2, 0xba, 0xc5, 0x4d, 0x73, 0x32,
+	0x85, 0x5a, 0x89, 0x7b, 0xea, 0xa9, 0x10, 0x08, 0x85, 0x9e, 0x82, 0xdb, 0x53, 0x2f, 0x46, 0x71,
+	0x85, 0x2b, 0x1a, 0x4b, 0x46, 0xd2, 0x9a, 0xcd, 0x5b, 0xe4, 0x31, 0xf6, 0xb8, 0x8f, 0x91, 0x63,
+	0xd8, 0xd3, 0x9e, 0x96, 0x25, 0x39, 0xec, 0x6b, 0x2c, 0x91, 0xbc, 0x6c, 0x92, 0xcb, 0x30, 0xfc,
+	0xff, 0xf7, 0xcf, 0x0c, 0x0c, 0xfc, 0x40, 0xeb, 0x52, 0x28, 0x4c, 0x65, 0x9e, 0x8c, 0x71, 0x3d,
+	0xc1, 0x05, 0xe5, 0x54, 0x31, 0x15, 0x57, 0x52, 0x68, 0x81, 0x5e, 0x1a, 0x37, 0x36, 0x6e, 0x5c,
+	0x4f, 0x82, 0x01, 0x29, 0x19, 0x17, 0xd8, 0x54, 0x8b, 0x04, 0xc1, 0xd9, 0x00, 0xcb, 0x5a, 0xef,
+	0x4d, 0x21, 0x0a, 0x61, 0x5a, 0x7c, 0xe8, 0xac, 0x3a, 0x5a, 0x03, 0xd8, 0xff, 0x61, 0xd7, 0xfc,
+	0xd2, 0x44, 0x53, 0xf4, 0x0d, 0x76, 0x2a, 0x22, 0x49, 0xa9, 0x7c, 0x30, 0x04, 0x91, 0x97, 0xbc,
+	0x8d, 0x4f, 0xd7, 0xc6, 0x73, 0xe3, 0x4e, 0x7b, 0x9b, 0xbb, 0x8f, 0xce, 0xd5, 0xc3, 0xf5, 0x67,
+	0x90, 0x36, 0x01, 0x34, 0x83, 0x9e, 0x16, 0xff, 0x29, 0xcf, 0x2a, 0xc2, 0xa4, 0xf2, 0x5b, 0x43,
+	0x37, 0xf2, 0x92, 0x77, 0xe7, 0xf9, 0xdf, 0x07, 0x64, 0x4e, 0x98, 0x3c, 0x1e, 0x01, 0xf5, 0x93,
+	0xaa, 0x46, 0x37, 0x00, 0x76, 0xec, 0x12, 0xf4, 0x09, 0xf6, 0x29, 0x27, 0x8b, 0x25, 0xcd, 0x4c,
+	0xdc, 0x9c, 0xd4, 0x4d, 0x3d, 0xab, 0xcd, 0x0e, 0x12, 0xfa, 0x02, 0x11, 0x27, 0x9a, 0xd5, 0x34,
+	0xab, 0x24, 0xcd, 0x45, 0x59, 0xb1, 0x25, 0x55, 0xbe, 0x3b, 0x74, 0xa3, 0x5e, 0x3a, 0xb0, 0xce,
+	0xfc, 0xd9, 0x40, 0x18, 0xbe, 0xfe, 0xbb, 0xe2, 0xa4, 0x64, 0xf9, 0x09, 0xdf, 0x36, 0x3c, 0x6a,
+	0xac, 0xe3, 0xc0, 0x77, 0xf8, 0xbe, 0xa2, 0xb2, 0x64, 0x4a, 0x31, 0xc1, 0x97, 0x54, 0xa9, 0x4c,
+	0xd2, 0x82, 0x29, 0x2d, 0x89, 0x66, 0x82, 0xfb, 0x2f, 0xcc, 0x45, 0xc1, 0x29, 0x92, 0x1e, 0x11,
+	0x3f, 0xdb, 0xdd, 0xd6, 0x2b, 0x77, 0x3a, 0xdd, 0xec, 0x42, 0xb0, 0xdd, 0x85, 0xe0, 0x7e, 0x17,
+	0x82, 0xf5, 0x3e, 0x74, 0xb6, 0xfb, 0xd0, 0xb9, 0xdd, 0x87, 0xce, 0x9f, 0xa8, 0x60, 0xfa, 0xdf,
+	0xc5, 0x22, 0xce, 0x45, 0x89, 0x9b, 0xf7, 0x99, 0x5a, 0x27, 0x63, 0x7c, 0xd9, 0xbc, 0x52, 0xaf,
+	0x2a, 0xaa, 0x16, 0x1d, 0xf3, 0xb2, 0xaf, 0x8f, 0x03, 0x00, 0x67, 0x6a, 0x95, 0x97, 0x27, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -245,6 +270,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PermissionlessRegistration {
+		i--
+		if m.PermissionlessRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -287,6 +322,7 @@ func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -325,15 +361,20 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.PermissionlessRegistration {
+		n += 2
+	}
 	return n
 }
 
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
+
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -451,6 +492,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -564,6 +606,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionlessRegistration = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -585,6 +647,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
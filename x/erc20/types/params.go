@@ -44,6 +44,8 @@ var (
 	ParamStoreKeyEnableErc20        = []byte("EnableErc20")
 	ParamStoreKeyDynamicPrecompiles = []byte("DynamicPrecompiles")
 	ParamStoreKeyNativePrecompiles  = []byte("NativePrecompiles")
+	// ParamStoreKeyPermissionlessRegistration is the store key of the PermissionlessRegistration param
+	ParamStoreKeyPermissionlessRegistration = []byte("PermissionlessRegistration")
 	// DefaultNativePrecompiles defines the default precompiles for the wrapped native coin
 	// NOTE: If you modify this, make sure you modify it on the local_node genesis script as well
 	DefaultNativePrecompiles = []string{WEVMOSContractMainnet}
@@ -56,21 +58,24 @@ func NewParams(
 	enableErc20 bool,
 	nativePrecompiles []string,
 	dynamicPrecompiles []string,
+	permissionlessRegistration bool,
 ) Params {
 	slices.Sort(nativePrecompiles)
 	slices.Sort(dynamicPrecompiles)
 	return Params{
-		EnableErc20:        enableErc20,
-		NativePrecompiles:  nativePrecompiles,
-		DynamicPrecompiles: dynamicPrecompiles,
+		EnableErc20:                enableErc20,
+		NativePrecompiles:          nativePrecompiles,
+		DynamicPrecompiles:         dynamicPrecompiles,
+		PermissionlessRegistration: permissionlessRegistration,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableErc20:        true,
-		NativePrecompiles:  DefaultNativePrecompiles,
-		DynamicPrecompiles: DefaultDynamicPrecompiles,
+		EnableErc20:                true,
+		NativePrecompiles:          DefaultNativePrecompiles,
+		DynamicPrecompiles:         DefaultDynamicPrecompiles,
+		PermissionlessRegistration: false,
 	}
 }
 
@@ -88,6 +93,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateBool(p.PermissionlessRegistration); err != nil {
+		return err
+	}
+
 	npAddrs, err := ValidatePrecompiles(p.NativePrecompiles)
 	if err != nil {
 		return err
@@ -33,19 +33,23 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		},
 		{
 			"valid",
-			func() types.Params { return types.NewParams(true, []string{}, []string{}) },
+			func() types.Params { return types.NewParams(true, []string{}, []string{}, false) },
 			false,
 			"",
 		},
 		{
 			"valid address - dynamic precompile",
-			func() types.Params { return types.NewParams(true, []string{}, []string{types.WEVMOSContractMainnet}) },
+			func() types.Params {
+				return types.NewParams(true, []string{}, []string{types.WEVMOSContractMainnet}, false)
+			},
 			false,
 			"",
 		},
 		{
 			"valid address - native precompile",
-			func() types.Params { return types.NewParams(true, []string{types.WEVMOSContractMainnet}, []string{}) },
+			func() types.Params {
+				return types.NewParams(true, []string{types.WEVMOSContractMainnet}, []string{}, false)
+			},
 			false,
 			"",
 		},
@@ -53,7 +57,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"sorted address",
 			// order of creation shouldn't matter since it should be sorted when defining new param
 			func() types.Params {
-				return types.NewParams(true, []string{types.WEVMOSContractTestnet, types.WEVMOSContractMainnet}, []string{})
+				return types.NewParams(true, []string{types.WEVMOSContractTestnet, types.WEVMOSContractMainnet}, []string{}, false)
 			},
 			false,
 			"",
@@ -62,7 +66,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			"unsorted address",
 			// order of creation shouldn't matter since it should be sorted when defining new param
 			func() types.Params {
-				return types.NewParams(true, []string{types.WEVMOSContractMainnet, types.WEVMOSContractTestnet}, []string{})
+				return types.NewParams(true, []string{types.WEVMOSContractMainnet, types.WEVMOSContractTestnet}, []string{}, false)
 			},
 			false,
 			"",
@@ -76,7 +80,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{
 			"invalid address - native precompile",
 			func() types.Params {
-				return types.NewParams(true, []string{"qq"}, []string{})
+				return types.NewParams(true, []string{"qq"}, []string{}, false)
 			},
 			true,
 			"invalid precompile",
@@ -84,7 +88,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{
 			"invalid address - dynamic precompile",
 			func() types.Params {
-				return types.NewParams(true, []string{}, []string{"0xqq"})
+				return types.NewParams(true, []string{}, []string{"0xqq"}, false)
 			},
 			true,
 			"invalid precompile",
@@ -92,7 +96,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{
 			"repeated address in different params",
 			func() types.Params {
-				return types.NewParams(true, []string{types.WEVMOSContractMainnet}, []string{types.WEVMOSContractMainnet})
+				return types.NewParams(true, []string{types.WEVMOSContractMainnet}, []string{types.WEVMOSContractMainnet}, false)
 			},
 			true,
 			"duplicate precompile",
@@ -100,7 +104,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{
 			"repeated address - native precompiles",
 			func() types.Params {
-				return types.NewParams(true, []string{types.WEVMOSContractMainnet, types.WEVMOSContractMainnet}, []string{})
+				return types.NewParams(true, []string{types.WEVMOSContractMainnet, types.WEVMOSContractMainnet}, []string{}, false)
 			},
 			true,
 			"duplicate precompile",
@@ -108,7 +112,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{
 			"repeated address - dynamic precompiles",
 			func() types.Params {
-				return types.NewParams(true, []string{}, []string{types.WEVMOSContractMainnet, types.WEVMOSContractMainnet})
+				return types.NewParams(true, []string{}, []string{types.WEVMOSContractMainnet, types.WEVMOSContractMainnet}, false)
 			},
 			true,
 			"duplicate precompile",
@@ -116,7 +120,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{
 			"repeated address - one EIP-55 other not",
 			func() types.Params {
-				return types.NewParams(true, []string{}, []string{"0xcc491f589b45d4a3c679016195b3fb87d7848210", "0xcc491f589B45d4a3C679016195B3FB87D7848210"})
+				return types.NewParams(true, []string{}, []string{"0xcc491f589b45d4a3c679016195b3fb87d7848210", "0xcc491f589B45d4a3C679016195B3FB87D7848210"}, false)
 			},
 			true,
 			"duplicate precompile",
@@ -161,14 +165,14 @@ func (suite *ParamsTestSuite) TestIsNativePrecompile() {
 		},
 		{
 			"not native precompile",
-			func() types.Params { return types.NewParams(true, nil, nil) },
+			func() types.Params { return types.NewParams(true, nil, nil, false) },
 			common.HexToAddress(types.WEVMOSContractMainnet),
 			false,
 		},
 		{
 			"EIP-55 address - is native precompile",
 			func() types.Params {
-				return types.NewParams(true, []string{"0xcc491f589B45d4a3C679016195B3FB87D7848210"}, nil)
+				return types.NewParams(true, []string{"0xcc491f589B45d4a3C679016195B3FB87D7848210"}, nil, false)
 			},
 			common.HexToAddress(types.WEVMOSContractTestnet),
 			true,
@@ -176,7 +180,7 @@ func (suite *ParamsTestSuite) TestIsNativePrecompile() {
 		{
 			"NOT EIP-55 address - is native precompile",
 			func() types.Params {
-				return types.NewParams(true, []string{"0xcc491f589b45d4a3c679016195b3fb87d7848210"}, nil)
+				return types.NewParams(true, []string{"0xcc491f589b45d4a3c679016195b3fb87d7848210"}, nil, false)
 			},
 			common.HexToAddress(types.WEVMOSContractTestnet),
 			true,
@@ -204,14 +208,14 @@ func (suite *ParamsTestSuite) TestIsDynamicPrecompile() {
 		},
 		{
 			"no dynamic precompiles",
-			func() types.Params { return types.NewParams(true, nil, nil) },
+			func() types.Params { return types.NewParams(true, nil, nil, false) },
 			common.HexToAddress(types.WEVMOSContractMainnet),
 			false,
 		},
 		{
 			"EIP-55 address - is dynamic precompile",
 			func() types.Params {
-				return types.NewParams(true, nil, []string{"0xcc491f589B45d4a3C679016195B3FB87D7848210"})
+				return types.NewParams(true, nil, []string{"0xcc491f589B45d4a3C679016195B3FB87D7848210"}, false)
 			},
 			common.HexToAddress(types.WEVMOSContractTestnet),
 			true,
@@ -219,7 +223,7 @@ func (suite *ParamsTestSuite) TestIsDynamicPrecompile() {
 		{
 			"NOT EIP-55 address - is dynamic precompile",
 			func() types.Params {
-				return types.NewParams(true, nil, []string{"0xcc491f589b45d4a3c679016195b3fb87d7848210"})
+				return types.NewParams(true, nil, []string{"0xcc491f589b45d4a3c679016195b3fb87d7848210"}, false)
 			},
 			common.HexToAddress(types.WEVMOSContractTestnet),
 			true,
@@ -28,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountCmd(),
 		GetParamsCmd(),
 		GetConfigCmd(),
+		GetCustomEIPsCmd(),
 	)
 	return cmd
 }
@@ -201,3 +202,31 @@ func GetConfigCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCustomEIPsCmd queries the active custom EIPs and their gas table
+func GetCustomEIPsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "custom-eips",
+		Short: "Get the active custom EIPs",
+		Long:  "Get the custom EIPs activated in the evm params and the resulting gas of the opcodes they modify.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CustomEIPs(cmd.Context(), &types.QueryCustomEIPsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
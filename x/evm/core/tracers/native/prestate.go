@@ -17,6 +17,7 @@
 package native
 
 import (
+	"bytes"
 	"encoding/json"
 	"math/big"
 	"sync/atomic"
@@ -46,23 +47,52 @@ type (
 type prestateTracer struct {
 	env       *vm.EVM
 	prestate  prestate
+	post      prestate
 	create    bool
+	from      common.Address
 	to        common.Address
 	gasLimit  uint64 // Amount of gas bought for the whole tx
+	config    prestateTracerConfig
 	interrupt uint32 // Atomic flag to signal execution interruption
 	reason    error  // Textual reason for the interruption
+	created   map[common.Address]bool
+	deleted   map[common.Address]bool
 }
 
-func newPrestateTracer(ctx *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
-	// First callframe contains tx context info
-	// and is populated on start and end.
-	return &prestateTracer{prestate: prestate{}}, nil
+type prestateTracerConfig struct {
+	DiffMode bool `json:"diffMode"` // If true, this tracer will return state modifications
+}
+
+// diffAccount is the account representation used on the post state of the
+// diff mode, where only the modified fields are set.
+type diffAccount struct {
+	Balance string                      `json:"balance,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Code    string                      `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+func newPrestateTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config prestateTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	return &prestateTracer{
+		prestate: prestate{},
+		post:     prestate{},
+		config:   config,
+		created:  make(map[common.Address]bool),
+		deleted:  make(map[common.Address]bool),
+	}, nil
 }
 
 // CaptureStart implements the EVMLogger interface to initialize the tracing operation.
 func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
 	t.env = env
 	t.create = create
+	t.from = from
 	t.to = to
 
 	t.lookupAccount(from)
@@ -81,10 +111,17 @@ func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to commo
 	fromBal.Add(fromBal, new(big.Int).Add(value, consumedGas))
 	t.prestate[from].Balance = hexutil.EncodeBig(fromBal)
 	t.prestate[from].Nonce--
+
+	if create && t.config.DiffMode {
+		t.created[to] = true
+	}
 }
 
 // CaptureEnd is called after the call finishes to finalize the tracing.
 func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	if t.config.DiffMode {
+		return
+	}
 	if t.create {
 		// Exclude created contract.
 		delete(t.prestate, t.to)
@@ -103,20 +140,27 @@ func (t *prestateTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64,
 	case stackLen >= 1 && (op == vm.EXTCODECOPY || op == vm.EXTCODEHASH || op == vm.EXTCODESIZE || op == vm.BALANCE || op == vm.SELFDESTRUCT):
 		addr := common.Address(stackData[stackLen-1].Bytes20())
 		t.lookupAccount(addr)
+		if op == vm.SELFDESTRUCT {
+			t.deleted[scope.Contract.Address()] = true
+		}
 	case stackLen >= 5 && (op == vm.DELEGATECALL || op == vm.CALL || op == vm.STATICCALL || op == vm.CALLCODE):
 		addr := common.Address(stackData[stackLen-2].Bytes20())
 		t.lookupAccount(addr)
 	case op == vm.CREATE:
 		addr := scope.Contract.Address()
 		nonce := t.env.StateDB.GetNonce(addr)
-		t.lookupAccount(crypto.CreateAddress(addr, nonce))
+		created := crypto.CreateAddress(addr, nonce)
+		t.lookupAccount(created)
+		t.created[created] = true
 	case stackLen >= 4 && op == vm.CREATE2:
 		offset := stackData[stackLen-2]
 		size := stackData[stackLen-3]
 		init := scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
 		inithash := crypto.Keccak256(init)
 		salt := stackData[stackLen-4]
-		t.lookupAccount(crypto.CreateAddress2(scope.Contract.Address(), salt.Bytes32(), inithash))
+		created := crypto.CreateAddress2(scope.Contract.Address(), salt.Bytes32(), inithash)
+		t.lookupAccount(created)
+		t.created[created] = true
 	}
 }
 
@@ -137,12 +181,28 @@ func (t *prestateTracer) CaptureTxStart(gasLimit uint64) {
 	t.gasLimit = gasLimit
 }
 
-func (t *prestateTracer) CaptureTxEnd(restGas uint64) {}
+func (t *prestateTracer) CaptureTxEnd(restGas uint64) {
+	if !t.config.DiffMode || t.env == nil {
+		return
+	}
+	t.processDiffState(restGas)
+}
 
 // GetResult returns the json-encoded nested list of call traces, and any
 // error arising from the encoding or forceful termination (via `Stop`).
 func (t *prestateTracer) GetResult() (json.RawMessage, error) {
-	res, err := json.Marshal(t.prestate)
+	var (
+		res []byte
+		err error
+	)
+	if t.config.DiffMode {
+		res, err = json.Marshal(struct {
+			Post map[common.Address]*diffAccount `json:"post"`
+			Pre  prestate                        `json:"pre"`
+		}{t.diffPost(), t.prestate})
+	} else {
+		res, err = json.Marshal(t.prestate)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +215,87 @@ func (t *prestateTracer) Stop(err error) {
 	atomic.StoreUint32(&t.interrupt, 1)
 }
 
+// processDiffState computes the post state of the touched accounts, keeping
+// only the fields that were modified by the transaction. Accounts that were not
+// modified are removed from the prestate, as are the accounts created by the
+// transaction.
+func (t *prestateTracer) processDiffState(restGas uint64) {
+	for addr, state := range t.prestate {
+		// The deleted account's state is pruned from `post` but kept in `pre`
+		if t.deleted[addr] {
+			continue
+		}
+		modified := false
+		postAccount := &account{Storage: make(map[common.Hash]common.Hash)}
+
+		newBalance := t.env.StateDB.GetBalance(addr)
+		if addr == t.from {
+			// The unused gas is refunded to the sender after the execution.
+			refund := new(big.Int).Mul(t.env.TxContext.GasPrice, new(big.Int).SetUint64(restGas))
+			newBalance = new(big.Int).Add(newBalance, refund)
+		}
+		if balance := bigToHex(newBalance); balance != state.Balance {
+			modified = true
+			postAccount.Balance = balance
+		}
+		if newNonce := t.env.StateDB.GetNonce(addr); newNonce != state.Nonce {
+			modified = true
+			postAccount.Nonce = newNonce
+		}
+		if newCode := t.env.StateDB.GetCode(addr); !bytes.Equal(newCode, common.FromHex(state.Code)) {
+			modified = true
+			postAccount.Code = bytesToHex(newCode)
+		}
+
+		for key, val := range state.Storage {
+			newVal := t.env.StateDB.GetState(addr, key)
+			if val == newVal {
+				// Omit unchanged slots
+				delete(state.Storage, key)
+				continue
+			}
+			modified = true
+			if newVal != (common.Hash{}) {
+				postAccount.Storage[key] = newVal
+			}
+		}
+
+		if modified {
+			t.post[addr] = postAccount
+		} else {
+			// if state is not modified, then no need to include into the pre state
+			delete(t.prestate, addr)
+		}
+	}
+
+	// the new created contracts' prestate were empty, so delete them
+	for addr := range t.created {
+		if state, ok := t.prestate[addr]; ok && state.isEmpty() {
+			delete(t.prestate, addr)
+		}
+	}
+}
+
+// diffPost returns the post state of the diff mode omitting the fields that
+// were not modified.
+func (t *prestateTracer) diffPost() map[common.Address]*diffAccount {
+	post := make(map[common.Address]*diffAccount, len(t.post))
+	for addr, state := range t.post {
+		post[addr] = &diffAccount{
+			Balance: state.Balance,
+			Nonce:   state.Nonce,
+			Code:    state.Code,
+			Storage: state.Storage,
+		}
+	}
+	return post
+}
+
+// isEmpty returns true if the account has no balance, nonce nor code.
+func (a *account) isEmpty() bool {
+	return (a.Balance == "" || a.Balance == "0x0") && a.Nonce == 0 && (a.Code == "" || a.Code == "0x")
+}
+
 // lookupAccount fetches details of an account and adds it to the prestate
 // if it doesn't exist there.
 func (t *prestateTracer) lookupAccount(addr common.Address) {
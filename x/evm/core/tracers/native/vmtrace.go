@@ -0,0 +1,280 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/evmos/v20/x/evm/core/tracers"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/holiman/uint256"
+)
+
+func init() {
+	register("vmTracer", newVMTracer)
+}
+
+// vmTrace is the Parity-style trace of the code executed on a single call
+// frame.
+type vmTrace struct {
+	Code hexutil.Bytes  `json:"code"`
+	Ops  []*vmOperation `json:"ops"`
+}
+
+// vmOperation is a single step of the execution. Ex is nil when the operation
+// failed and Sub holds the trace of the call frame created by the operation,
+// if any.
+type vmOperation struct {
+	Cost uint64               `json:"cost"`
+	Ex   *vmExecutedOperation `json:"ex"`
+	Pc   uint64               `json:"pc"`
+	Sub  *vmTrace             `json:"sub"`
+}
+
+// vmExecutedOperation holds the side effects of a successful operation.
+type vmExecutedOperation struct {
+	Used  uint64         `json:"used"`
+	Push  []string       `json:"push"`
+	Mem   *vmMemoryDiff  `json:"mem"`
+	Store *vmStorageDiff `json:"store"`
+}
+
+type vmMemoryDiff struct {
+	Off  uint64        `json:"off"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+type vmStorageDiff struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
+
+// vmFrame keeps track of the operation of a call frame waiting for the
+// results of its execution, which are only known on the next step.
+type vmFrame struct {
+	trace   *vmTrace
+	pending *vmOperation
+	op      vm.OpCode
+	memOff  uint64
+	memSize uint64
+}
+
+// vmTracer is a native go tracer which records the executed operations on the
+// Parity vmTrace format, and implements vm.EVMLogger.
+type vmTracer struct {
+	env            *vm.EVM
+	frames         []*vmFrame
+	root           *vmTrace
+	selfdestructed bool
+	interrupt      uint32 // Atomic flag to signal execution interruption
+	reason         error  // Textual reason for the interruption
+}
+
+func newVMTracer(ctx *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	return &vmTracer{}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *vmTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	code := input
+	if !create {
+		code = env.StateDB.GetCode(to)
+	}
+	t.root = &vmTrace{Code: code, Ops: []*vmOperation{}}
+	t.frames = []*vmFrame{{trace: t.root}}
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *vmTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	if len(t.frames) > 0 {
+		t.frames[0].finish(nil)
+	}
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *vmTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	if frame.pending != nil {
+		frame.pending.Ex.Used = gas
+	}
+	frame.finish(scope)
+
+	operation := &vmOperation{Cost: cost, Pc: pc}
+	frame.trace.Ops = append(frame.trace.Ops, operation)
+	if err != nil {
+		// The operation failed before being executed
+		return
+	}
+
+	operation.Ex = &vmExecutedOperation{Used: gas - cost, Push: []string{}}
+	frame.pending = operation
+	frame.op = op
+	frame.memOff, frame.memSize = memoryWritten(op, scope.Stack.Data)
+
+	stack := scope.Stack.Data
+	if op == vm.SSTORE && len(stack) >= 2 {
+		operation.Ex.Store = &vmStorageDiff{
+			Key: stack[len(stack)-1].Hex(),
+			Val: stack[len(stack)-2].Hex(),
+		}
+	}
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *vmTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, _ *vm.ScopeContext, depth int, err error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	if frame.pending != nil {
+		frame.pending.Ex = nil
+		frame.pending = nil
+	}
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *vmTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if typ == vm.SELFDESTRUCT {
+		t.selfdestructed = true
+		return
+	}
+	if len(t.frames) == 0 {
+		return
+	}
+
+	code := input
+	if typ != vm.CREATE && typ != vm.CREATE2 {
+		code = t.env.StateDB.GetCode(to)
+	}
+	sub := &vmTrace{Code: code, Ops: []*vmOperation{}}
+	if parent := t.frames[len(t.frames)-1]; parent.pending != nil {
+		parent.pending.Sub = sub
+	}
+	t.frames = append(t.frames, &vmFrame{trace: sub})
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *vmTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if t.selfdestructed {
+		t.selfdestructed = false
+		return
+	}
+	if len(t.frames) <= 1 {
+		return
+	}
+	t.frames[len(t.frames)-1].finish(nil)
+	t.frames = t.frames[:len(t.frames)-1]
+}
+
+func (*vmTracer) CaptureTxStart(gasLimit uint64) {}
+
+func (*vmTracer) CaptureTxEnd(restGas uint64) {}
+
+// GetResult returns the json-encoded vm trace, and any error arising from the
+// encoding or forceful termination (via `Stop`).
+func (t *vmTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.root)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *vmTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// finish fills the results of the pending operation of the frame with the
+// stack and memory after its execution. The scope is nil when the frame
+// finished its execution.
+func (f *vmFrame) finish(scope *vm.ScopeContext) {
+	operation := f.pending
+	if operation == nil {
+		return
+	}
+	f.pending = nil
+	if scope == nil {
+		return
+	}
+
+	stack := scope.Stack.Data
+	if n := stackPushed(f.op); n > 0 && n <= len(stack) {
+		for _, item := range stack[len(stack)-n:] {
+			operation.Ex.Push = append(operation.Ex.Push, item.Hex())
+		}
+	}
+	memLen := uint64(scope.Memory.Len()) // #nosec G115 -- length is non-negative
+	if f.memSize > 0 && f.memOff <= memLen && f.memSize <= memLen-f.memOff {
+		operation.Ex.Mem = &vmMemoryDiff{
+			Off:  f.memOff,
+			Data: scope.Memory.GetCopy(int64(f.memOff), int64(f.memSize)), // #nosec G115 -- bounded by the memory length
+		}
+	}
+}
+
+// stackPushed returns the number of stack items reported as pushed by the
+// given operation, following the Parity convention for DUP and SWAP.
+func stackPushed(op vm.OpCode) int {
+	switch {
+	case op >= vm.DUP1 && op <= vm.DUP16:
+		return int(op-vm.DUP1) + 2
+	case op >= vm.SWAP1 && op <= vm.SWAP16:
+		return int(op-vm.SWAP1) + 2
+	case op >= vm.LOG0 && op <= vm.LOG4:
+		return 0
+	}
+
+	switch op {
+	case vm.STOP, vm.POP, vm.MSTORE, vm.MSTORE8, vm.SSTORE, vm.TSTORE, vm.JUMP, vm.JUMPI, vm.JUMPDEST,
+		vm.RETURN, vm.REVERT, vm.SELFDESTRUCT, vm.INVALID,
+		vm.CALLDATACOPY, vm.CODECOPY, vm.EXTCODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// memoryWritten returns the offset and size of the memory region written by
+// the given operation, using the stack before its execution.
+func memoryWritten(op vm.OpCode, stack []uint256.Int) (uint64, uint64) {
+	peek := func(n int) uint64 {
+		if len(stack) < n {
+			return 0
+		}
+		return stack[len(stack)-n].Uint64()
+	}
+
+	switch op {
+	case vm.MSTORE:
+		return peek(1), 32
+	case vm.MSTORE8:
+		return peek(1), 1
+	case vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		return peek(1), peek(3)
+	case vm.EXTCODECOPY:
+		return peek(2), peek(4)
+	case vm.CALL, vm.CALLCODE:
+		return peek(6), peek(7)
+	case vm.DELEGATECALL, vm.STATICCALL:
+		return peek(5), peek(6)
+	default:
+		return 0, 0
+	}
+}
@@ -96,13 +96,12 @@ var PrecompiledContractsBerlin = map[common.Address]PrecompiledContract{
 }
 
 // PrecompiledContractsBLS contains the set of pre-compiled Ethereum
-// contracts specified in EIP-2537. These are exported for testing purposes.
+// contracts specified in EIP-2537. They are not part of any default set and
+// are activated as static precompiles through the EVM module parameters.
 var PrecompiledContractsBLS = map[common.Address]PrecompiledContract{
 	bls12381G1Add{}.Address():      &bls12381G1Add{},
-	bls12381G1Mul{}.Address():      &bls12381G1Mul{},
 	bls12381G1MultiExp{}.Address(): &bls12381G1MultiExp{},
 	bls12381G2Add{}.Address():      &bls12381G2Add{},
-	bls12381G2Mul{}.Address():      &bls12381G2Mul{},
 	bls12381G2MultiExp{}.Address(): &bls12381G2MultiExp{},
 	bls12381Pairing{}.Address():    &bls12381Pairing{},
 	bls12381MapG1{}.Address():      &bls12381MapG1{},
@@ -833,25 +832,59 @@ func (c *blake2F) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, erro
 	return output, nil
 }
 
+// Gas costs of the EIP-2537 precompiles. They differ from the go-ethereum
+// params, which predate the final version of the EIP.
+const (
+	bls12381G1AddGas          uint64 = 375   // Price for BLS12-381 elliptic curve G1 point addition
+	bls12381G1MulGas          uint64 = 12000 // Price for BLS12-381 elliptic curve G1 point scalar multiplication
+	bls12381G2AddGas          uint64 = 600   // Price for BLS12-381 elliptic curve G2 point addition
+	bls12381G2MulGas          uint64 = 22500 // Price for BLS12-381 elliptic curve G2 point scalar multiplication
+	bls12381PairingBaseGas    uint64 = 37700 // Base gas price for BLS12-381 elliptic curve pairing check
+	bls12381PairingPerPairGas uint64 = 32600 // Per-point pair gas price for BLS12-381 elliptic curve pairing check
+	bls12381MapG1Gas          uint64 = 5500  // Gas price for BLS12-381 mapping field element to G1 operation
+	bls12381MapG2Gas          uint64 = 23800 // Gas price for BLS12-381 mapping field element to G2 operation
+)
+
+// bls12381G1MultiExpDiscountTable is the gas discount table for BLS12-381 G1 multi exponentiation operation
+var bls12381G1MultiExpDiscountTable = [128]uint64{1000, 949, 848, 797, 764, 750, 738, 728, 719, 712, 705, 698, 692, 687, 682, 677, 673, 669, 665, 661, 658, 654, 651, 648, 645, 642, 640, 637, 635, 632, 630, 627, 625, 623, 621, 619, 617, 615, 613, 611, 609, 608, 606, 604, 603, 601, 599, 598, 596, 595, 593, 592, 591, 589, 588, 586, 585, 584, 582, 581, 580, 579, 577, 576, 575, 574, 573, 572, 570, 569, 568, 567, 566, 565, 564, 563, 562, 561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 551, 550, 549, 548, 547, 547, 546, 545, 544, 543, 542, 541, 540, 540, 539, 538, 537, 536, 536, 535, 534, 533, 532, 532, 531, 530, 529, 528, 528, 527, 526, 525, 525, 524, 523, 522, 522, 521, 520, 520, 519}
+
+// bls12381G2MultiExpDiscountTable is the gas discount table for BLS12-381 G2 multi exponentiation operation
+var bls12381G2MultiExpDiscountTable = [128]uint64{1000, 1000, 923, 884, 855, 832, 812, 796, 782, 770, 759, 749, 740, 732, 724, 717, 711, 704, 699, 693, 688, 683, 679, 674, 670, 666, 663, 659, 655, 652, 649, 646, 643, 640, 637, 634, 632, 629, 627, 624, 622, 620, 618, 615, 613, 611, 609, 607, 606, 604, 602, 600, 598, 597, 595, 593, 592, 590, 589, 587, 586, 584, 583, 582, 580, 579, 578, 576, 575, 574, 573, 571, 570, 569, 568, 567, 566, 565, 563, 562, 561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 552, 551, 550, 549, 548, 547, 546, 545, 545, 544, 543, 542, 541, 541, 540, 539, 538, 537, 537, 536, 535, 535, 534, 533, 532, 532, 531, 530, 530, 529, 528, 528, 527, 526, 526, 525, 524, 524}
+
 var (
 	errBLS12381InvalidInputLength          = errors.New("invalid input length")
 	errBLS12381InvalidFieldElementTopBytes = errors.New("invalid field element top bytes")
 	errBLS12381G1PointSubgroup             = errors.New("g1 point is not on correct subgroup")
 	errBLS12381G2PointSubgroup             = errors.New("g2 point is not on correct subgroup")
+	errBLS12381InvalidFieldElement         = errors.New("invalid fp.Element encoding")
+	errBLS12381PointNotOnCurve             = errors.New("invalid point: not on curve")
 )
 
+// bls12381Error returns the EIP-2537 error for the given error of the
+// BLS12-381 library, so that failures match the reference test vectors.
+func bls12381Error(err error) error {
+	switch err.Error() {
+	case "must be less than modulus":
+		return errBLS12381InvalidFieldElement
+	case "point is not on curve":
+		return errBLS12381PointNotOnCurve
+	default:
+		return err
+	}
+}
+
 // bls12381G1Add implements EIP-2537 G1Add precompile.
 type bls12381G1Add struct{}
 
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381G1Add) Address() common.Address {
-	return common.BytesToAddress([]byte{10})
+	return common.BytesToAddress([]byte{0x0b})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
 func (c *bls12381G1Add) RequiredGas(input []byte) uint64 {
-	return params.Bls12381G1AddGas
+	return bls12381G1AddGas
 }
 
 func (c *bls12381G1Add) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -869,11 +902,11 @@ func (c *bls12381G1Add) Run(evm *EVM, contract *Contract, readonly bool) ([]byte
 
 	// Decode G1 point p_0
 	if p0, err = g.DecodePoint(contract.Input[:128]); err != nil {
-		return nil, err
+		return nil, bls12381Error(err)
 	}
 	// Decode G1 point p_1
 	if p1, err = g.DecodePoint(contract.Input[128:]); err != nil {
-		return nil, err
+		return nil, bls12381Error(err)
 	}
 
 	// Compute r = p_0 + p_1
@@ -884,55 +917,13 @@ func (c *bls12381G1Add) Run(evm *EVM, contract *Contract, readonly bool) ([]byte
 	return g.EncodePoint(r), nil
 }
 
-// bls12381G1Mul implements EIP-2537 G1Mul precompile.
-type bls12381G1Mul struct{}
-
-// Address defines the precompiled contract address. This MUST match the address
-// set in the precompiled contract map.
-func (bls12381G1Mul) Address() common.Address {
-	return common.BytesToAddress([]byte{11})
-}
-
-// RequiredGas returns the gas required to execute the pre-compiled contract.
-func (c *bls12381G1Mul) RequiredGas(input []byte) uint64 {
-	return params.Bls12381G1MulGas
-}
-
-func (c *bls12381G1Mul) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
-	// Implements EIP-2537 G1Mul precompile.
-	// > G1 multiplication call expects `160` bytes as an input that is interpreted as byte concatenation of encoding of G1 point (`128` bytes) and encoding of a scalar value (`32` bytes).
-	// > Output is an encoding of multiplication operation result - single G1 point (`128` bytes).
-	if len(contract.Input) != 160 {
-		return nil, errBLS12381InvalidInputLength
-	}
-	var err error
-	var p0 *bls12381.PointG1
-
-	// Initialize G1
-	g := bls12381.NewG1()
-
-	// Decode G1 point
-	if p0, err = g.DecodePoint(contract.Input[:128]); err != nil {
-		return nil, err
-	}
-	// Decode scalar value
-	e := new(big.Int).SetBytes(contract.Input[128:])
-
-	// Compute r = e * p_0
-	r := g.New()
-	g.MulScalar(r, p0, e)
-
-	// Encode the G1 point into 128 bytes
-	return g.EncodePoint(r), nil
-}
-
 // bls12381G1MultiExp implements EIP-2537 G1MultiExp precompile.
 type bls12381G1MultiExp struct{}
 
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381G1MultiExp) Address() common.Address {
-	return common.BytesToAddress([]byte{12})
+	return common.BytesToAddress([]byte{0x0c})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
@@ -945,13 +936,13 @@ func (c *bls12381G1MultiExp) RequiredGas(input []byte) uint64 {
 	}
 	// Lookup discount value for G1 point, scalar value pair length
 	var discount uint64
-	if dLen := len(params.Bls12381MultiExpDiscountTable); k < dLen {
-		discount = params.Bls12381MultiExpDiscountTable[k-1]
+	if dLen := len(bls12381G1MultiExpDiscountTable); k <= dLen {
+		discount = bls12381G1MultiExpDiscountTable[k-1]
 	} else {
-		discount = params.Bls12381MultiExpDiscountTable[dLen-1]
+		discount = bls12381G1MultiExpDiscountTable[dLen-1]
 	}
 	// Calculate gas and return the result
-	return (uint64(k) * params.Bls12381G1MulGas * discount) / 1000
+	return (uint64(k) * bls12381G1MulGas * discount) / 1000
 }
 
 func (c *bls12381G1MultiExp) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -975,7 +966,12 @@ func (c *bls12381G1MultiExp) Run(evm *EVM, contract *Contract, readonly bool) ([
 		t0, t1, t2 := off, off+128, off+160
 		// Decode G1 point
 		if points[i], err = g.DecodePoint(contract.Input[t0:t1]); err != nil {
-			return nil, err
+			return nil, bls12381Error(err)
+		}
+		// 'point is on curve' check already done,
+		// Here we need to apply subgroup checks.
+		if !g.InCorrectSubgroup(points[i]) {
+			return nil, errBLS12381G1PointSubgroup
 		}
 		// Decode scalar value
 		scalars[i] = new(big.Int).SetBytes(contract.Input[t1:t2])
@@ -995,12 +991,12 @@ type bls12381G2Add struct{}
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381G2Add) Address() common.Address {
-	return common.BytesToAddress([]byte{13})
+	return common.BytesToAddress([]byte{0x0d})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
 func (c *bls12381G2Add) RequiredGas(input []byte) uint64 {
-	return params.Bls12381G2AddGas
+	return bls12381G2AddGas
 }
 
 func (c *bls12381G2Add) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -1019,11 +1015,11 @@ func (c *bls12381G2Add) Run(evm *EVM, contract *Contract, readonly bool) ([]byte
 
 	// Decode G2 point p_0
 	if p0, err = g.DecodePoint(contract.Input[:256]); err != nil {
-		return nil, err
+		return nil, bls12381Error(err)
 	}
 	// Decode G2 point p_1
 	if p1, err = g.DecodePoint(contract.Input[256:]); err != nil {
-		return nil, err
+		return nil, bls12381Error(err)
 	}
 
 	// Compute r = p_0 + p_1
@@ -1033,55 +1029,13 @@ func (c *bls12381G2Add) Run(evm *EVM, contract *Contract, readonly bool) ([]byte
 	return g.EncodePoint(r), nil
 }
 
-// bls12381G2Mul implements EIP-2537 G2Mul precompile.
-type bls12381G2Mul struct{}
-
-// Address defines the precompiled contract address. This MUST match the address
-// set in the precompiled contract map.
-func (bls12381G2Mul) Address() common.Address {
-	return common.BytesToAddress([]byte{14})
-}
-
-// RequiredGas returns the gas required to execute the pre-compiled contract.
-func (c *bls12381G2Mul) RequiredGas(input []byte) uint64 {
-	return params.Bls12381G2MulGas
-}
-
-func (c *bls12381G2Mul) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
-	// Implements EIP-2537 G2MUL precompile logic.
-	// > G2 multiplication call expects `288` bytes as an input that is interpreted as byte concatenation of encoding of G2 point (`256` bytes) and encoding of a scalar value (`32` bytes).
-	// > Output is an encoding of multiplication operation result - single G2 point (`256` bytes).
-	if len(contract.Input) != 288 {
-		return nil, errBLS12381InvalidInputLength
-	}
-	var err error
-	var p0 *bls12381.PointG2
-
-	// Initialize G2
-	g := bls12381.NewG2()
-
-	// Decode G2 point
-	if p0, err = g.DecodePoint(contract.Input[:256]); err != nil {
-		return nil, err
-	}
-	// Decode scalar value
-	e := new(big.Int).SetBytes(contract.Input[256:])
-
-	// Compute r = e * p_0
-	r := g.New()
-	g.MulScalar(r, p0, e)
-
-	// Encode the G2 point into 256 bytes
-	return g.EncodePoint(r), nil
-}
-
 // bls12381G2MultiExp implements EIP-2537 G2MultiExp precompile.
 type bls12381G2MultiExp struct{}
 
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381G2MultiExp) Address() common.Address {
-	return common.BytesToAddress([]byte{15})
+	return common.BytesToAddress([]byte{0x0e})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
@@ -1094,13 +1048,13 @@ func (c *bls12381G2MultiExp) RequiredGas(input []byte) uint64 {
 	}
 	// Lookup discount value for G2 point, scalar value pair length
 	var discount uint64
-	if dLen := len(params.Bls12381MultiExpDiscountTable); k < dLen {
-		discount = params.Bls12381MultiExpDiscountTable[k-1]
+	if dLen := len(bls12381G2MultiExpDiscountTable); k <= dLen {
+		discount = bls12381G2MultiExpDiscountTable[k-1]
 	} else {
-		discount = params.Bls12381MultiExpDiscountTable[dLen-1]
+		discount = bls12381G2MultiExpDiscountTable[dLen-1]
 	}
 	// Calculate gas and return the result
-	return (uint64(k) * params.Bls12381G2MulGas * discount) / 1000
+	return (uint64(k) * bls12381G2MulGas * discount) / 1000
 }
 
 func (c *bls12381G2MultiExp) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -1122,9 +1076,14 @@ func (c *bls12381G2MultiExp) Run(evm *EVM, contract *Contract, readonly bool) ([
 	for i := 0; i < k; i++ {
 		off := 288 * i
 		t0, t1, t2 := off, off+256, off+288
-		// Decode G1 point
+		// Decode G2 point
 		if points[i], err = g.DecodePoint(contract.Input[t0:t1]); err != nil {
-			return nil, err
+			return nil, bls12381Error(err)
+		}
+		// 'point is on curve' check already done,
+		// Here we need to apply subgroup checks.
+		if !g.InCorrectSubgroup(points[i]) {
+			return nil, errBLS12381G2PointSubgroup
 		}
 		// Decode scalar value
 		scalars[i] = new(big.Int).SetBytes(contract.Input[t1:t2])
@@ -1144,12 +1103,12 @@ type bls12381Pairing struct{}
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381Pairing) Address() common.Address {
-	return common.BytesToAddress([]byte{16})
+	return common.BytesToAddress([]byte{0x0f})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
 func (c *bls12381Pairing) RequiredGas(input []byte) uint64 {
-	return params.Bls12381PairingBaseGas + uint64(len(input)/384)*params.Bls12381PairingPerPairGas
+	return bls12381PairingBaseGas + uint64(len(input)/384)*bls12381PairingPerPairGas
 }
 
 func (c *bls12381Pairing) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -1176,12 +1135,12 @@ func (c *bls12381Pairing) Run(evm *EVM, contract *Contract, readonly bool) ([]by
 		// Decode G1 point
 		p1, err := g1.DecodePoint(contract.Input[t0:t1])
 		if err != nil {
-			return nil, err
+			return nil, bls12381Error(err)
 		}
 		// Decode G2 point
 		p2, err := g2.DecodePoint(contract.Input[t1:t2])
 		if err != nil {
-			return nil, err
+			return nil, bls12381Error(err)
 		}
 
 		// 'point is on curve' check already done,
@@ -1229,12 +1188,12 @@ type bls12381MapG1 struct{}
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381MapG1) Address() common.Address {
-	return common.BytesToAddress([]byte{17})
+	return common.BytesToAddress([]byte{0x10})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
 func (c *bls12381MapG1) RequiredGas(input []byte) uint64 {
-	return params.Bls12381MapG1Gas
+	return bls12381MapG1Gas
 }
 
 func (c *bls12381MapG1) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -1257,7 +1216,7 @@ func (c *bls12381MapG1) Run(evm *EVM, contract *Contract, readonly bool) ([]byte
 	// Compute mapping
 	r, err := g.MapToCurve(fe)
 	if err != nil {
-		return nil, err
+		return nil, bls12381Error(err)
 	}
 
 	// Encode the G1 point to 128 bytes
@@ -1270,12 +1229,12 @@ type bls12381MapG2 struct{}
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381MapG2) Address() common.Address {
-	return common.BytesToAddress([]byte{18})
+	return common.BytesToAddress([]byte{0x11})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
 func (c *bls12381MapG2) RequiredGas(input []byte) uint64 {
-	return params.Bls12381MapG2Gas
+	return bls12381MapG2Gas
 }
 
 func (c *bls12381MapG2) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -1305,7 +1264,7 @@ func (c *bls12381MapG2) Run(evm *EVM, contract *Contract, readonly bool) ([]byte
 	// Compute mapping
 	r, err := g.MapToCurve(fe)
 	if err != nil {
-		return nil, err
+		return nil, bls12381Error(err)
 	}
 
 	// Encode the G2 point to 256 bytes
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/math"
 	"golang.org/x/exp/maps"
 )
 
@@ -117,6 +118,33 @@ func (o *operation) SetDynamicGas(gf gasFunc) {
 	o.dynamicGas = gf
 }
 
+// HasDynamicGas returns true if the operation has a dynamic gas function.
+func (o *operation) HasDynamicGas() bool {
+	return o.dynamicGas != nil
+}
+
+// MultiplyDynamicGas wraps the dynamic gas function of the operation to
+// multiply the gas it returns by the given multiplier. It is a no-op for the
+// operations without dynamic gas.
+func (o *operation) MultiplyDynamicGas(multiplier uint64) {
+	if o.dynamicGas == nil {
+		return
+	}
+
+	dynamicGas := o.dynamicGas
+	o.dynamicGas = func(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+		gas, err := dynamicGas(evm, contract, stack, mem, memorySize)
+		if err != nil {
+			return 0, err
+		}
+		gas, overflow := math.SafeMul(gas, multiplier)
+		if overflow {
+			return 0, ErrGasUintOverflow
+		}
+		return gas, nil
+	}
+}
+
 // SetMinStack sets the minimum stack size required for the operation.
 func (o *operation) SetMinStack(minStack int) {
 	o.minStack = minStack
@@ -0,0 +1,56 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vm
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DelegationPrefix is the prefix of the EIP-7702 delegation designator, set as
+// the code of the accounts that delegate their code execution.
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// ParseDelegation tries to parse the address from a delegation designator.
+func ParseDelegation(b []byte) (common.Address, bool) {
+	if len(b) != len(DelegationPrefix)+common.AddressLength || !bytes.HasPrefix(b, DelegationPrefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(b[len(DelegationPrefix):]), true
+}
+
+// AddressToDelegation adds the delegation prefix to the given address to
+// build a delegation designator.
+func AddressToDelegation(addr common.Address) []byte {
+	return append(common.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
+
+// delegationTarget returns the target of the delegation designator of the
+// provided account. Delegations are only followed once Prague is enabled.
+func (evm *EVM) delegationTarget(addr common.Address) (common.Address, bool) {
+	if !evm.Config.Prague {
+		return common.Address{}, false
+	}
+	return ParseDelegation(evm.StateDB.GetCode(addr))
+}
+
+// resolveCode returns the code associated with the provided account,
+// following the delegation designator if the account has one. Only one
+// level of delegation is followed.
+func (evm *EVM) resolveCode(addr common.Address) []byte {
+	if target, ok := evm.delegationTarget(addr); ok {
+		return evm.StateDB.GetCode(target)
+	}
+	return evm.StateDB.GetCode(addr)
+}
+
+// resolveCodeHash returns the code hash associated with the provided account,
+// following the delegation designator if the account has one.
+func (evm *EVM) resolveCodeHash(addr common.Address) common.Hash {
+	if target, ok := evm.delegationTarget(addr); ok {
+		return evm.StateDB.GetCodeHash(target)
+	}
+	return evm.StateDB.GetCodeHash(addr)
+}
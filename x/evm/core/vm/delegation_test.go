@@ -0,0 +1,121 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vm
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestResolveCode(t *testing.T) {
+	var (
+		target    = common.BytesToAddress([]byte("target"))
+		delegated = common.BytesToAddress([]byte("delegated"))
+		chained   = common.BytesToAddress([]byte("chained"))
+		code      = []byte{byte(PUSH1), 0x01, byte(STOP)}
+	)
+
+	statedb := newTestStateDB()
+	statedb.SetCode(target, code)
+	statedb.SetCode(delegated, AddressToDelegation(target))
+	statedb.SetCode(chained, AddressToDelegation(delegated))
+
+	tests := []struct {
+		name     string
+		prague   bool
+		addr     common.Address
+		wantCode []byte
+	}{
+		{"account without delegation", true, target, code},
+		{"delegated account", true, delegated, code},
+		// only one level of delegation is followed
+		{"delegation to a delegated account", true, chained, AddressToDelegation(target)},
+		// delegations are not followed before Prague
+		{"delegated account before prague", false, delegated, AddressToDelegation(target)},
+	}
+
+	for _, tt := range tests {
+		evm := NewEVM(BlockContext{}, TxContext{}, statedb, params.AllEthashProtocolChanges, Config{Prague: tt.prague})
+		if got := evm.resolveCode(tt.addr); !bytes.Equal(got, tt.wantCode) {
+			t.Errorf("%s: code mismatch: got %x, want %x", tt.name, got, tt.wantCode)
+		}
+		if got, want := evm.resolveCodeHash(tt.addr), crypto.Keccak256Hash(tt.wantCode); got != want {
+			t.Errorf("%s: code hash mismatch: got %x, want %x", tt.name, got, want)
+		}
+	}
+}
+
+func TestDelegationCallGas(t *testing.T) {
+	var (
+		caller    = common.BytesToAddress([]byte("caller"))
+		callee    = common.BytesToAddress([]byte("callee"))
+		target    = common.BytesToAddress([]byte("target"))
+		gasLimit  = uint64(100_000)
+		coldExtra = params.ColdAccountAccessCostEIP2929
+		warmExtra = params.WarmStorageReadCostEIP2929
+	)
+
+	// CALL(0, callee, 0, 0, 0, 0, 0)
+	code := []byte{
+		byte(PUSH1), 0, byte(PUSH1), 0, byte(PUSH1), 0, byte(PUSH1), 0, byte(PUSH1), 0,
+		byte(PUSH20),
+	}
+	code = append(code, callee.Bytes()...)
+	code = append(code, byte(PUSH1), 0, byte(CALL), byte(STOP))
+
+	vmctx := BlockContext{
+		BlockNumber: new(big.Int),
+		CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+	}
+
+	// gasUsed calls the caller contract and returns the gas used, along with
+	// the state after the call
+	gasUsed := func(delegate, warmTarget, prague bool) (uint64, *testStateDB) {
+		statedb := newTestStateDB()
+		statedb.SetCode(caller, code)
+		statedb.SetCode(target, []byte{byte(STOP)})
+		if delegate {
+			statedb.SetCode(callee, AddressToDelegation(target))
+		}
+		if warmTarget {
+			statedb.AddAddressToAccessList(target)
+		}
+
+		evm := NewEVM(vmctx, TxContext{}, statedb, params.AllEthashProtocolChanges, Config{Prague: prague})
+		_, leftOver, err := evm.Call(AccountRef(common.Address{}), caller, nil, gasLimit, new(big.Int))
+		if err != nil {
+			t.Fatalf("call failed: %v", err)
+		}
+		return gasLimit - leftOver, statedb
+	}
+
+	base, _ := gasUsed(false, false, true)
+
+	cold, statedb := gasUsed(true, false, true)
+	if cold != base+coldExtra {
+		t.Errorf("cold delegation target: gas used %d, want %d", cold, base+coldExtra)
+	}
+	if !statedb.AddressInAccessList(target) {
+		t.Error("delegation target not added to the access list")
+	}
+
+	if warm, _ := gasUsed(true, true, true); warm != base+warmExtra {
+		t.Errorf("warm delegation target: gas used %d, want %d", warm, base+warmExtra)
+	}
+
+	// the delegation target is not charged before Prague
+	notPrague, statedb := gasUsed(true, false, false)
+	if notPrague != base {
+		t.Errorf("delegation before prague: gas used %d, want %d", notPrague, base)
+	}
+	if statedb.AddressInAccessList(target) {
+		t.Error("delegation target added to the access list before prague")
+	}
+}
@@ -17,6 +17,7 @@
 package vm
 
 import (
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 )
 
@@ -30,6 +31,15 @@ const (
 	GasExtStep     uint64 = 20
 )
 
+// EIP-3860 initcode limits
+const (
+	// MaxInitCodeSize is the maximum initcode size allowed for contract
+	// creation (2 * MaxCodeSize).
+	MaxInitCodeSize = 2 * params.MaxCodeSize
+	// InitCodeWordGas is the gas charged for every 32-byte word of initcode.
+	InitCodeWordGas uint64 = 2
+)
+
 // callGas returns the actual gas cost of the call.
 //
 // The cost of gas was changed during the homestead price change HF.
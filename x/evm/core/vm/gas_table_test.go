@@ -28,6 +28,34 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 )
 
+// testStateDB extends the go-ethereum StateDB with the methods introduced on
+// Cancun, which are not available on the go-ethereum version used.
+type testStateDB struct {
+	*state.StateDB
+	transient map[common.Address]map[common.Hash]common.Hash
+}
+
+func newTestStateDB() *testStateDB {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	return &testStateDB{
+		StateDB:   statedb,
+		transient: make(map[common.Address]map[common.Hash]common.Hash),
+	}
+}
+
+func (s *testStateDB) Selfdestruct6780(addr common.Address) {}
+
+func (s *testStateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transient[addr][key]
+}
+
+func (s *testStateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	if _, ok := s.transient[addr]; !ok {
+		s.transient[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.transient[addr][key] = value
+}
+
 func TestMemoryGasCost(t *testing.T) {
 	tests := []struct {
 		size     uint64
@@ -81,7 +109,7 @@ func TestEIP2200(t *testing.T) {
 	for i, tt := range eip2200Tests {
 		address := common.BytesToAddress([]byte("contract"))
 
-		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		statedb := newTestStateDB()
 		statedb.CreateAccount(address)
 		statedb.SetCode(address, hexutil.MustDecode(tt.input))
 		statedb.SetState(address, common.Hash{}, common.BytesToHash([]byte{tt.original}))
@@ -105,3 +133,52 @@ func TestEIP2200(t *testing.T) {
 		}
 	}
 }
+
+var createGasTests = []struct {
+	code    string
+	eip3860 bool
+	gasUsed uint64
+	failure error
+}{
+	// legacy create(0, 0, 0xc000) without 3860 used
+	{"0x61C00060006000f0" + "600052" + "60206000F3", false, 41237, nil},
+	// legacy create(0, 0, 0xc000) _with_ 3860
+	{"0x61C00060006000f0" + "600052" + "60206000F3", true, 44309, nil},
+	// create2(0, 0, 0xc001, 0) without 3860
+	{"0x600061C00160006000f5" + "600052" + "60206000F3", false, 50471, nil},
+	// create2(0, 0, 0xc001, 0) (too large), with 3860
+	{"0x600061C00160006000f5" + "600052" + "60206000F3", true, 100_000, ErrOutOfGas},
+	// create2(0, 0, 0xc000, 0) (at the limit), with 3860
+	{"0x600061C00060006000f5" + "600052" + "60206000F3", true, 53528, nil},
+}
+
+func TestCreateGas(t *testing.T) {
+	for i, tt := range createGasTests {
+		address := common.BytesToAddress([]byte("contract"))
+
+		statedb := newTestStateDB()
+		statedb.CreateAccount(address)
+		statedb.SetCode(address, hexutil.MustDecode(tt.code))
+		statedb.Finalise(true)
+
+		vmctx := BlockContext{
+			CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+			Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+			BlockNumber: big.NewInt(0),
+		}
+		config := Config{}
+		if tt.eip3860 {
+			config.ExtraEips = []string{"ethereum_3860"}
+		}
+		vmenv := NewEVM(vmctx, TxContext{}, statedb, params.AllEthashProtocolChanges, config)
+
+		startGas := uint64(100_000)
+		_, gas, err := vmenv.Call(AccountRef(common.Address{}), address, nil, startGas, new(big.Int))
+		if err != tt.failure {
+			t.Errorf("test %d: failure mismatch: have %v, want %v", i, err, tt.failure)
+		}
+		if used := startGas - gas; used != tt.gasUsed {
+			t.Errorf("test %d: gas used mismatch: have %v, want %v", i, used, tt.gasUsed)
+		}
+	}
+}
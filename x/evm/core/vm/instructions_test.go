@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -714,3 +715,109 @@ func TestRandom(t *testing.T) {
 		}
 	}
 }
+
+func TestOpMCopy(t *testing.T) {
+	// Test cases from https://eips.ethereum.org/EIPS/eip-5656#test-cases
+	for i, tc := range []struct {
+		dst, src, len string
+		pre           string
+		want          string
+		wantGas       uint64
+	}{
+		{ // MCOPY 0 32 32 - copy 32 bytes from offset 32 to offset 0.
+			dst: "0x0", src: "0x20", len: "0x20",
+			pre:     "0000000000000000000000000000000000000000000000000000000000000000 000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			want:    "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f 000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			wantGas: 6,
+		},
+		{ // MCOPY 0 0 32 - copy 32 bytes from offset 0 to offset 0.
+			dst: "0x0", src: "0x0", len: "0x20",
+			pre:     "0101010101010101010101010101010101010101010101010101010101010101",
+			want:    "0101010101010101010101010101010101010101010101010101010101010101",
+			wantGas: 6,
+		},
+		{ // MCOPY 0 1 8 - copy 8 bytes from offset 1 to offset 0 (overlapping).
+			dst: "0x0", src: "0x1", len: "0x8",
+			pre:     "000102030405060708 000000000000000000000000000000000000000000000000",
+			want:    "010203040506070808 000000000000000000000000000000000000000000000000",
+			wantGas: 6,
+		},
+		{ // MCOPY 1 0 8 - copy 8 bytes from offset 0 to offset 1 (overlapping).
+			dst: "0x1", src: "0x0", len: "0x8",
+			pre:     "000102030405060708 000000000000000000000000000000000000000000000000",
+			want:    "000001020304050607 000000000000000000000000000000000000000000000000",
+			wantGas: 6,
+		},
+		{ // MCOPY 0x20 0 32 - copy 32 bytes into an expanded memory region.
+			dst: "0x20", src: "0x0", len: "0x20",
+			pre:     "0101010101010101010101010101010101010101010101010101010101010101",
+			want:    "0101010101010101010101010101010101010101010101010101010101010101 0101010101010101010101010101010101010101010101010101010101010101",
+			wantGas: 9,
+		},
+	} {
+		var (
+			evm            = NewEVM(BlockContext{}, TxContext{}, nil, params.TestChainConfig, Config{})
+			stack, err     = NewStack()
+			pc             = uint64(0)
+			evmInterpreter = evm.interpreter.(*EVMInterpreter)
+		)
+		require.NoError(t, err)
+
+		data := common.FromHex(strings.ReplaceAll(tc.pre, " ", ""))
+		mem := NewMemory()
+		// account for the gas already paid for the pre-existing memory
+		_, err = memoryGasCost(mem, uint64(len(data)))
+		require.NoError(t, err, "case %d", i)
+		mem.Resize(uint64(len(data)))
+		mem.Set(0, uint64(len(data)), data)
+
+		length, _ := uint256.FromHex(tc.len)
+		src, _ := uint256.FromHex(tc.src)
+		dst, _ := uint256.FromHex(tc.dst)
+		stack.Push(length)
+		stack.Push(src)
+		stack.Push(dst)
+
+		memSize, overflow := memoryMcopy(stack)
+		require.False(t, overflow, "case %d", i)
+		memorySize := toWordSize(memSize) * 32
+
+		dynamicCost, err := gasMcopy(evm, nil, stack, mem, memorySize)
+		require.NoError(t, err, "case %d", i)
+		require.Equal(t, tc.wantGas, GasFastestStep+dynamicCost, "case %d", i)
+
+		if memorySize > 0 {
+			mem.Resize(memorySize)
+		}
+		_, err = opMcopy(&pc, evmInterpreter, &ScopeContext{mem, stack, nil})
+		require.NoError(t, err, "case %d", i)
+
+		want := common.FromHex(strings.ReplaceAll(tc.want, " ", ""))
+		require.Equal(t, want, mem.Data(), "case %d", i)
+	}
+}
+
+func TestOpBlobBaseFee(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		blobBaseFee *big.Int
+		expected    uint64
+	}{
+		{name: "blob base fee not set", blobBaseFee: nil, expected: 0},
+		{name: "blob base fee set", blobBaseFee: big.NewInt(7), expected: 7},
+	} {
+		var (
+			env         = NewEVM(BlockContext{BlobBaseFee: tc.blobBaseFee}, TxContext{}, nil, params.TestChainConfig, Config{})
+			stack, err  = NewStack()
+			pc          = uint64(0)
+			interpreter = env.interpreter
+		)
+		require.NoError(t, err, tc.name)
+
+		_, err = opBlobBaseFee(&pc, interpreter.(*EVMInterpreter), &ScopeContext{nil, stack, nil})
+		require.NoError(t, err, tc.name)
+		require.Equal(t, 1, stack.Len(), tc.name)
+		actual := stack.Pop()
+		require.Equal(t, tc.expected, actual.Uint64(), tc.name)
+	}
+}
@@ -49,6 +49,12 @@ type StateDB interface {
 
 	Suicide(common.Address) bool
 	HasSuicided(common.Address) bool
+	// Selfdestruct6780 applies the EIP-6780 semantics to SELFDESTRUCT: the
+	// account is only deleted if it was created in the current transaction.
+	Selfdestruct6780(common.Address)
+
+	GetTransientState(addr common.Address, key common.Hash) common.Hash
+	SetTransientState(addr common.Address, key, value common.Hash)
 
 	// Exist reports whether the given account exists in state.
 	// Notably this should also return true for suicided accounts.
@@ -34,6 +34,14 @@ type Config struct {
 	JumpTable *JumpTable // EVM instruction table, automatically populated if unset
 
 	ExtraEips []string // Additional EIPS that are to be enabled
+	// ExtraActivators are the activators of the EIPs defined at runtime, e.g.
+	// from the module params. They are not registered through ExtendActivators,
+	// since its registry is global and append-only: it is extended once on the
+	// app initialization, while the EIPs defined at runtime can be updated or
+	// removed and must only apply to the EVM instances configured with them.
+	ExtraActivators map[string]func(*JumpTable)
+
+	Prague bool // Enables the EIP-7702 code delegations
 }
 
 // ScopeContext contains the things that are per-call, such as stack and memory,
@@ -70,7 +78,7 @@ type EVMInterpreter struct {
 func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 	// If jump table was not initialised we set the default one.
 	if cfg.JumpTable == nil {
-		cfg.JumpTable = DefaultJumpTable(evm.chainRules)
+		cfg.JumpTable = DefaultJumpTable(evm.chainRules, evm.chainConfig.IsCancun(evm.Context.BlockNumber))
 		for i, eip := range cfg.ExtraEips {
 			if len(cfg.ExtraEips) == 1 && eip == "\x8f\x1e" {
 				// The protobuf params changed so need to update the EIP for archive calls
@@ -79,7 +87,7 @@ func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 
 			// Deep-copy jumptable to prevent modification of opcodes in other tables
 			copy := CopyJumpTable(cfg.JumpTable)
-			if err := EnableEIP(eip, copy); err != nil {
+			if err := enableEIP(eip, copy, cfg.ExtraActivators); err != nil {
 				// Disable it, so caller can check if it's activated or not
 				cfg.ExtraEips = append(cfg.ExtraEips[:i], cfg.ExtraEips[i+1:]...)
 				log.Error("EIP activation failed", "eip", eip, "error", err)
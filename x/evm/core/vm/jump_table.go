@@ -55,14 +55,22 @@ var (
 	BerlinInstructionSet           = newBerlinInstructionSet()
 	LondonInstructionSet           = newLondonInstructionSet()
 	MergeInstructionSet            = newMergeInstructionSet()
+	ShanghaiInstructionSet         = newShanghaiInstructionSet()
+	CancunInstructionSet           = newCancunInstructionSet()
 )
 
 // JumpTable contains the EVM opcodes supported at a given fork.
 type JumpTable [256]*operation
 
 // DefaultJumpTable defines the default jump table used by the EVM interpreter.
-func DefaultJumpTable(rules params.Rules) (jumpTable *JumpTable) {
+// The Cancun activation is passed separately since it is not exposed on the
+// params.Rules of the go-ethereum fork (see ChainConfig.IsCancun).
+func DefaultJumpTable(rules params.Rules, isCancun bool) (jumpTable *JumpTable) {
 	switch {
+	case isCancun:
+		jumpTable = &CancunInstructionSet
+	case rules.IsShanghai:
+		jumpTable = &ShanghaiInstructionSet
 	case rules.IsMerge:
 		jumpTable = &MergeInstructionSet
 	case rules.IsLondon:
@@ -117,6 +125,27 @@ func (jt JumpTable) MustValidate() {
 	}
 }
 
+// newCancunInstructionSet returns the frontier, homestead, byzantium,
+// constantinople, istanbul, petersburg, berlin, london, merge, shanghai
+// and cancun instructions.
+func newCancunInstructionSet() JumpTable {
+	instructionSet := newShanghaiInstructionSet()
+	enable1153(&instructionSet) // EIP-1153 "Transient Storage"
+	enable5656(&instructionSet) // EIP-5656 (MCOPY opcode)
+	enable6780(&instructionSet) // EIP-6780 SELFDESTRUCT only in same transaction
+	enable7516(&instructionSet) // EIP-7516 (BLOBBASEFEE opcode)
+	instructionSet.MustValidate()
+	return instructionSet
+}
+
+func newShanghaiInstructionSet() JumpTable {
+	instructionSet := newMergeInstructionSet()
+	enable3855(&instructionSet) // PUSH0 instruction
+	enable3860(&instructionSet) // Limit and meter initcode
+	instructionSet.MustValidate()
+	return instructionSet
+}
+
 func newMergeInstructionSet() JumpTable {
 	instructionSet := newLondonInstructionSet()
 	instructionSet[RANDOM] = &operation{
@@ -17,8 +17,11 @@
 package vm
 
 import (
+	"math/big"
+	"reflect"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,3 +36,42 @@ func TestJumpTableCopy(t *testing.T) {
 	require.Equal(t, uint64(100), deepCopy[SLOAD].constantGas)
 	require.Equal(t, uint64(0), tbl[SLOAD].constantGas)
 }
+
+// TestDefaultJumpTableCancun tests that the Cancun opcodes are only available
+// once the Cancun fork is active
+func TestDefaultJumpTableCancun(t *testing.T) {
+	rules := params.TestChainConfig.Rules(big.NewInt(0), true)
+
+	isUndefined := func(op *operation) bool {
+		return reflect.ValueOf(op.execute).Pointer() == reflect.ValueOf(opUndefined).Pointer()
+	}
+
+	merge := DefaultJumpTable(rules, false)
+	for _, op := range []OpCode{TLOAD, TSTORE, MCOPY, BLOBBASEFEE} {
+		require.True(t, isUndefined(merge[op]), "%s should not be defined before Cancun", op)
+	}
+
+	cancun := DefaultJumpTable(rules, true)
+	for _, op := range []OpCode{PUSH0, TLOAD, TSTORE, MCOPY, BLOBBASEFEE} {
+		require.False(t, isUndefined(cancun[op]), "%s should be defined on Cancun", op)
+	}
+	require.Equal(t, params.WarmStorageReadCostEIP2929, cancun[TLOAD].constantGas)
+	require.Equal(t, params.WarmStorageReadCostEIP2929, cancun[TSTORE].constantGas)
+}
+
+// TestDefaultJumpTableShanghai tests that the Shanghai jump table enables
+// PUSH0 and the EIP-3860 initcode metering
+func TestDefaultJumpTableShanghai(t *testing.T) {
+	config := *params.TestChainConfig
+	config.ShanghaiBlock = big.NewInt(0)
+	rules := config.Rules(big.NewInt(0), true)
+	require.True(t, rules.IsShanghai)
+
+	shanghai := DefaultJumpTable(rules, false)
+	require.Equal(t, reflect.ValueOf(opPush0).Pointer(), reflect.ValueOf(shanghai[PUSH0].execute).Pointer())
+	require.Equal(t, reflect.ValueOf(gasCreateEip3860).Pointer(), reflect.ValueOf(shanghai[CREATE].dynamicGas).Pointer())
+	require.Equal(t, reflect.ValueOf(gasCreate2Eip3860).Pointer(), reflect.ValueOf(shanghai[CREATE2].dynamicGas).Pointer())
+
+	merge := DefaultJumpTable(params.TestChainConfig.Rules(big.NewInt(0), true), false)
+	require.Equal(t, reflect.ValueOf(gasCreate).Pointer(), reflect.ValueOf(merge[CREATE].dynamicGas).Pointer())
+}
@@ -173,21 +173,46 @@ func makeCallVariantGasCallEIP2929(oldCalculator gasFunc) gasFunc {
 				return 0, ErrOutOfGas
 			}
 		}
+		// Charge the access to the code of the EIP-7702 delegation target, if
+		// the called account has a delegation designator
+		var delegationCost uint64
+		if target, ok := evm.delegationTarget(addr); ok {
+			if evm.StateDB.AddressInAccessList(target) {
+				delegationCost = params.WarmStorageReadCostEIP2929
+			} else {
+				evm.StateDB.AddAddressToAccessList(target)
+				delegationCost = params.ColdAccountAccessCostEIP2929
+			}
+			if !contract.UseGas(delegationCost) {
+				return 0, ErrOutOfGas
+			}
+		}
 		// Now call the old calculator, which takes into account
 		// - create new account
 		// - transfer value
 		// - memory expansion
 		// - 63/64ths rule
 		gas, err := oldCalculator(evm, contract, stack, mem, memorySize)
-		if warmAccess || err != nil {
+		if err != nil {
 			return gas, err
 		}
+		charged := delegationCost
+		if !warmAccess {
+			charged += coldCost
+		}
+		if charged == 0 {
+			return gas, nil
+		}
 		// In case of a cold access, we temporarily add the cold charge back, and also
 		// add it to the returned gas. By adding it to the return, it will be charged
 		// outside of this function, as part of the dynamic gas, and that will make it
 		// also become correctly reported to tracers.
-		contract.Gas += coldCost
-		return gas + coldCost, nil
+		contract.Gas += charged
+		var overflow bool
+		if gas, overflow = math.SafeAdd(gas, charged); overflow {
+			return 0, ErrGasUintOverflow
+		}
+		return gas, nil
 	}
 }
 
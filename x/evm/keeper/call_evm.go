@@ -7,6 +7,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -100,3 +101,66 @@ func (k Keeper) CallEVMWithData(
 
 	return res, nil
 }
+
+// CallEVMWithGasLimit performs a smart contract method call using contract data
+// with at most the given amount of gas, as done for the calls triggered by the
+// modules (e.g. IBC and epoch callbacks). Unlike CallEVMWithData, the gas limit
+// is not estimated and the caller is not required to have an existing account.
+// The call runs in a cached context on its own gas meter, so that running out
+// of gas only fails the call, and the gas used is charged once to the gas meter
+// of the given context. The state changes are only committed if the call succeeds.
+func (k Keeper) CallEVMWithGasLimit(
+	ctx sdk.Context,
+	from, contract common.Address,
+	data []byte,
+	gasLimit uint64,
+) (res *types.MsgEthereumTxResponse, err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	// the EVM accounts for the gas of the call, so the KV store gas is not
+	// charged on top of it
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit)).
+		WithKVGasConfig(storetypes.GasConfig{}).
+		WithTransientKVGasConfig(storetypes.GasConfig{})
+
+	// NOTE: the whole gas limit is charged if the call fails without
+	// reporting its usage
+	gasUsed := gasLimit
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res = nil
+			err = errorsmod.Wrapf(errortypes.ErrOutOfGas, "EVM call out of gas in location: %s", outOfGas.Descriptor)
+		}
+		ctx.GasMeter().ConsumeGas(gasUsed, "EVM call with gas limit")
+	}()
+
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		k.GetNonce(cacheCtx, from),
+		big.NewInt(0), // amount
+		gasLimit,      // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	res, err = k.ApplyMessage(cacheCtx, msg, types.NewNoOpTracer(), true)
+	if err != nil {
+		return nil, err
+	}
+	gasUsed = res.GasUsed
+
+	if res.Failed() {
+		return res, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+	}
+
+	writeCache()
+	return res, nil
+}
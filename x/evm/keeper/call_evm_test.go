@@ -3,10 +3,13 @@ package keeper_test
 import (
 	"fmt"
 
+	storetypes "cosmossdk.io/store/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/evmos/v20/contracts"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	"github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -146,3 +149,86 @@ func (suite *KeeperTestSuite) TestCallEVMWithData() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCallEVMWithGasLimit() {
+	var (
+		// storeCode is the runtime code of a contract that stores 1 in the slot 0 on any call.
+		storeCode = common.FromHex("0x600160005500")
+		// revertCode is the runtime code of a contract that reverts on any call.
+		revertCode = common.FromHex("0x60006000fd")
+		gasLimit   = uint64(100_000)
+	)
+
+	testCases := []struct {
+		name      string
+		code      []byte
+		gasLimit  uint64
+		expPass   bool
+		expStored bool
+		expExact  bool
+	}{
+		{
+			"pass - charged the gas used",
+			storeCode,
+			gasLimit,
+			true,
+			true,
+			false,
+		},
+		{
+			"fail - call reverts and the state is not committed",
+			revertCode,
+			gasLimit,
+			false,
+			false,
+			false,
+		},
+		{
+			"fail - out of gas charged the gas limit once",
+			storeCode,
+			25_000,
+			false,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			// the store gas is not charged so that only the call gas is measured
+			ctx := suite.network.GetContext().
+				WithGasMeter(storetypes.NewGasMeter(10_000_000)).
+				WithKVGasConfig(storetypes.GasConfig{}).
+				WithTransientKVGasConfig(storetypes.GasConfig{})
+
+			contract := utiltx.GenerateAddress()
+			codeHash := crypto.Keccak256Hash(tc.code)
+			suite.network.App.EvmKeeper.SetCode(ctx, codeHash.Bytes(), tc.code)
+			account := statedb.NewEmptyAccount()
+			account.CodeHash = codeHash.Bytes()
+			suite.Require().NoError(suite.network.App.EvmKeeper.SetAccount(ctx, contract, *account))
+
+			gasBefore := ctx.GasMeter().GasConsumed()
+			_, err := suite.network.App.EvmKeeper.CallEVMWithGasLimit(ctx, types.ModuleAddress, contract, nil, tc.gasLimit)
+			gasCharged := ctx.GasMeter().GasConsumed() - gasBefore
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			if tc.expExact {
+				suite.Require().Equal(tc.gasLimit, gasCharged)
+			} else {
+				suite.Require().NotZero(gasCharged)
+				suite.Require().Less(gasCharged, tc.gasLimit)
+			}
+
+			stored := suite.network.App.EvmKeeper.GetState(ctx, contract, common.Hash{})
+			suite.Require().Equal(tc.expStored, stored == common.BigToHash(common.Big1))
+		})
+	}
+}
@@ -15,7 +15,7 @@ import (
 // EVMConfig creates the EVMConfig based on current state
 func (k *Keeper) EVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress) (*statedb.EVMConfig, error) {
 	params := k.GetParams(ctx)
-	ethCfg := types.GetEthChainConfig()
+	ethCfg := params.EthereumConfig(types.GetEthChainConfig())
 
 	// get the coinbase address from the block proposer
 	coinbase, err := k.GetCoinbaseAddress(ctx, proposerAddress)
@@ -56,9 +56,11 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 	}
 
 	return vm.Config{
-		Debug:     debug,
-		Tracer:    tracer,
-		NoBaseFee: noBaseFee,
-		ExtraEips: cfg.Params.EIPs(),
+		Debug:           debug,
+		Tracer:          tracer,
+		NoBaseFee:       noBaseFee,
+		ExtraEips:       cfg.Params.EIPs(),
+		ExtraActivators: cfg.Params.CustomEIPActivators(),
+		Prague:          cfg.Params.IsPrague(ctx.BlockHeight()),
 	}
 }
@@ -71,7 +71,7 @@ func VerifyFee(
 	txData types.TxData,
 	denom string,
 	baseFee *big.Int,
-	homestead, istanbul, isCheckTx bool,
+	homestead, istanbul, shanghai, isCheckTx bool,
 ) (sdk.Coins, error) {
 	isContractCreation := txData.GetTo() == nil
 
@@ -91,6 +91,16 @@ func VerifyFee(
 		)
 	}
 
+	if isContractCreation && shanghai {
+		if intrinsicGas, err = addInitCodeGas(intrinsicGas, txData.GetData()); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to retrieve initcode gas")
+		}
+	}
+
+	if setCodeTx, ok := txData.(*types.SetCodeTx); ok {
+		intrinsicGas += setCodeTx.AuthorizationGas()
+	}
+
 	// intrinsic gas verification during CheckTx
 	if isCheckTx && gasLimit < intrinsicGas {
 		return nil, errorsmod.Wrapf(
@@ -3,6 +3,7 @@
 package keeper
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
@@ -14,6 +15,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -22,8 +24,40 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	height := big.NewInt(ctx.BlockHeight())
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
+	shanghai := cfg.IsShanghai(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	intrinsicGas, err := core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	// EIP-3860 contract creations are charged for each word of initcode
+	if isContractCreation && shanghai {
+		if intrinsicGas, err = addInitCodeGas(intrinsicGas, msg.Data()); err != nil {
+			return 0, err
+		}
+	}
+
+	// EIP-7702 set code txs are charged for each authorization tuple
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		authGas := uint64(len(setCodeMsg.AuthorizationList)) * types.PerEmptyAccountCost
+		if intrinsicGas+authGas < intrinsicGas {
+			return 0, core.ErrGasUintOverflow
+		}
+		intrinsicGas += authGas
+	}
+
+	return intrinsicGas, nil
+}
+
+// addInitCodeGas adds the EIP-3860 initcode word gas of the given contract
+// creation data to the intrinsic gas.
+func addInitCodeGas(intrinsicGas uint64, data []byte) (uint64, error) {
+	words := (uint64(len(data)) + 31) / 32
+	if words > (math.MaxUint64-intrinsicGas)/vm.InitCodeWordGas {
+		return 0, core.ErrGasUintOverflow
+	}
+	return intrinsicGas + words*vm.InitCodeWordGas, nil
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
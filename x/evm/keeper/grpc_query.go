@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"math/big"
 	"time"
 
@@ -238,8 +239,12 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := setOverrides(cfg, req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.getNonceWithOverrides(ctx, cfg, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -258,6 +263,119 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// SimulateV1 implements eth_simulateV1 rpc api. It executes the calls of each
+// simulated block sequentially over a single StateDB, so that each call sees
+// the state left by the previous ones, including the effects of precompiles.
+// The StateDB is never committed.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (*types.SimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var opts types.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	blocks, err := opts.SanitizeBlocks(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- block time is never negative
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stateDB := statedb.New(ctx, &k, statedb.NewEmptyTxConfig(common.Hash{}))
+
+	// gasCap is the gas left to be used by all the simulated calls
+	gasCap := req.GasCap
+	res := &types.SimulateV1Response{
+		Blocks: make([]types.SimulatedBlock, 0, len(blocks)),
+	}
+
+	for i, block := range opts.BlockStateCalls {
+		blockCfg := *cfg
+		setBlockOverrides(&blockCfg, &blocks[i])
+
+		if err := block.StateOverrides.Apply(stateDB); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: failed to apply state overrides: %s", i, err.Error())
+		}
+
+		simBlock := types.SimulatedBlock{
+			Number: blocks[i].Number.ToInt().Uint64(),
+			Time:   uint64(*blocks[i].Time),
+			Calls:  make([]types.SimulatedCallResult, 0, len(block.Calls)),
+		}
+		if blockCfg.BaseFee != nil {
+			baseFee := sdkmath.NewIntFromBigInt(blockCfg.BaseFee)
+			simBlock.BaseFee = &baseFee
+		}
+
+		var logIndex uint
+		for j, args := range block.Calls {
+			if req.GasCap > 0 && gasCap == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "block %d, call %d: gas cap exhausted", i, j)
+			}
+
+			// the calls without nonce use the one left by the previous calls
+			if args.Nonce == nil {
+				nonce := stateDB.GetNonce(args.GetFrom())
+				args.Nonce = (*hexutil.Uint64)(&nonce)
+			}
+
+			msg, err := args.ToMessage(gasCap, blockCfg.BaseFee)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "block %d, call %d: %s", i, j, err.Error())
+			}
+
+			txConfig := statedb.NewTxConfig(common.Hash{}, common.Hash{}, uint(j), logIndex)
+			stateDB.SetTxConfig(txConfig)
+
+			// pass false to not commit StateDB
+			callRes, err := k.applyMessageWithStateDB(ctx, msg, nil, false, &blockCfg, txConfig, stateDB)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "block %d, call %d: %s", i, j, err.Error())
+			}
+
+			// the EVM only increases the nonce of the sender on contract creations
+			if msg.To() != nil {
+				stateDB.SetNonce(msg.From(), msg.Nonce()+1)
+			}
+
+			if req.GasCap > 0 {
+				if callRes.GasUsed > gasCap {
+					callRes.GasUsed = gasCap
+				}
+				gasCap -= callRes.GasUsed
+			}
+
+			for _, log := range callRes.Logs {
+				log.BlockNumber = simBlock.Number
+			}
+
+			logIndex += uint(len(callRes.Logs))
+			simBlock.GasUsed += callRes.GasUsed
+			simBlock.Calls = append(simBlock.Calls, types.SimulatedCallResult{
+				Ret:     callRes.Ret,
+				GasUsed: callRes.GasUsed,
+				Logs:    callRes.Logs,
+				VmError: callRes.VmError,
+			})
+		}
+
+		res.Blocks = append(res.Blocks, simBlock)
+	}
+
+	return res, nil
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	return k.EstimateGasInternal(c, req, types.RPC)
@@ -316,10 +434,24 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 
+	if err := setOverrides(cfg, req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.getNonceWithOverrides(ctx, cfg, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
+	// The sender nonce is increased before the execution on RPC calls to mimic
+	// the ante handler, so the same needs to be done for an overridden nonce.
+	if override, ok := cfg.Overrides[args.GetFrom()]; ok && override.Nonce != nil && fromType == types.RPC {
+		overrides := maps.Clone(cfg.Overrides)
+		nextNonce := *override.Nonce + 1
+		override.Nonce = &nextNonce
+		overrides[args.GetFrom()] = override
+		cfg.Overrides = overrides
+	}
+
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
 	// convert the tx args to an ethereum message
@@ -333,6 +465,9 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		baseDenom := types.GetEVMCoinDenom()
 
 		balance := k.bankWrapper.GetBalance(ctx, sdk.AccAddress(args.From.Bytes()), baseDenom)
+		if override, ok := cfg.Overrides[args.GetFrom()]; ok && override.Balance != nil && *override.Balance != nil {
+			balance.Amount = sdkmath.NewIntFromBigInt((*override.Balance).ToInt())
+		}
 		available := balance.Amount
 		transfer := "0"
 		if args.Value != nil {
@@ -362,7 +497,7 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	// Create a helper to check if a gas allowance results in an executable transaction
 	executable := func(gas uint64) (vmError bool, rsp *types.MsgEthereumTxResponse, err error) {
 		// update the message with the new gas value
-		msg = ethtypes.NewMessage(
+		gasMsg := ethtypes.NewMessage(
 			msg.From(),
 			msg.To(),
 			msg.Nonce(),
@@ -375,6 +510,12 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 			msg.AccessList(),
 			msg.IsFake(),
 		)
+		if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+			setCodeMsg.Message = gasMsg
+			msg = setCodeMsg
+		} else {
+			msg = gasMsg
+		}
 
 		tmpCtx := ctx
 		if fromType == types.RPC {
@@ -439,6 +580,62 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// overridesRequest is a query request that contains JSON encoded state and
+// block overrides.
+type overridesRequest interface {
+	GetOverrides() []byte
+	GetBlockOverrides() []byte
+}
+
+// setOverrides decodes the state and block overrides from the request, if any,
+// and sets them on the EVM config.
+func setOverrides(cfg *statedb.EVMConfig, req overridesRequest) error {
+	if len(req.GetOverrides()) > 0 {
+		var overrides types.StateOverride
+		if err := json.Unmarshal(req.GetOverrides(), &overrides); err != nil {
+			return fmt.Errorf("invalid state overrides: %w", err)
+		}
+		if err := overrides.Validate(); err != nil {
+			return err
+		}
+		cfg.Overrides = overrides
+	}
+
+	if len(req.GetBlockOverrides()) > 0 {
+		var blockOverrides types.BlockOverrides
+		if err := json.Unmarshal(req.GetBlockOverrides(), &blockOverrides); err != nil {
+			return fmt.Errorf("invalid block overrides: %w", err)
+		}
+		if err := blockOverrides.Validate(); err != nil {
+			return err
+		}
+		setBlockOverrides(cfg, &blockOverrides)
+	}
+
+	return nil
+}
+
+// setBlockOverrides sets the given block overrides on the EVM config.
+func setBlockOverrides(cfg *statedb.EVMConfig, blockOverrides *types.BlockOverrides) {
+	// the base fee is also used to compute the effective gas price of the message
+	if blockOverrides.BaseFee != nil {
+		cfg.BaseFee = blockOverrides.BaseFee.ToInt()
+	}
+	if blockOverrides.Coinbase != nil {
+		cfg.CoinBase = *blockOverrides.Coinbase
+	}
+	cfg.BlockOverrides = blockOverrides
+}
+
+// getNonceWithOverrides returns the nonce of the given address, taking into
+// account the state overrides set on the EVM config.
+func (k Keeper) getNonceWithOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, addr common.Address) uint64 {
+	if override, ok := cfg.Overrides[addr]; ok && override.Nonce != nil {
+		return uint64(*override.Nonce)
+	}
+	return k.GetNonce(ctx, addr)
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -488,12 +685,11 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	// and avoid stacking the gas used of every predecessor in the same gas meter
 
 	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		msg, err := tx.AsMessage(signer, cfg.BaseFee)
 		if err != nil {
 			continue
 		}
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.TxHash()
 		txConfig.TxIndex = uint(i) // #nosec G115
 		// reset gas meter for each transaction
 		ctx = evmante.BuildEvmExecutionCtx(ctx).
@@ -505,8 +701,8 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		txConfig.LogIndex += uint(len(rsp.Logs))
 	}
 
-	tx := req.Msg.AsTransaction()
-	txConfig.TxHash = tx.Hash()
+	tx := req.Msg
+	txConfig.TxHash = tx.TxHash()
 	if len(req.Predecessors) > 0 {
 		txConfig.TxIndex++
 	}
@@ -533,6 +729,68 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the queried block. The state and
+// block overrides of the request are applied before executing the call.
+// The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	if err := setOverrides(cfg, req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.getNonceWithOverrides(ctx, cfg, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment for all the transactions in the queried block.
 // The return value will be tracer dependent.
@@ -579,12 +837,17 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
-		ethTx := tx.AsTransaction()
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.TxHash()
 		txConfig.TxIndex = uint(i) // #nosec G115
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, true, tracerConfig)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -610,7 +873,25 @@ func (k *Keeper) traceTx(
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 	signer ethtypes.Signer,
-	tx *ethtypes.Transaction,
+	tx *types.MsgEthereumTx,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
@@ -622,10 +903,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -724,3 +1001,56 @@ func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.Q
 
 	return &types.QueryConfigResponse{Config: config}, nil
 }
+
+// CustomEIPs implements the Query/CustomEIPs gRPC method. It returns the
+// custom EIPs activated in the params and the gas of the opcodes they modify,
+// resulting from applying all the extra EIPs to the current jump table.
+func (k Keeper) CustomEIPs(c context.Context, _ *types.QueryCustomEIPsRequest) (*types.QueryCustomEIPsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	ethCfg := params.EthereumConfig(types.GetEthChainConfig())
+	height := big.NewInt(ctx.BlockHeight())
+	rules := ethCfg.Rules(height, ethCfg.MergeNetsplitBlock != nil)
+	defaultJumpTable := vm.DefaultJumpTable(rules, ethCfg.IsCancun(height))
+
+	jumpTable := vm.CopyJumpTable(defaultJumpTable)
+	activators := params.CustomEIPActivators()
+	for _, eip := range params.EIPs() {
+		if activator, ok := activators[eip]; ok {
+			activator(jumpTable)
+			continue
+		}
+		if err := vm.EnableEIP(eip, jumpTable); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	customEIPs := params.ActiveCustomEIPs()
+	gasTable := []types.OpCodeGas{}
+	seen := make(map[vm.OpCode]struct{})
+	for _, eip := range customEIPs {
+		opcodes, err := eip.OpCodes()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, op := range opcodes {
+			if _, ok := seen[op]; ok {
+				continue
+			}
+			seen[op] = struct{}{}
+
+			gasTable = append(gasTable, types.OpCodeGas{
+				Opcode:             op.String(),
+				DefaultConstantGas: defaultJumpTable[op].GetConstantGas(),
+				ConstantGas:        jumpTable[op].GetConstantGas(),
+				DynamicGas:         jumpTable[op].HasDynamicGas(),
+			})
+		}
+	}
+
+	return &types.QueryCustomEIPsResponse{
+		CustomEIPs: customEIPs,
+		GasTable:   gasTable,
+	}, nil
+}
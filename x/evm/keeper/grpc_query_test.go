@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 
+	"github.com/evmos/evmos/v20/precompiles/staking"
 	"github.com/evmos/evmos/v20/x/evm/keeper/testdata"
 
 	sdkmath "cosmossdk.io/math"
@@ -23,6 +25,7 @@ import (
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
 	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	"github.com/evmos/evmos/v20/x/evm/statedb"
 	"github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
@@ -446,6 +449,38 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().Equal(expParams, res.Params)
 }
 
+func (suite *KeeperTestSuite) TestQueryCustomEIPs() {
+	callEIP := types.CustomEIP{
+		Name:    "evmos_100",
+		EIPType: types.CustomEIPTypeConstantGasMultiplier,
+		Opcodes: []string{"CALL"},
+		Value:   2,
+	}
+	sstoreEIP := types.CustomEIP{
+		Name:    "evmos_101",
+		EIPType: types.CustomEIPTypeConstantGasOverride,
+		Opcodes: []string{"SSTORE"},
+		Value:   1000,
+	}
+
+	params := types.DefaultParams()
+	params.CustomEIPs = []types.CustomEIP{callEIP, sstoreEIP}
+	params.ExtraEIPs = append(params.ExtraEIPs, callEIP.Name)
+	err := suite.network.App.EvmKeeper.SetParams(suite.network.GetContext(), params)
+	suite.Require().NoError(err)
+
+	res, err := suite.network.GetEvmClient().CustomEIPs(suite.network.GetContext(), &types.QueryCustomEIPsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.CustomEIP{callEIP}, res.CustomEIPs)
+	suite.Require().Len(res.GasTable, 1)
+
+	callGas := res.GasTable[0]
+	suite.Require().Equal("CALL", callGas.Opcode)
+	suite.Require().NotZero(callGas.DefaultConstantGas)
+	suite.Require().Equal(callGas.DefaultConstantGas*2, callGas.ConstantGas)
+	suite.Require().True(callGas.DynamicGas)
+}
+
 func (suite *KeeperTestSuite) TestQueryValidatorAccount() {
 	testCases := []struct {
 		msg           string
@@ -1224,6 +1259,108 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTraceCall() {
+	suite.SetupTest()
+
+	sender := suite.keyring.GetAddr(0)
+	contractAddr := common.HexToAddress("0xC6Fe5D33615a1C52c08018c47E8Bc53646A0E101")
+	// PUSH1 0 SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	slotValue := common.BigToHash(big.NewInt(42))
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contractAddr})
+	suite.Require().NoError(err)
+	overrides, err := json.Marshal(types.StateOverride{contractAddr: {
+		Code:      &sloadCode,
+		StateDiff: &map[common.Hash]common.Hash{{}: slotValue},
+	}})
+	suite.Require().NoError(err)
+	// PUSH1 1 PUSH1 0 SSTORE STOP
+	sstoreCode := hexutil.Bytes(common.FromHex("0x600160005500"))
+	sstoreOverrides, err := json.Marshal(types.StateOverride{contractAddr: {Code: &sstoreCode}})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg         string
+		traceConfig *types.TraceConfig
+		overrides   []byte
+		expPass     bool
+		expTrace    []string
+	}{
+		{
+			msg:         "fail - negative limit",
+			traceConfig: &types.TraceConfig{Limit: -1},
+			expPass:     false,
+		},
+		{
+			msg:       "default trace with state overrides",
+			overrides: overrides,
+			expPass:   true,
+			expTrace:  []string{"\"failed\":false", "\"returnValue\":\"" + common.Bytes2Hex(slotValue.Bytes()) + "\"", "\"op\":\"SLOAD\""},
+		},
+		{
+			msg:         "callTracer with state overrides",
+			traceConfig: &types.TraceConfig{Tracer: "callTracer"},
+			overrides:   overrides,
+			expPass:     true,
+			expTrace:    []string{"\"type\":\"CALL\"", "\"output\":\"" + hexutil.Encode(slotValue.Bytes()) + "\""},
+		},
+		{
+			msg:         "prestateTracer with state overrides",
+			traceConfig: &types.TraceConfig{Tracer: "prestateTracer"},
+			overrides:   overrides,
+			expPass:     true,
+			expTrace:    []string{strings.ToLower(contractAddr.Hex()), "\"code\":\"" + sloadCode.String() + "\""},
+		},
+		{
+			msg:         "prestateTracer on diff mode",
+			traceConfig: &types.TraceConfig{Tracer: "prestateTracer", TracerJsonConfig: `{"diffMode":true}`},
+			overrides:   sstoreOverrides,
+			expPass:     true,
+			expTrace:    []string{"\"post\":{", "\"pre\":{", "\"storage\":{\"" + common.Hash{}.Hex() + "\":\"" + common.BigToHash(big.NewInt(1)).Hex() + "\"}"},
+		},
+		{
+			msg:         "vmTracer",
+			traceConfig: &types.TraceConfig{Tracer: "vmTracer"},
+			overrides:   sstoreOverrides,
+			expPass:     true,
+			expTrace:    []string{"\"code\":\"" + sstoreCode.String() + "\"", "\"push\":[\"0x1\"]", "\"store\":{\"key\":\"0x0\",\"val\":\"0x1\"}"},
+		},
+		{
+			msg: "javascript tracer",
+			traceConfig: &types.TraceConfig{
+				Tracer: "{ops: [], fault: function(log) {}, step: function(log) { this.ops.push(log.op.toString()); }, result: function() { return this.ops; }}",
+			},
+			overrides: overrides,
+			expPass:   true,
+			expTrace:  []string{"[\"PUSH1\",\"SLOAD\",\"PUSH1\",\"MSTORE\",\"PUSH1\",\"PUSH1\",\"RETURN\"]"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			req := &types.QueryTraceCallRequest{
+				Args:        args,
+				GasCap:      config.DefaultGasCap,
+				TraceConfig: tc.traceConfig,
+				Overrides:   tc.overrides,
+				ChainId:     suite.network.GetEIP155ChainID().Int64(),
+			}
+
+			res, err := suite.network.GetEvmClient().TraceCall(suite.network.GetContext(), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			for _, expTrace := range tc.expTrace {
+				suite.Require().Contains(string(res.Data), expTrace)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = true
 	defer func() { suite.enableFeemarket = false }()
@@ -1661,6 +1798,305 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallWithOverrides() {
+	suite.SetupTest()
+
+	sender := suite.keyring.GetAddr(0)
+	contractAddr := utiltx.GenerateAddress()
+	// NUMBER PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	numberCode := hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+	// PUSH1 0 SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	slotValue := common.BigToHash(big.NewInt(42))
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contractAddr})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		overrides      interface{}
+		blockOverrides interface{}
+		expPass        bool
+		expRet         []byte
+	}{
+		{
+			"fail - invalid state overrides",
+			"invalid",
+			nil,
+			false,
+			nil,
+		},
+		{
+			"fail - both state and state diff",
+			types.StateOverride{contractAddr: {
+				Code:      &sloadCode,
+				State:     &map[common.Hash]common.Hash{{}: slotValue},
+				StateDiff: &map[common.Hash]common.Hash{{}: slotValue},
+			}},
+			nil,
+			false,
+			nil,
+		},
+		{
+			"pass - code and state diff override",
+			types.StateOverride{contractAddr: {
+				Code:      &sloadCode,
+				StateDiff: &map[common.Hash]common.Hash{{}: slotValue},
+			}},
+			nil,
+			true,
+			slotValue.Bytes(),
+		},
+		{
+			"pass - block number override",
+			types.StateOverride{contractAddr: {Code: &numberCode}},
+			types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1234))},
+			true,
+			common.BigToHash(big.NewInt(1234)).Bytes(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			if tc.overrides != nil {
+				req.Overrides, err = json.Marshal(tc.overrides)
+				suite.Require().NoError(err)
+			}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.network.GetEvmClient().EthCall(suite.network.GetContext(), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(tc.expRet, res.Ret)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasWithBlockOverrides() {
+	suite.SetupTest()
+
+	sender := suite.keyring.GetAddr(0)
+	contractAddr := utiltx.GenerateAddress()
+	// reverts unless the block number is 1234:
+	// NUMBER PUSH2 1234 EQ PUSH1 13 JUMPI PUSH1 0 PUSH1 0 REVERT JUMPDEST STOP
+	numberCode := hexutil.Bytes(common.FromHex("0x436104d214600d5760006000fd5b00"))
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contractAddr})
+	suite.Require().NoError(err)
+	overrides, err := json.Marshal(types.StateOverride{contractAddr: {Code: &numberCode}})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		blockOverrides interface{}
+		expPass        bool
+		expVMError     string
+	}{
+		{
+			"fail - invalid block overrides",
+			"invalid",
+			false,
+			"",
+		},
+		{
+			"pass - no block overrides, call reverts",
+			nil,
+			true,
+			vm.ErrExecutionReverted.Error(),
+		},
+		{
+			"pass - block number override",
+			types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1234))},
+			true,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.network.GetEvmClient().EstimateGas(suite.network.GetContext(), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expVMError, res.VmError)
+			if tc.expVMError == "" {
+				suite.Require().Greater(res.Gas, ethparams.TxGas)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateV1() {
+	suite.SetupTest()
+
+	sender := suite.keyring.GetAddr(0)
+	contractAddr := utiltx.GenerateAddress()
+	revertAddr := utiltx.GenerateAddress()
+	// stores the calldata word on slot 0 if any calldata is provided, otherwise returns slot 0
+	storageCode := hexutil.Bytes(common.FromHex("0x3615600c57600035600055005b60005460005260206000f3"))
+	// PUSH1 0 PUSH1 0 REVERT
+	revertCode := hexutil.Bytes(common.FromHex("0x60006000fd"))
+	// NUMBER PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	numberCode := hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+	storedValue := common.BigToHash(big.NewInt(42))
+	storeData := hexutil.Bytes(storedValue.Bytes())
+
+	stakingABI, err := staking.LoadABI()
+	suite.Require().NoError(err)
+	stakingAddr := common.HexToAddress(types.StakingPrecompileAddress)
+
+	testCases := []struct {
+		name      string
+		opts      interface{}
+		malleate  func() interface{}
+		expPass   bool
+		expBlocks func(blocks []types.SimulatedBlock)
+	}{
+		{
+			"fail - empty input",
+			types.SimOpts{},
+			nil,
+			false,
+			nil,
+		},
+		{
+			"fail - block numbers not in order",
+			types.SimOpts{BlockStateCalls: []types.SimBlock{
+				{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))}},
+				{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(999))}},
+			}},
+			nil,
+			false,
+			nil,
+		},
+		{
+			"pass - precompile state changes are visible to later calls",
+			nil,
+			func() interface{} {
+				valAddr := suite.network.GetValidators()[0].OperatorAddress
+				delegateInput, err := stakingABI.Pack(staking.DelegateMethod, sender, valAddr, big.NewInt(1e18))
+				suite.Require().NoError(err)
+				delegationInput, err := stakingABI.Pack(staking.DelegationMethod, sender, valAddr)
+				suite.Require().NoError(err)
+
+				return types.SimOpts{BlockStateCalls: []types.SimBlock{
+					{
+						Calls: []types.TransactionArgs{
+							{From: &sender, To: &stakingAddr, Input: (*hexutil.Bytes)(&delegationInput)},
+							{From: &sender, To: &stakingAddr, Input: (*hexutil.Bytes)(&delegateInput)},
+							{From: &sender, To: &stakingAddr, Input: (*hexutil.Bytes)(&delegationInput)},
+						},
+					},
+				}}
+			},
+			true,
+			func(blocks []types.SimulatedBlock) {
+				suite.Require().Len(blocks, 1)
+				for _, call := range blocks[0].Calls {
+					suite.Require().Empty(call.VmError)
+				}
+
+				before, err := stakingABI.Unpack(staking.DelegationMethod, blocks[0].Calls[0].Ret)
+				suite.Require().NoError(err)
+				after, err := stakingABI.Unpack(staking.DelegationMethod, blocks[0].Calls[2].Ret)
+				suite.Require().NoError(err)
+				sharesBefore, ok := before[0].(*big.Int)
+				suite.Require().True(ok)
+				sharesAfter, ok := after[0].(*big.Int)
+				suite.Require().True(ok)
+				suite.Require().Equal(1, sharesAfter.Cmp(sharesBefore), "expected delegation shares to increase")
+			},
+		},
+		{
+			"pass - later calls see the state of previous calls",
+			types.SimOpts{BlockStateCalls: []types.SimBlock{
+				{
+					StateOverrides: types.StateOverride{contractAddr: {Code: &storageCode}},
+					Calls: []types.TransactionArgs{
+						{From: &sender, To: &contractAddr, Input: &storeData},
+						{From: &sender, To: &contractAddr},
+					},
+				},
+				{
+					Calls: []types.TransactionArgs{
+						{From: &sender, To: &contractAddr},
+					},
+				},
+			}},
+			nil,
+			true,
+			func(blocks []types.SimulatedBlock) {
+				suite.Require().Len(blocks, 2)
+				suite.Require().Len(blocks[0].Calls, 2)
+				suite.Require().Empty(blocks[0].Calls[0].VmError)
+				suite.Require().Equal(storedValue.Bytes(), blocks[0].Calls[1].Ret)
+				suite.Require().Equal(storedValue.Bytes(), blocks[1].Calls[0].Ret)
+				suite.Require().Equal(blocks[0].Number+1, blocks[1].Number)
+				suite.Require().Equal(
+					blocks[0].Calls[0].GasUsed+blocks[0].Calls[1].GasUsed,
+					blocks[0].GasUsed,
+				)
+			},
+		},
+		{
+			"pass - block overrides and reverted call",
+			types.SimOpts{BlockStateCalls: []types.SimBlock{
+				{
+					BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))},
+					StateOverrides: types.StateOverride{
+						contractAddr: {Code: &numberCode},
+						revertAddr:   {Code: &revertCode},
+					},
+					Calls: []types.TransactionArgs{
+						{From: &sender, To: &contractAddr},
+						{From: &sender, To: &revertAddr},
+					},
+				},
+			}},
+			nil,
+			true,
+			func(blocks []types.SimulatedBlock) {
+				suite.Require().Len(blocks, 1)
+				suite.Require().Equal(uint64(1000), blocks[0].Number)
+				suite.Require().Equal(common.BigToHash(big.NewInt(1000)).Bytes(), blocks[0].Calls[0].Ret)
+				suite.Require().Equal(vm.ErrExecutionReverted.Error(), blocks[0].Calls[1].VmError)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.opts = tc.malleate()
+			}
+			opts, err := json.Marshal(tc.opts)
+			suite.Require().NoError(err)
+
+			req := &types.SimulateV1Request{Opts: opts, GasCap: config.DefaultGasCap}
+			res, err := suite.network.GetEvmClient().SimulateV1(suite.network.GetContext(), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			tc.expBlocks(res.Blocks)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	suite.SetupTest()
 	k := suite.network.App.EvmKeeper
@@ -1717,6 +2153,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(suite.network.GetContext(), nil)
 			},
 		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(suite.network.GetContext(), nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
@@ -1729,6 +2171,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.network.GetContext(), nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.network.GetContext(), nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
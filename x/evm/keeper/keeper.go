@@ -269,7 +269,7 @@ func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 // - `0`: london hardfork enabled but feemarket is not enabled.
 // - `n`: both london hardfork and feemarket are enabled.
 func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
-	ethCfg := types.GetEthChainConfig()
+	ethCfg := k.GetParams(ctx).EthereumConfig(types.GetEthChainConfig())
 	if !types.IsLondon(ethCfg, ctx.BlockHeight()) {
 		return nil
 	}
@@ -9,7 +9,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -49,6 +51,35 @@ func (suite *KeeperTestSuite) TestEthereumTx() {
 			},
 			nil,
 		},
+		{
+			"fail - set code tx before prague",
+			func() *types.MsgEthereumTx {
+				ctx := suite.network.GetContext()
+				params := suite.network.App.EvmKeeper.GetParams(ctx)
+				params.PragueBlock = nil
+				err := suite.network.App.EvmKeeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+
+				recipient := suite.keyring.GetAddr(1)
+				txData, err := types.NewSetCodeTx(&ethutils.SetCodeTx{
+					ChainID:   suite.network.GetEIP155ChainID(),
+					GasTipCap: big.NewInt(1),
+					GasFeeCap: big.NewInt(1),
+					Gas:       100_000,
+					To:        recipient,
+					Value:     common.Big0,
+					AuthList:  []ethutils.SetCodeAuthorization{{ChainID: common.Big0, Address: recipient}},
+					V:         common.Big0,
+					R:         common.Big0,
+					S:         common.Big0,
+				})
+				suite.Require().NoError(err)
+				anyData, err := types.PackTxData(txData)
+				suite.Require().NoError(err)
+				return &types.MsgEthereumTx{Data: anyData, From: suite.keyring.GetAccAddr(0).String()}
+			},
+			types.ErrSetCodeTxNotEnabled,
+		},
 	}
 
 	for _, tc := range testCases {
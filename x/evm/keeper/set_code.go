@@ -0,0 +1,70 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// applyAuthorization checks the EIP-7702 authorization against the current
+// state and, if it is valid, sets the code delegation of its authority and
+// increments the authority nonce.
+func applyAuthorization(stateDB *statedb.StateDB, chainID *big.Int, auth ethutils.SetCodeAuthorization) error {
+	authority, err := validateAuthorization(stateDB, chainID, auth)
+	if err != nil {
+		return err
+	}
+
+	// the intrinsic gas charged assumes the authority doesn't exist, so the
+	// difference is refunded otherwise
+	if stateDB.Exist(authority) {
+		stateDB.AddRefund(types.PerEmptyAccountCost - types.PerAuthBaseCost)
+	}
+
+	stateDB.SetDelegation(authority, auth.Address)
+	stateDB.SetNonce(authority, auth.Nonce+1)
+	return nil
+}
+
+// validateAuthorization checks the authorization and returns its authority.
+func validateAuthorization(stateDB *statedb.StateDB, chainID *big.Int, auth ethutils.SetCodeAuthorization) (common.Address, error) {
+	// the authorization is valid on any chain if its chain ID is zero
+	if auth.ChainID.Sign() != 0 && auth.ChainID.Cmp(chainID) != 0 {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidAuthorization, "invalid chain id %s", auth.ChainID)
+	}
+
+	if auth.Nonce+1 < auth.Nonce {
+		return common.Address{}, errorsmod.Wrap(types.ErrInvalidAuthorization, "nonce overflow")
+	}
+
+	authority, err := auth.Authority()
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(types.ErrInvalidAuthorization, err.Error())
+	}
+
+	// the authority is accessed regardless of the outcome of the checks below
+	stateDB.AddAddressToAccessList(authority)
+
+	// only EOAs, optionally already delegated, can set their code delegation
+	code := stateDB.GetCode(authority)
+	if _, ok := vm.ParseDelegation(code); len(code) != 0 && !ok {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidAuthorization, "authority %s has code", authority)
+	}
+
+	if nonce := stateDB.GetNonce(authority); nonce != auth.Nonce {
+		return common.Address{}, errorsmod.Wrapf(
+			types.ErrInvalidAuthorization,
+			"invalid nonce for authority %s; expected %d, got %d", authority, nonce, auth.Nonce,
+		)
+	}
+
+	return authority, nil
+}
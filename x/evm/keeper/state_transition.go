@@ -50,6 +50,7 @@ func (k *Keeper) NewEVM(
 		BaseFee:     cfg.BaseFee,
 		Random:      nil, // not supported
 	}
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	txCtx := evmoscore.NewEVMTxContext(msg)
 	if tracer == nil {
@@ -146,8 +147,6 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
-	var bloom *big.Int
-
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
@@ -161,6 +160,38 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
 
+	return k.applyTransactionMessage(ctx, msg, cfg, txConfig)
+}
+
+// ApplySetCodeTransaction runs and attempts to perform a state transition with
+// the given EIP-7702 set code transaction, in the same way as ApplyTransaction.
+// The authorizations of the transaction are applied before its execution.
+func (k *Keeper) ApplySetCodeTransaction(ctx sdk.Context, ethMsg *types.MsgEthereumTx) (*types.MsgEthereumTxResponse, error) {
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+	txConfig := k.TxConfig(ctx, ethMsg.TxHash())
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	msg, err := ethMsg.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to return set code transaction as core message")
+	}
+
+	return k.applyTransactionMessage(ctx, msg, cfg, txConfig)
+}
+
+// applyTransactionMessage applies the core message of a transaction and
+// handles the gas refund, the block bloom and the transient tx values.
+func (k *Keeper) applyTransactionMessage(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	var bloom *big.Int
+
 	// Create a cache context to revert state. The cache context is only committed when both tx and hooks executed successfully.
 	// Didn't use `Snapshot` because the context stack has exponential complexity on certain operations,
 	// thus restricted to be used only inside `ApplyMessage`.
@@ -268,13 +299,34 @@ func (k *Keeper) ApplyMessageWithConfig(
 	commit bool,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	stateDB := statedb.New(ctx, k, txConfig)
+	if cfg.Overrides != nil {
+		if err := cfg.Overrides.Apply(stateDB); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply state overrides")
+		}
+	}
+	return k.applyMessageWithStateDB(ctx, msg, tracer, commit, cfg, txConfig, stateDB)
+}
+
+// applyMessageWithStateDB computes the new state by applying the given message
+// on top of the provided StateDB. It allows executing several messages over the
+// same StateDB, so that each message sees the state changes of the previous ones
+// (e.g. eth_simulateV1).
+func (k *Keeper) applyMessageWithStateDB(
+	ctx sdk.Context,
+	msg core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	stateDB *statedb.StateDB,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
 	)
 
-	stateDB := statedb.New(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -292,6 +344,14 @@ func (k *Keeper) ApplyMessageWithConfig(
 	contractCreation := msg.To() == nil
 	isLondon := cfg.ChainConfig.IsLondon(evm.Context.BlockNumber)
 
+	// EIP-7702 set code messages, along with the intrinsic gas of their
+	// authorizations, are only enabled from the Prague block. They should
+	// have already been rejected on Ante Handler, but eth_call doesn't go
+	// through it.
+	if _, ok := msg.(types.SetCodeMessage); ok && !evm.Config.Prague {
+		return nil, errorsmod.Wrapf(types.ErrSetCodeTxNotEnabled, "block height %d", ctx.BlockHeight())
+	}
+
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
@@ -305,6 +365,11 @@ func (k *Keeper) ApplyMessageWithConfig(
 	}
 	leftoverGas -= intrinsicGas
 
+	// EIP-3860: the initcode of contract creations is limited to MaxInitCodeSize
+	if contractCreation && cfg.ChainConfig.IsShanghai(evm.Context.BlockNumber) && len(msg.Data()) > vm.MaxInitCodeSize {
+		return nil, errorsmod.Wrapf(vm.ErrMaxInitCodeSizeExceeded, "code size %d limit %d", len(msg.Data()), vm.MaxInitCodeSize)
+	}
+
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
 	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
@@ -314,6 +379,22 @@ func (k *Keeper) ApplyMessageWithConfig(
 		stateDB.PrepareAccessList(msg.From(), msg.To(), []common.Address{}, msg.AccessList())
 	}
 
+	// apply the EIP-7702 authorizations before the execution, so that the
+	// delegations are in place when the recipient is called
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		for _, auth := range setCodeMsg.AuthorizationList {
+			// invalid authorizations are skipped without failing the transaction
+			if err := applyAuthorization(stateDB, cfg.ChainConfig.ChainID, auth); err != nil {
+				k.Logger(ctx).Debug("skipped set code authorization", "error", err.Error())
+			}
+		}
+
+		// the delegation target of the recipient is warm for the call
+		if target, ok := stateDB.GetDelegation(*msg.To()); ok {
+			stateDB.AddAddressToAccessList(target)
+		}
+	}
+
 	if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
@@ -3,6 +3,7 @@
 package keeper_test
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 	"math"
 	"math/big"
@@ -19,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
@@ -26,7 +28,10 @@ import (
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/keeper"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
 	"github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
 )
@@ -251,6 +256,24 @@ func (suite *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			true,
 			params.TxGas + params.TxDataNonZeroGasEIP2028*1,
 		},
+		{
+			"with 33 zero data, no accesslist, is contract creation, is homestead, is istanbul, not shanghai",
+			make([]byte, 33),
+			nil,
+			3,
+			true,
+			true,
+			params.TxGasContractCreation + params.TxDataZeroGas*33,
+		},
+		{
+			"with 33 zero data, no accesslist, is contract creation, is homestead, is istanbul, is shanghai",
+			make([]byte, 33),
+			nil,
+			4,
+			true,
+			true,
+			params.TxGasContractCreation + params.TxDataZeroGas*33 + vm.InitCodeWordGas*2,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -258,6 +281,7 @@ func (suite *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			ethCfg := types.GetEthChainConfig()
 			ethCfg.HomesteadBlock = big.NewInt(2)
 			ethCfg.IstanbulBlock = big.NewInt(3)
+			ethCfg.ShanghaiBlock = big.NewInt(4)
 			signer := gethtypes.LatestSignerForChainID(types.GetEthChainConfig().ChainID)
 
 			ctx := suite.network.GetContext().WithBlockHeight(tc.height)
@@ -767,6 +791,211 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestApplyMessageWithConfigSetCode() {
+	suite.SetupTest()
+
+	chainID := suite.network.GetEIP155ChainID()
+	target := utiltx.GenerateAddress()
+	// the intrinsic gas of a call with a single authorization
+	gasLimit := params.TxGas + types.PerEmptyAccountCost
+
+	testCases := []struct {
+		name string
+		// malleate returns the authorization signed by the given key,
+		// optionally modifying the state of its authority
+		malleate        func(key *ecdsa.PrivateKey) ethutils.SetCodeAuthorization
+		expDelegation   bool
+		expectedGasUsed uint64
+	}{
+		{
+			"success - delegation of a new account",
+			func(key *ecdsa.PrivateKey) ethutils.SetCodeAuthorization {
+				return signAuthorization(suite, key, chainID, target, 0)
+			},
+			true,
+			gasLimit,
+		},
+		{
+			"success - delegation of an existing account is refunded",
+			func(key *ecdsa.PrivateKey) ethutils.SetCodeAuthorization {
+				authority := crypto.PubkeyToAddress(key.PublicKey)
+				err := suite.network.App.EvmKeeper.SetAccount(suite.network.GetContext(), authority, statedb.Account{
+					Nonce:    3,
+					Balance:  big.NewInt(1),
+					CodeHash: types.EmptyCodeHash,
+				})
+				suite.Require().NoError(err)
+				return signAuthorization(suite, key, chainID, target, 3)
+			},
+			true,
+			// the refund is capped to a fifth of the gas used
+			gasLimit - gasLimit/params.RefundQuotientEIP3529,
+		},
+		{
+			"success - authorization valid on any chain",
+			func(key *ecdsa.PrivateKey) ethutils.SetCodeAuthorization {
+				return signAuthorization(suite, key, common.Big0, target, 0)
+			},
+			true,
+			gasLimit,
+		},
+		{
+			"skipped - authorization for another chain",
+			func(key *ecdsa.PrivateKey) ethutils.SetCodeAuthorization {
+				return signAuthorization(suite, key, new(big.Int).Add(chainID, common.Big1), target, 0)
+			},
+			false,
+			gasLimit,
+		},
+		{
+			"skipped - authorization with an invalid nonce",
+			func(key *ecdsa.PrivateKey) ethutils.SetCodeAuthorization {
+				return signAuthorization(suite, key, chainID, target, 1)
+			},
+			false,
+			gasLimit,
+		},
+		{
+			"skipped - authority with code",
+			func(key *ecdsa.PrivateKey) ethutils.SetCodeAuthorization {
+				authority := crypto.PubkeyToAddress(key.PublicKey)
+				code := []byte("contract code")
+				codeHash := crypto.Keccak256(code)
+				suite.network.App.EvmKeeper.SetCode(suite.network.GetContext(), codeHash, code)
+				err := suite.network.App.EvmKeeper.SetAccount(suite.network.GetContext(), authority, statedb.Account{
+					Balance:  new(big.Int),
+					CodeHash: codeHash,
+				})
+				suite.Require().NoError(err)
+				return signAuthorization(suite, key, chainID, target, 0)
+			},
+			false,
+			gasLimit,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			key, err := crypto.GenerateKey()
+			suite.Require().NoError(err)
+			authority := crypto.PubkeyToAddress(key.PublicKey)
+			auth := tc.malleate(key)
+			nonceBefore := suite.network.App.EvmKeeper.GetNonce(ctx, authority)
+
+			sender := suite.keyring.GetAddr(0)
+			recipient := utiltx.GenerateAddress()
+			msg := types.SetCodeMessage{
+				Message: gethtypes.NewMessage(
+					sender,
+					&recipient,
+					suite.network.App.EvmKeeper.GetNonce(ctx, sender),
+					common.Big0,
+					gasLimit,
+					common.Big0,
+					common.Big0,
+					common.Big0,
+					nil,
+					nil,
+					false,
+				),
+				AuthorizationList: []ethutils.SetCodeAuthorization{auth},
+			}
+
+			proposerAddress := ctx.BlockHeader().ProposerAddress
+			config, err := suite.network.App.EvmKeeper.EVMConfig(ctx, proposerAddress)
+			suite.Require().NoError(err)
+			txConfig := suite.network.App.EvmKeeper.TxConfig(ctx, common.Hash{})
+
+			// Function being tested
+			res, err := suite.network.App.EvmKeeper.ApplyMessageWithConfig(ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed())
+			suite.Require().Equal(tc.expectedGasUsed, res.GasUsed)
+
+			codeHash := suite.network.App.EvmKeeper.GetCodeHash(ctx, authority)
+			code := suite.network.App.EvmKeeper.GetCode(ctx, codeHash)
+			delegation, ok := vm.ParseDelegation(code)
+			if tc.expDelegation {
+				suite.Require().True(ok)
+				suite.Require().Equal(target, delegation)
+				suite.Require().Equal(nonceBefore+1, suite.network.App.EvmKeeper.GetNonce(ctx, authority))
+			} else {
+				suite.Require().False(ok)
+				suite.Require().Equal(nonceBefore, suite.network.App.EvmKeeper.GetNonce(ctx, authority))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageWithConfigSetCodeBeforePrague() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	// enable the Prague rules from the next block
+	evmParams := suite.network.App.EvmKeeper.GetParams(ctx)
+	pragueBlock := sdkmath.NewInt(ctx.BlockHeight() + 1)
+	evmParams.PragueBlock = &pragueBlock
+	err := suite.network.App.EvmKeeper.SetParams(ctx, evmParams)
+	suite.Require().NoError(err)
+
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	authority := crypto.PubkeyToAddress(key.PublicKey)
+	auth := signAuthorization(suite, key, suite.network.GetEIP155ChainID(), utiltx.GenerateAddress(), 0)
+
+	sender := suite.keyring.GetAddr(0)
+	recipient := utiltx.GenerateAddress()
+	msg := types.SetCodeMessage{
+		Message: gethtypes.NewMessage(
+			sender,
+			&recipient,
+			suite.network.App.EvmKeeper.GetNonce(ctx, sender),
+			common.Big0,
+			params.TxGas+types.PerEmptyAccountCost,
+			common.Big0,
+			common.Big0,
+			common.Big0,
+			nil,
+			nil,
+			false,
+		),
+		AuthorizationList: []ethutils.SetCodeAuthorization{auth},
+	}
+
+	config, err := suite.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	suite.Require().NoError(err)
+	txConfig := suite.network.App.EvmKeeper.TxConfig(ctx, common.Hash{})
+
+	_, err = suite.network.App.EvmKeeper.ApplyMessageWithConfig(ctx, msg, nil, true, config, txConfig)
+	suite.Require().ErrorIs(err, types.ErrSetCodeTxNotEnabled)
+
+	// the authorization is not applied
+	codeHash := suite.network.App.EvmKeeper.GetCodeHash(ctx, authority)
+	_, ok := vm.ParseDelegation(suite.network.App.EvmKeeper.GetCode(ctx, codeHash))
+	suite.Require().False(ok)
+}
+
+// signAuthorization signs an EIP-7702 authorization delegating the code of
+// the key address to the target.
+func signAuthorization(
+	suite *KeeperTestSuite,
+	key *ecdsa.PrivateKey,
+	chainID *big.Int,
+	target common.Address,
+	nonce uint64,
+) ethutils.SetCodeAuthorization {
+	auth, err := ethutils.SignSetCode(key, ethutils.SetCodeAuthorization{
+		ChainID: chainID,
+		Address: target,
+		Nonce:   nonce,
+	})
+	suite.Require().NoError(err)
+	return auth
+}
+
 func (suite *KeeperTestSuite) TestGetProposerAddress() {
 	suite.SetupTest()
 	address := sdk.ConsAddress(suite.keyring.GetAddr(0).Bytes())
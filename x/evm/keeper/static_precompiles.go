@@ -9,28 +9,39 @@ import (
 	"slices"
 
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/common"
+	authzprecompile "github.com/evmos/evmos/v20/precompiles/authz"
 	bankprecompile "github.com/evmos/evmos/v20/precompiles/bank"
 	"github.com/evmos/evmos/v20/precompiles/bech32"
 	distprecompile "github.com/evmos/evmos/v20/precompiles/distribution"
+	epochsprecompile "github.com/evmos/evmos/v20/precompiles/epochs"
+	erc20moduleprecompile "github.com/evmos/evmos/v20/precompiles/erc20module"
 	evidenceprecompile "github.com/evmos/evmos/v20/precompiles/evidence"
+	feegrantprecompile "github.com/evmos/evmos/v20/precompiles/feegrant"
 	govprecompile "github.com/evmos/evmos/v20/precompiles/gov"
+	icaprecompile "github.com/evmos/evmos/v20/precompiles/ica"
 	ics20precompile "github.com/evmos/evmos/v20/precompiles/ics20"
 	"github.com/evmos/evmos/v20/precompiles/p256"
 	slashingprecompile "github.com/evmos/evmos/v20/precompiles/slashing"
 	stakingprecompile "github.com/evmos/evmos/v20/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v20/precompiles/vesting"
+	epochskeeper "github.com/evmos/evmos/v20/x/epochs/keeper"
 	erc20Keeper "github.com/evmos/evmos/v20/x/erc20/keeper"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/types"
+	ibccallbackskeeper "github.com/evmos/evmos/v20/x/ibc/callbacks/keeper"
 	transferkeeper "github.com/evmos/evmos/v20/x/ibc/transfer/keeper"
+	inflationkeeper "github.com/evmos/evmos/v20/x/inflation/v1/keeper"
 	stakingkeeper "github.com/evmos/evmos/v20/x/staking/keeper"
 	vestingkeeper "github.com/evmos/evmos/v20/x/vesting/keeper"
 )
@@ -48,9 +59,15 @@ func NewAvailableStaticPrecompiles(
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	ibcCallbacksKeeper ibccallbackskeeper.Keeper,
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
 	evidenceKeeper evidencekeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
+	epochsKeeper epochskeeper.Keeper,
+	inflationKeeper inflationkeeper.Keeper,
+	cdc codec.Codec,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -81,6 +98,7 @@ func NewAvailableStaticPrecompiles(
 		stakingKeeper,
 		transferKeeper,
 		channelKeeper,
+		ibcCallbacksKeeper,
 		authzKeeper,
 	)
 	if err != nil {
@@ -97,7 +115,7 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
 
-	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, authzKeeper)
+	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, cdc, authzKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate gov precompile: %w", err))
 	}
@@ -112,9 +130,36 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate evidence precompile: %w", err))
 	}
 
+	authzPrecompile, err := authzprecompile.NewPrecompile(authzKeeper, cdc)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
+	icaPrecompile, err := icaprecompile.NewPrecompile(icaControllerKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate ICA precompile: %w", err))
+	}
+
+	erc20ModulePrecompile, err := erc20moduleprecompile.NewPrecompile(erc20Keeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate erc20 module precompile: %w", err))
+	}
+
+	epochsPrecompile, err := epochsprecompile.NewPrecompile(epochsKeeper, inflationKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate epochs precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
+	// BLS12-381 precompiles as per EIP-2537
+	maps.Copy(precompiles, vm.PrecompiledContractsBLS)
 
 	// Stateful precompiles
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
@@ -125,6 +170,11 @@ func NewAvailableStaticPrecompiles(
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
 	precompiles[evidencePrecompile.Address()] = evidencePrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
+	precompiles[icaPrecompile.Address()] = icaPrecompile
+	precompiles[erc20ModulePrecompile.Address()] = erc20ModulePrecompile
+	precompiles[epochsPrecompile.Address()] = epochsPrecompile
 
 	return precompiles
 }
@@ -46,4 +46,8 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides of the accounts state, only used on simulations (e.g. eth_call)
+	Overrides types.StateOverride
+	// BlockOverrides of the block context, only used on simulations (e.g. eth_call)
+	BlockOverrides *types.BlockOverrides
 }
@@ -65,6 +65,12 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	// newContract is set when the account is created within the current
+	// transaction, which is required by the EIP-6780 SELFDESTRUCT semantics
+	newContract bool
+	// fakeStorage is set when the whole account storage is overridden,
+	// preventing the committed state to be loaded from the keeper
+	fakeStorage bool
 }
 
 // newObject creates a state object.
@@ -221,6 +227,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.fakeStorage {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
@@ -254,3 +263,14 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// setStorage replaces the committed storage of the account with the provided
+// one and drops any dirty state.
+func (s *stateObject) setStorage(storage map[common.Hash]common.Hash) {
+	s.originStorage = make(Storage, len(storage))
+	for key, value := range storage {
+		s.originStorage[key] = value
+	}
+	s.dirtyStorage = make(Storage)
+	s.fakeStorage = true
+}
@@ -61,6 +61,9 @@ type StateDB struct {
 	// Per-transaction access list
 	accessList *accessList
 
+	// Transient storage (EIP-1153), discarded at the end of every transaction
+	transientStorage transientStorage
+
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
 }
@@ -74,6 +77,8 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		journal:      newJournal(),
 		accessList:   newAccessList(),
 
+		transientStorage: newTransientStorage(),
+
 		txConfig: txConfig,
 	}
 }
@@ -124,6 +129,22 @@ func (s *StateDB) cache() error {
 	return nil
 }
 
+// SetTxConfig sets the config of the next transaction executed on the StateDB
+// and resets the per-transaction state (logs, refund counter, access list,
+// transient storage and precompile calls counter). It allows executing several
+// messages on top of the same StateDB, e.g. on multi-call simulations.
+func (s *StateDB) SetTxConfig(txConfig TxConfig) {
+	s.txConfig = txConfig
+	s.logs = nil
+	s.refund = 0
+	s.accessList = newAccessList()
+	s.transientStorage = newTransientStorage()
+	s.precompileCallsCounter = 0
+	for _, obj := range s.stateObjects {
+		obj.newContract = false
+	}
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.journal.append(addLogChange{})
@@ -313,6 +334,7 @@ func (s *StateDB) CreateAccount(addr common.Address) {
 	if prev != nil {
 		newObj.setBalance(prev.account.Balance)
 	}
+	newObj.newContract = true
 }
 
 // ForEachStorage iterate the contract storage, the iteration order is not defined.
@@ -373,6 +395,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(new(big.Int).Set(amount))
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -389,6 +419,23 @@ func (s *StateDB) SetCode(addr common.Address, code []byte) {
 	}
 }
 
+// SetDelegation sets the code of the account to the EIP-7702 delegation
+// designator of the target address. A zero target address clears the
+// delegation, resetting the account code hash to the empty one.
+func (s *StateDB) SetDelegation(addr, target common.Address) {
+	if target == (common.Address{}) {
+		s.SetCode(addr, nil)
+		return
+	}
+	s.SetCode(addr, vm.AddressToDelegation(target))
+}
+
+// GetDelegation returns the address the account delegates its code execution
+// to, if its code is an EIP-7702 delegation designator.
+func (s *StateDB) GetDelegation(addr common.Address) (common.Address, bool) {
+	return vm.ParseDelegation(s.GetCode(addr))
+}
+
 // SetState sets the contract state.
 func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -397,6 +444,19 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetStorage replaces the entire storage of the given account with the provided
+// one. Any storage slot not included is treated as empty.
+//
+// NOTE: it should only be used for simulations (e.g. state overrides) and the
+// StateDB must be discarded afterwards, since the slots dropped are not deleted
+// from the store on commit.
+func (s *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.setStorage(storage)
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -418,6 +478,46 @@ func (s *StateDB) Suicide(addr common.Address) bool {
 	return true
 }
 
+// Selfdestruct6780 marks the given account as suicided only if it was created
+// in the current transaction, following the EIP-6780 semantics. Otherwise it is
+// a no-op, since the balance has already been transferred to the beneficiary.
+func (s *StateDB) Selfdestruct6780(addr common.Address) {
+	stateObject := s.getStateObject(addr)
+	if stateObject == nil {
+		return
+	}
+	if stateObject.newContract {
+		s.Suicide(addr)
+	}
+}
+
+// SetTransientState sets transient storage for a given account. It
+// adds the change to the journal so that it can be rolled back
+// to its previous value if there is a revert.
+func (s *StateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	prev := s.GetTransientState(addr, key)
+	if prev == value {
+		return
+	}
+	s.journal.append(transientStorageChange{
+		account:  &addr,
+		key:      key,
+		prevalue: prev,
+	})
+	s.setTransientState(addr, key, value)
+}
+
+// setTransientState is a lower level setter for transient storage. It
+// is called during a revert to prevent modifications to the journal.
+func (s *StateDB) setTransientState(addr common.Address, key, value common.Hash) {
+	s.transientStorage.Set(addr, key, value)
+}
+
+// GetTransientState gets transient storage for a given account.
+func (s *StateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transientStorage.Get(addr, key)
+}
+
 // PrepareAccessList handles the preparatory steps for executing a state transition with
 // regards to both EIP-2929 and EIP-2930:
 //
@@ -6,10 +6,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/statedb"
+	"github.com/evmos/evmos/v20/x/evm/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -126,6 +128,93 @@ func (suite *StateDBTestSuite) TestAccountOverride() {
 	suite.Require().Equal(uint64(0), db.GetNonce(address))
 }
 
+func (suite *StateDBTestSuite) TestStateOverrides() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	key2 := common.BigToHash(big.NewInt(3))
+	value2 := common.BigToHash(big.NewInt(4))
+
+	// create a contract account with some state
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetCode(address, []byte("hello world"))
+	db.AddBalance(address, big.NewInt(100))
+	db.SetState(address, key1, value1)
+	db.SetState(address, key2, value2)
+	suite.Require().NoError(db.Commit())
+
+	nonce := hexutil.Uint64(5)
+	code := hexutil.Bytes("overridden code")
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	state := map[common.Hash]common.Hash{key1: value2}
+	stateDiff := map[common.Hash]common.Hash{key2: value1}
+
+	testCases := []struct {
+		name      string
+		overrides types.StateOverride
+		expPass   bool
+		malleate  func(*statedb.StateDB)
+	}{
+		{
+			"fail - both state and state diff",
+			types.StateOverride{address: {State: &state, StateDiff: &stateDiff}},
+			false,
+			nil,
+		},
+		{
+			"pass - account fields",
+			types.StateOverride{address: {Nonce: &nonce, Code: &code, Balance: &balance}},
+			true,
+			func(db *statedb.StateDB) {
+				suite.Require().Equal(uint64(nonce), db.GetNonce(address))
+				suite.Require().Equal([]byte(code), db.GetCode(address))
+				suite.Require().Equal(balance.ToInt(), db.GetBalance(address))
+				suite.Require().Equal(value1, db.GetState(address, key1))
+			},
+		},
+		{
+			"pass - replace the whole state",
+			types.StateOverride{address: {State: &state}},
+			true,
+			func(db *statedb.StateDB) {
+				suite.Require().Equal(value2, db.GetState(address, key1))
+				suite.Require().Equal(common.Hash{}, db.GetState(address, key2))
+				suite.Require().Equal(common.Hash{}, db.GetCommittedState(address, key2))
+			},
+		},
+		{
+			"pass - apply state diff",
+			types.StateOverride{address: {StateDiff: &stateDiff}},
+			true,
+			func(db *statedb.StateDB) {
+				suite.Require().Equal(value1, db.GetState(address, key1))
+				suite.Require().Equal(value1, db.GetState(address, key2))
+			},
+		},
+		{
+			"pass - new account",
+			types.StateOverride{address2: {Balance: &balance}},
+			true,
+			func(db *statedb.StateDB) {
+				suite.Require().True(db.Exist(address2))
+				suite.Require().Equal(balance.ToInt(), db.GetBalance(address2))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			err := tc.overrides.Apply(db)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			tc.malleate(db)
+		})
+	}
+}
+
 func (suite *StateDBTestSuite) TestDBError() {
 	testCases := []struct {
 		name     string
@@ -329,6 +418,14 @@ func (suite *StateDBTestSuite) TestRevertSnapshot() {
 			db.AddAddressToAccessList(address)
 			db.AddSlotToAccessList(address, v1)
 		}},
+		{"transient storage", func(db vm.StateDB) {
+			db.SetTransientState(address, v1, v3)
+		}},
+		{"selfdestruct 6780 of new contract", func(db vm.StateDB) {
+			db.CreateAccount(address3)
+			db.SetCode(address3, []byte("hello world"))
+			db.Selfdestruct6780(address3)
+		}},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
@@ -538,6 +635,96 @@ func (suite *StateDBTestSuite) TestRefund() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	rev1 := db.Snapshot()
+	db.SetTransientState(address, key, value1)
+
+	rev2 := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+	// transient storage is not visible on the regular storage
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+
+	db.RevertToSnapshot(rev2)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+
+	db.RevertToSnapshot(rev1)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	// transient storage is discarded on the next transaction
+	db.SetTransientState(address, key, value1)
+	db.SetTxConfig(emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	// transient storage is never persisted
+	keeper := NewMockKeeper()
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetTransientState(address, key, value1)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Empty(keeper.accounts)
+}
+
+func (suite *StateDBTestSuite) TestSelfdestruct6780() {
+	code := []byte("hello world")
+
+	testCases := []struct {
+		name        string
+		malleate    func(*statedb.StateDB)
+		expSuicided bool
+	}{
+		{
+			"existing contract - not deleted",
+			func(*statedb.StateDB) {},
+			false,
+		},
+		{
+			"contract created in the same transaction - deleted",
+			func(db *statedb.StateDB) {
+				db.CreateAccount(address)
+				db.SetCode(address, code)
+			},
+			true,
+		},
+		{
+			"contract created in a previous transaction of the same StateDB - not deleted",
+			func(db *statedb.StateDB) {
+				db.CreateAccount(address)
+				db.SetCode(address, code)
+				db.SetTxConfig(emptyTxConfig)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			keeper := NewMockKeeper()
+			{
+				db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+				db.SetCode(address, code)
+				db.AddBalance(address, big.NewInt(100))
+				suite.Require().NoError(db.Commit())
+			}
+
+			db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			tc.malleate(db)
+			db.Selfdestruct6780(address)
+			suite.Require().Equal(tc.expSuicided, db.HasSuicided(address))
+
+			suite.Require().NoError(db.Commit())
+			_, found := keeper.accounts[address]
+			suite.Require().Equal(!tc.expSuicided, found)
+		})
+	}
+}
+
 func (suite *StateDBTestSuite) TestIterateStorage() {
 	key1 := common.BigToHash(big.NewInt(1))
 	value1 := common.BigToHash(big.NewInt(2))
@@ -0,0 +1,114 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/types"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+)
+
+// AuthorizationList is an EIP-7702 authorization list that represents the
+// slice of the protobuf SetCodeAuthorizations.
+type AuthorizationList []SetCodeAuthorization
+
+// NewAuthorizationList creates a new protobuf-compatible AuthorizationList
+// from a list of EIP-7702 authorization tuples.
+func NewAuthorizationList(ethAuthList []ethutils.SetCodeAuthorization) AuthorizationList {
+	if ethAuthList == nil {
+		return nil
+	}
+
+	al := make(AuthorizationList, len(ethAuthList))
+	for i, auth := range ethAuthList {
+		al[i] = SetCodeAuthorization{
+			ChainID: sdkmath.NewIntFromBigInt(auth.ChainID),
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+			V:       new(big.Int).SetUint64(uint64(auth.V)).Bytes(),
+		}
+		if auth.R != nil {
+			al[i].R = auth.R.Bytes()
+		}
+		if auth.S != nil {
+			al[i].S = auth.S.Bytes()
+		}
+	}
+
+	return al
+}
+
+// ToEthAuthorizationList is an utility function to convert the protobuf
+// compatible AuthorizationList to a list of EIP-7702 authorization tuples.
+func (al AuthorizationList) ToEthAuthorizationList() []ethutils.SetCodeAuthorization {
+	ethAuthList := make([]ethutils.SetCodeAuthorization, len(al))
+	for i, auth := range al {
+		ethAuthList[i] = auth.ToEthAuthorization()
+	}
+	return ethAuthList
+}
+
+// ToEthAuthorization converts the authorization to an EIP-7702 authorization
+// tuple.
+func (a SetCodeAuthorization) ToEthAuthorization() ethutils.SetCodeAuthorization {
+	v, r, s := ethutils.RawSignatureValues(a.V, a.R, a.S)
+
+	var yParity uint8
+	if v != nil && v.IsUint64() && v.Uint64() <= math.MaxUint8 {
+		yParity = uint8(v.Uint64())
+	}
+
+	chainID := a.ChainID.BigInt()
+	if chainID == nil {
+		chainID = common.Big0
+	}
+
+	return ethutils.SetCodeAuthorization{
+		ChainID: chainID,
+		Address: common.HexToAddress(a.Address),
+		Nonce:   a.Nonce,
+		V:       yParity,
+		R:       r,
+		S:       s,
+	}
+}
+
+// Authority recovers the address of the account that signed the
+// authorization.
+func (a SetCodeAuthorization) Authority() (common.Address, error) {
+	return a.ToEthAuthorization().Authority()
+}
+
+// Validate performs a stateless validation of the authorization fields. The
+// signature itself is verified when recovering the authority.
+func (a SetCodeAuthorization) Validate() error {
+	if err := types.ValidateAddress(a.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid authorization address")
+	}
+
+	if a.ChainID.IsNil() || a.ChainID.IsNegative() || !types.IsValidInt256(a.ChainID.BigInt()) {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "invalid chain id %s", a.ChainID)
+	}
+
+	// the nonce of the authority is incremented when the authorization is
+	// applied, so it can't be the maximum value
+	if a.Nonce == math.MaxUint64 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "nonce overflow")
+	}
+
+	v, r, s := ethutils.RawSignatureValues(a.V, a.R, a.S)
+	if v != nil && (!v.IsUint64() || v.Uint64() > 1) {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "invalid y parity %s", v)
+	}
+	if r == nil || s == nil || !types.IsValidInt256(r) || !types.IsValidInt256(s) {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "invalid signature values")
+	}
+
+	return nil
+}
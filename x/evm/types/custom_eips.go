@@ -0,0 +1,140 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+// MaxCustomEIPGasMultiplier is the maximum multiplier that a custom EIP can
+// apply to the gas of an opcode.
+const MaxCustomEIPGasMultiplier = uint64(100)
+
+// customEIPsJumpTable is the jump table used to validate the opcodes of the
+// custom EIPs. It is the table run by the EVM once the Cancun rules are
+// enabled, which includes the opcodes of all the previous forks (e.g. the
+// MCOPY dynamic gas is only defined from Cancun). On the previous tables, the
+// dynamic gas multiplier is a no-op for the opcodes without dynamic gas.
+var customEIPsJumpTable = &vm.CancunInstructionSet
+
+// customEIPActivators is the registry of the custom EIP types. Each entry
+// builds the jump table activator of a custom EIP from its opcodes and value.
+var customEIPActivators = map[CustomEIPType]func(opcodes []vm.OpCode, value uint64) func(*vm.JumpTable){
+	CustomEIPTypeConstantGasMultiplier: func(opcodes []vm.OpCode, value uint64) func(*vm.JumpTable) {
+		return func(jt *vm.JumpTable) {
+			for _, op := range opcodes {
+				gas, overflow := math.SafeMul(jt[op].GetConstantGas(), value)
+				if overflow {
+					gas = math.MaxUint64
+				}
+				jt[op].SetConstantGas(gas)
+			}
+		}
+	},
+	CustomEIPTypeConstantGasOverride: func(opcodes []vm.OpCode, value uint64) func(*vm.JumpTable) {
+		return func(jt *vm.JumpTable) {
+			for _, op := range opcodes {
+				jt[op].SetConstantGas(value)
+			}
+		}
+	},
+	CustomEIPTypeDynamicGasMultiplier: func(opcodes []vm.OpCode, value uint64) func(*vm.JumpTable) {
+		return func(jt *vm.JumpTable) {
+			for _, op := range opcodes {
+				jt[op].MultiplyDynamicGas(value)
+			}
+		}
+	},
+}
+
+// Validate performs a stateless validation of the custom EIP, checking its
+// name, type and value and that its opcodes exist in the EVM jump table.
+func (eip CustomEIP) Validate() error {
+	if err := vm.ValidateEIPName(eip.Name); err != nil {
+		return fmt.Errorf("custom EIP %s name is not valid: %w", eip.Name, err)
+	}
+	if vm.ExistsEipActivator(eip.Name) {
+		return fmt.Errorf("custom EIP %s is already registered as an EIP activator", eip.Name)
+	}
+	if _, ok := customEIPActivators[eip.EIPType]; !ok {
+		return fmt.Errorf("custom EIP %s has an invalid type: %s", eip.Name, eip.EIPType)
+	}
+
+	switch eip.EIPType {
+	case CustomEIPTypeConstantGasMultiplier, CustomEIPTypeDynamicGasMultiplier:
+		if eip.Value == 0 || eip.Value > MaxCustomEIPGasMultiplier {
+			return fmt.Errorf("custom EIP %s multiplier must be between 1 and %d, got %d", eip.Name, MaxCustomEIPGasMultiplier, eip.Value)
+		}
+	case CustomEIPTypeConstantGasOverride:
+		if eip.Value == 0 {
+			return fmt.Errorf("custom EIP %s gas override cannot be zero", eip.Name)
+		}
+	}
+
+	if len(eip.Opcodes) == 0 {
+		return fmt.Errorf("custom EIP %s has no opcodes", eip.Name)
+	}
+
+	opcodes, err := eip.OpCodes()
+	if err != nil {
+		return err
+	}
+	for i, op := range opcodes {
+		if slices.Contains(opcodes[:i], op) {
+			return fmt.Errorf("custom EIP %s has duplicate opcode %s", eip.Name, op)
+		}
+		if eip.EIPType == CustomEIPTypeDynamicGasMultiplier && !customEIPsJumpTable[op].HasDynamicGas() {
+			return fmt.Errorf("custom EIP %s opcode %s has no dynamic gas", eip.Name, op)
+		}
+	}
+
+	return nil
+}
+
+// OpCodes returns the EVM opcodes of the custom EIP, failing if any of its
+// opcode names is unknown.
+func (eip CustomEIP) OpCodes() ([]vm.OpCode, error) {
+	opcodes := make([]vm.OpCode, len(eip.Opcodes))
+	for i, name := range eip.Opcodes {
+		op := vm.StringToOp(name)
+		// StringToOp returns STOP (0x00) for the unknown names
+		if op == vm.STOP && name != vm.STOP.String() {
+			return nil, fmt.Errorf("custom EIP %s has unknown opcode %s", eip.Name, name)
+		}
+		opcodes[i] = op
+	}
+	return opcodes, nil
+}
+
+// Activator returns the function that applies the custom EIP to a jump table.
+func (eip CustomEIP) Activator() (func(*vm.JumpTable), error) {
+	newActivator, ok := customEIPActivators[eip.EIPType]
+	if !ok {
+		return nil, fmt.Errorf("custom EIP %s has an invalid type: %s", eip.Name, eip.EIPType)
+	}
+	opcodes, err := eip.OpCodes()
+	if err != nil {
+		return nil, err
+	}
+	return newActivator(opcodes, eip.Value), nil
+}
+
+// validateCustomEIPs checks that the custom EIPs are valid and have unique names.
+func validateCustomEIPs(eips []CustomEIP) error {
+	names := make(map[string]struct{}, len(eips))
+	for _, eip := range eips {
+		if _, ok := names[eip.Name]; ok {
+			return fmt.Errorf("found duplicate custom EIP: %s", eip.Name)
+		}
+		names[eip.Name] = struct{}{}
+
+		if err := eip.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func TestCustomEIPValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		eip         CustomEIP
+		errContains string
+	}{
+		{
+			"pass - constant gas multiplier",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeConstantGasMultiplier, Opcodes: []string{"CALL", "CREATE"}, Value: 2},
+			"",
+		},
+		{
+			"pass - constant gas override",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeConstantGasOverride, Opcodes: []string{"SSTORE"}, Value: 500},
+			"",
+		},
+		{
+			"pass - dynamic gas multiplier",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeDynamicGasMultiplier, Opcodes: []string{"SSTORE"}, Value: 3},
+			"",
+		},
+		{
+			"pass - dynamic gas multiplier on a cancun opcode",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeDynamicGasMultiplier, Opcodes: []string{"MCOPY"}, Value: 3},
+			"",
+		},
+		{
+			"fail - invalid name",
+			CustomEIP{Name: "evmos", EIPType: CustomEIPTypeConstantGasOverride, Opcodes: []string{"SSTORE"}, Value: 500},
+			"name is not valid",
+		},
+		{
+			"fail - name of a registered activator",
+			CustomEIP{Name: "ethereum_3855", EIPType: CustomEIPTypeConstantGasOverride, Opcodes: []string{"SSTORE"}, Value: 500},
+			"already registered",
+		},
+		{
+			"fail - unspecified type",
+			CustomEIP{Name: "evmos_100", Opcodes: []string{"SSTORE"}, Value: 500},
+			"invalid type",
+		},
+		{
+			"fail - zero multiplier",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeConstantGasMultiplier, Opcodes: []string{"CALL"}},
+			"multiplier must be between",
+		},
+		{
+			"fail - multiplier too large",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeDynamicGasMultiplier, Opcodes: []string{"CALL"}, Value: MaxCustomEIPGasMultiplier + 1},
+			"multiplier must be between",
+		},
+		{
+			"fail - zero gas override",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeConstantGasOverride, Opcodes: []string{"SSTORE"}},
+			"cannot be zero",
+		},
+		{
+			"fail - no opcodes",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeConstantGasOverride, Value: 500},
+			"no opcodes",
+		},
+		{
+			"fail - unknown opcode",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeConstantGasOverride, Opcodes: []string{"sstore"}, Value: 500},
+			"unknown opcode",
+		},
+		{
+			"fail - duplicate opcode",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeConstantGasOverride, Opcodes: []string{"SSTORE", "SSTORE"}, Value: 500},
+			"duplicate opcode",
+		},
+		{
+			"fail - dynamic gas multiplier on opcode without dynamic gas",
+			CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeDynamicGasMultiplier, Opcodes: []string{"ADD"}, Value: 2},
+			"has no dynamic gas",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.eip.Validate()
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+func TestCustomEIPActivator(t *testing.T) {
+	jt := vm.CopyJumpTable(&vm.MergeInstructionSet)
+	callGas := jt[vm.CALL].GetConstantGas()
+	createGas := jt[vm.CREATE].GetConstantGas()
+
+	eips := []CustomEIP{
+		{Name: "evmos_100", EIPType: CustomEIPTypeConstantGasMultiplier, Opcodes: []string{"CALL", "CREATE"}, Value: 10},
+		{Name: "evmos_101", EIPType: CustomEIPTypeConstantGasOverride, Opcodes: []string{"SSTORE"}, Value: 500},
+	}
+	for _, eip := range eips {
+		activator, err := eip.Activator()
+		require.NoError(t, err)
+		activator(jt)
+	}
+
+	require.Equal(t, callGas*10, jt[vm.CALL].GetConstantGas())
+	require.Equal(t, createGas*10, jt[vm.CREATE].GetConstantGas())
+	require.Equal(t, uint64(500), jt[vm.SSTORE].GetConstantGas())
+	// the default jump table is not modified
+	require.Equal(t, callGas, vm.MergeInstructionSet[vm.CALL].GetConstantGas())
+}
+
+func TestParamsCustomEIPs(t *testing.T) {
+	callEIP := CustomEIP{Name: "evmos_100", EIPType: CustomEIPTypeConstantGasMultiplier, Opcodes: []string{"CALL"}, Value: 2}
+	sstoreEIP := CustomEIP{Name: "evmos_101", EIPType: CustomEIPTypeConstantGasOverride, Opcodes: []string{"SSTORE"}, Value: 500}
+
+	params := DefaultParams()
+	params.CustomEIPs = []CustomEIP{callEIP, sstoreEIP}
+	params.ExtraEIPs = []string{"ethereum_3855", sstoreEIP.Name}
+	require.NoError(t, params.Validate())
+	require.Equal(t, []CustomEIP{sstoreEIP}, params.ActiveCustomEIPs())
+	require.Len(t, params.CustomEIPActivators(), 2)
+
+	params.ExtraEIPs = []string{"evmos_102"}
+	require.ErrorContains(t, params.Validate(), "EIP evmos_102 is not activateable")
+
+	params.ExtraEIPs = nil
+	params.CustomEIPs = []CustomEIP{callEIP, callEIP}
+	require.ErrorContains(t, params.Validate(), "duplicate custom EIP")
+}
@@ -32,6 +32,8 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrInvalidAuthorization
+	codeErrSetCodeTxNotEnabled
 )
 
 var (
@@ -88,6 +90,12 @@ var (
 
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrInvalidAuthorization returns an error if an EIP-7702 authorization tuple is invalid
+	ErrInvalidAuthorization = errorsmod.Register(ModuleName, codeErrInvalidAuthorization, "invalid set code authorization")
+
+	// ErrSetCodeTxNotEnabled returns an error if an EIP-7702 set code transaction is sent before the Prague block
+	ErrSetCodeTxNotEnabled = errorsmod.Register(ModuleName, codeErrSetCodeTxNotEnabled, "set code transactions not enabled")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -25,6 +25,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CustomEIPType defines the types of custom EIPs
+type CustomEIPType int32
+
+const (
+	// CUSTOM_EIP_TYPE_UNSPECIFIED defines an invalid custom EIP type
+	CustomEIPTypeUnspecified CustomEIPType = 0
+	// CUSTOM_EIP_TYPE_CONSTANT_GAS_MULTIPLIER multiplies the constant gas of the opcodes by the value
+	CustomEIPTypeConstantGasMultiplier CustomEIPType = 1
+	// CUSTOM_EIP_TYPE_CONSTANT_GAS_OVERRIDE sets the constant gas of the opcodes to the value
+	CustomEIPTypeConstantGasOverride CustomEIPType = 2
+	// CUSTOM_EIP_TYPE_DYNAMIC_GAS_MULTIPLIER multiplies the dynamic gas of the opcodes by the value
+	CustomEIPTypeDynamicGasMultiplier CustomEIPType = 3
+)
+
+var CustomEIPType_name = map[int32]string{
+	0: "CUSTOM_EIP_TYPE_UNSPECIFIED",
+	1: "CUSTOM_EIP_TYPE_CONSTANT_GAS_MULTIPLIER",
+	2: "CUSTOM_EIP_TYPE_CONSTANT_GAS_OVERRIDE",
+	3: "CUSTOM_EIP_TYPE_DYNAMIC_GAS_MULTIPLIER",
+}
+
+var CustomEIPType_value = map[string]int32{
+	"CUSTOM_EIP_TYPE_UNSPECIFIED":             0,
+	"CUSTOM_EIP_TYPE_CONSTANT_GAS_MULTIPLIER": 1,
+	"CUSTOM_EIP_TYPE_CONSTANT_GAS_OVERRIDE":   2,
+	"CUSTOM_EIP_TYPE_DYNAMIC_GAS_MULTIPLIER":  3,
+}
+
+func (x CustomEIPType) String() string {
+	return proto.EnumName(CustomEIPType_name, int32(x))
+}
+
+func (CustomEIPType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{0}
+}
+
 // AccessType defines the types of permissions for the operations
 type AccessType int32
 
@@ -54,7 +90,7 @@ func (x AccessType) String() string {
 }
 
 func (AccessType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{0}
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
 }
 
 // Params defines the EVM module parameters
@@ -71,6 +107,18 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the precompiled
 	// contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// custom_eips defines the chain-specific EIPs that tune the gas of the EVM
+	// opcodes, they are activated by adding their name to the extra_eips
+	CustomEIPs []CustomEIP `protobuf:"bytes,11,rep,name=custom_eips,json=customEips,proto3" json:"custom_eips"`
+	// shanghai_block defines the block from which the Shanghai rules are enabled
+	// on top of the chain config (nil = not enabled, 0 = enabled from genesis)
+	ShanghaiBlock *cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=shanghai_block,json=shanghaiBlock,proto3,customtype=cosmossdk.io/math.Int" json:"shanghai_block,omitempty" yaml:"shanghai_block"`
+	// cancun_block defines the block from which the Cancun rules are enabled
+	// on top of the chain config (nil = not enabled, 0 = enabled from genesis)
+	CancunBlock *cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=cancun_block,json=cancunBlock,proto3,customtype=cosmossdk.io/math.Int" json:"cancun_block,omitempty" yaml:"cancun_block"`
+	// prague_block defines the block from which the EIP-7702 set code transactions
+	// and code delegations are enabled (nil = not enabled, 0 = enabled from genesis)
+	PragueBlock *cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=prague_block,json=pragueBlock,proto3,customtype=cosmossdk.io/math.Int" json:"prague_block,omitempty" yaml:"prague_block"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -141,6 +189,87 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetCustomEIPs() []CustomEIP {
+	if m != nil {
+		return m.CustomEIPs
+	}
+	return nil
+}
+
+// CustomEIP defines a parameterized EIP that modifies the gas of a set of
+// opcodes of the EVM jump table
+type CustomEIP struct {
+	// name of the EIP, with the 'chainName_Number' format used by the extra_eips
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// eip_type defines how the value is applied to the gas of the opcodes
+	EIPType CustomEIPType `protobuf:"varint,2,opt,name=eip_type,json=eipType,proto3,enum=ethermint.evm.v1.CustomEIPType" json:"eip_type,omitempty"`
+	// opcodes is the list of opcode names (e.g. SSTORE) modified by the EIP
+	Opcodes []string `protobuf:"bytes,3,rep,name=opcodes,proto3" json:"opcodes,omitempty"`
+	// value is the multiplier or the gas override, depending on the EIP type
+	Value uint64 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *CustomEIP) Reset()         { *m = CustomEIP{} }
+func (m *CustomEIP) String() string { return proto.CompactTextString(m) }
+func (*CustomEIP) ProtoMessage()    {}
+func (*CustomEIP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *CustomEIP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomEIP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomEIP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CustomEIP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomEIP.Merge(m, src)
+}
+func (m *CustomEIP) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomEIP) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomEIP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomEIP proto.InternalMessageInfo
+
+func (m *CustomEIP) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CustomEIP) GetEIPType() CustomEIPType {
+	if m != nil {
+		return m.EIPType
+	}
+	return CustomEIPTypeUnspecified
+}
+
+func (m *CustomEIP) GetOpcodes() []string {
+	if m != nil {
+		return m.Opcodes
+	}
+	return nil
+}
+
+func (m *CustomEIP) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -154,7 +283,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +341,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +438,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +514,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +571,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +647,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +762,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +803,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{8}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +863,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{9}
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -841,8 +970,10 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 }
 
 func init() {
+	proto.RegisterEnum("ethermint.evm.v1.CustomEIPType", CustomEIPType_name, CustomEIPType_value)
 	proto.RegisterEnum("ethermint.evm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*CustomEIP)(nil), "ethermint.evm.v1.CustomEIP")
 	proto.RegisterType((*AccessControl)(nil), "ethermint.evm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "ethermint.evm.v1.AccessControlType")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
@@ -857,128 +988,147 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1932 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcd, 0x6e, 0x23, 0xc7,
-	0x11, 0x16, 0xa5, 0x91, 0x34, 0x6c, 0x52, 0xe4, 0xa8, 0xf5, 0xb3, 0x5c, 0xae, 0xa3, 0x51, 0x26,
-	0x41, 0xa0, 0x2c, 0x1c, 0x69, 0x57, 0x6b, 0x25, 0xc2, 0x3a, 0x7f, 0xa2, 0x96, 0x4e, 0xc4, 0x68,
-	0xd7, 0x42, 0x53, 0x8e, 0xe1, 0x20, 0xc1, 0xa0, 0x39, 0xd3, 0x26, 0xc7, 0x9a, 0x99, 0x26, 0xa6,
-	0x9b, 0x5c, 0x32, 0x4f, 0x60, 0xec, 0xc9, 0x79, 0x80, 0x0d, 0x0c, 0xe4, 0x92, 0xa3, 0x1f, 0x21,
-	0x47, 0xc3, 0x27, 0x1f, 0x83, 0x00, 0x19, 0x04, 0xdc, 0x83, 0x01, 0x1d, 0xf5, 0x04, 0x41, 0xff,
-	0xf0, 0x57, 0x5a, 0x45, 0xb9, 0x50, 0x5d, 0xd5, 0x55, 0xdf, 0x57, 0x55, 0x5d, 0x3d, 0xdd, 0x2d,
-	0x50, 0x26, 0xbc, 0x45, 0x92, 0x28, 0x88, 0xf9, 0x1e, 0xe9, 0x46, 0x7b, 0xdd, 0xc7, 0xe2, 0xcf,
-	0x6e, 0x3b, 0xa1, 0x9c, 0x42, 0x6b, 0x34, 0xb7, 0x2b, 0x94, 0xdd, 0xc7, 0xe5, 0x55, 0x1c, 0x05,
-	0x31, 0xdd, 0x93, 0xbf, 0xca, 0xa8, 0xbc, 0xde, 0xa4, 0x4d, 0x2a, 0x87, 0x7b, 0x62, 0xa4, 0xb4,
-	0xce, 0x5f, 0x17, 0xc0, 0xd2, 0x19, 0x4e, 0x70, 0xc4, 0xe0, 0x11, 0x00, 0xa4, 0xc7, 0x13, 0xec,
-	0x92, 0xa0, 0xcd, 0x4a, 0xc6, 0xf6, 0xc2, 0x4e, 0xb6, 0xe2, 0x0c, 0x52, 0x3b, 0x5b, 0x15, 0xda,
-	0xea, 0xc9, 0x19, 0xbb, 0x4a, 0xed, 0xd5, 0x3e, 0x8e, 0xc2, 0xa7, 0xce, 0xd8, 0xd0, 0x41, 0x59,
-	0x29, 0x54, 0x83, 0x36, 0x83, 0xfb, 0x60, 0x03, 0x87, 0x21, 0x7d, 0xe9, 0x76, 0x62, 0x01, 0x4f,
-	0x3c, 0x4e, 0x7c, 0x97, 0xf7, 0x58, 0x69, 0x69, 0x3b, 0xb3, 0x63, 0xa2, 0x35, 0x39, 0xf9, 0xd1,
-	0x78, 0xee, 0xbc, 0x27, 0x7c, 0xf2, 0xa4, 0x1b, 0xb9, 0x5e, 0x0b, 0xc7, 0x31, 0x09, 0x59, 0xc9,
-	0x94, 0xc4, 0xc5, 0x41, 0x6a, 0xe7, 0xaa, 0xbf, 0x7f, 0x7e, 0xac, 0xd5, 0x28, 0x47, 0xba, 0xd1,
-	0x50, 0x80, 0x7f, 0x02, 0x05, 0xec, 0x79, 0x84, 0x31, 0xd7, 0xa3, 0x31, 0x4f, 0x68, 0x58, 0xca,
-	0x6e, 0x67, 0x76, 0x72, 0xfb, 0xf6, 0xee, 0x6c, 0x25, 0x76, 0x8f, 0xa4, 0xdd, 0xb1, 0x32, 0xab,
-	0x6c, 0x7c, 0x9d, 0xda, 0x73, 0x83, 0xd4, 0x5e, 0x99, 0x52, 0xa3, 0x15, 0x3c, 0x29, 0xc2, 0xa7,
-	0xe0, 0x3e, 0xf6, 0x78, 0xd0, 0x25, 0x2e, 0xe3, 0x98, 0x07, 0x9e, 0xdb, 0x4e, 0x88, 0x47, 0xa3,
-	0x76, 0x10, 0x12, 0x56, 0x02, 0x22, 0x3e, 0x74, 0x4f, 0x19, 0xd4, 0xe5, 0xfc, 0xd9, 0x78, 0xfa,
-	0xe9, 0xbd, 0x57, 0xdf, 0x7d, 0xf5, 0x10, 0x92, 0x6e, 0x44, 0xd9, 0x5e, 0x4f, 0x2e, 0x95, 0x2a,
-	0x6f, 0xcd, 0x30, 0x33, 0xd6, 0x7c, 0xcd, 0x30, 0xe7, 0xad, 0x85, 0x9a, 0x61, 0x2e, 0x58, 0x46,
-	0xcd, 0x30, 0x17, 0xad, 0xa5, 0x9a, 0x61, 0x2e, 0x5b, 0x26, 0xca, 0x8a, 0x1a, 0xf8, 0x24, 0xa6,
-	0x11, 0xca, 0x7b, 0x2d, 0x1c, 0xc4, 0x22, 0xb3, 0x4f, 0x83, 0xa6, 0xf3, 0x97, 0x0c, 0x98, 0x0e,
-	0x16, 0x1e, 0x81, 0x25, 0x2f, 0x21, 0x98, 0x93, 0x52, 0x46, 0x26, 0xfd, 0x83, 0xff, 0x91, 0xf4,
-	0x79, 0xbf, 0x4d, 0x2a, 0x86, 0x48, 0x1c, 0x69, 0x47, 0xf8, 0x0b, 0x60, 0x78, 0x38, 0x0c, 0x4b,
-	0xf3, 0xff, 0x2f, 0x80, 0x74, 0x73, 0xfe, 0x9d, 0x01, 0xab, 0xd7, 0x2c, 0xa0, 0x07, 0x72, 0x7a,
-	0x51, 0x78, 0xbf, 0xad, 0x82, 0x2b, 0xec, 0xbf, 0xf3, 0x36, 0x6c, 0x09, 0xfa, 0xc3, 0x41, 0x6a,
-	0x83, 0xb1, 0x7c, 0x95, 0xda, 0x50, 0xf5, 0xd7, 0x04, 0x90, 0x83, 0x00, 0x1e, 0x59, 0x40, 0x0f,
-	0xac, 0x4d, 0xaf, 0xbc, 0x1b, 0x06, 0x8c, 0x97, 0xe6, 0x65, 0xd3, 0x3c, 0x19, 0xa4, 0xf6, 0x74,
-	0x60, 0xa7, 0x01, 0xe3, 0x57, 0xa9, 0x5d, 0x9e, 0x42, 0x9d, 0xf4, 0x74, 0xd0, 0x2a, 0x9e, 0x75,
-	0x70, 0xbe, 0x29, 0x82, 0xdc, 0xb1, 0x58, 0x84, 0x63, 0xb9, 0x06, 0xf0, 0x8f, 0xa0, 0xd8, 0xa2,
-	0x11, 0x61, 0x9c, 0x60, 0xdf, 0x6d, 0x84, 0xd4, 0xbb, 0x90, 0xd9, 0x65, 0x2b, 0x4f, 0xfe, 0x95,
-	0xda, 0x1b, 0x1e, 0x65, 0x11, 0x65, 0xcc, 0xbf, 0xd8, 0x0d, 0xe8, 0x5e, 0x84, 0x79, 0x6b, 0xf7,
-	0x24, 0x16, 0xa4, 0x9b, 0x8a, 0x74, 0xc6, 0xd3, 0x41, 0x85, 0x91, 0xa6, 0x22, 0x14, 0xb0, 0x05,
-	0x0a, 0x3e, 0xa6, 0xee, 0xa7, 0x34, 0xb9, 0xd0, 0xe0, 0xf3, 0x12, 0xbc, 0xf2, 0x56, 0xf0, 0x41,
-	0x6a, 0xe7, 0x9f, 0x1d, 0x7d, 0xf8, 0x01, 0x4d, 0x2e, 0x24, 0xc4, 0x55, 0x6a, 0x6f, 0x28, 0xb2,
-	0x69, 0x20, 0x07, 0xe5, 0x7d, 0x4c, 0x47, 0x66, 0xf0, 0x63, 0x60, 0x8d, 0x0c, 0x58, 0xa7, 0xdd,
-	0xa6, 0x09, 0x2f, 0x2d, 0x88, 0x9d, 0x59, 0xf9, 0xc9, 0x20, 0xb5, 0x0b, 0x1a, 0xb2, 0xae, 0x66,
-	0xae, 0x52, 0xfb, 0xde, 0x0c, 0xa8, 0xf6, 0x71, 0x50, 0x41, 0xc3, 0x6a, 0x53, 0xd8, 0x00, 0x79,
-	0x12, 0xb4, 0x1f, 0x1f, 0x3c, 0xd2, 0x09, 0x18, 0x32, 0x81, 0x5f, 0xdd, 0x96, 0x40, 0xae, 0x7a,
-	0x72, 0xf6, 0xf8, 0xe0, 0xd1, 0x30, 0xfe, 0x35, 0xfd, 0x5d, 0x99, 0x40, 0x71, 0x50, 0x4e, 0x89,
-	0x2a, 0xf8, 0x13, 0xa0, 0x45, 0xb7, 0x85, 0x59, 0xab, 0xb4, 0x28, 0x29, 0x76, 0x44, 0x03, 0x29,
-	0xa4, 0xdf, 0x62, 0xd6, 0x1a, 0x57, 0xbd, 0xd1, 0xff, 0x33, 0x8e, 0x79, 0xd0, 0x89, 0x86, 0x58,
-	0x40, 0x39, 0x0b, 0xab, 0x51, 0xb8, 0x07, 0x3a, 0xdc, 0xa5, 0xbb, 0x86, 0x7b, 0x70, 0x53, 0xb8,
-	0x07, 0xd3, 0xe1, 0x2a, 0x9b, 0x11, 0xc7, 0xa1, 0xe6, 0x58, 0xbe, 0x2b, 0xc7, 0xe1, 0x4d, 0x1c,
-	0x87, 0xd3, 0x1c, 0xca, 0x46, 0xf4, 0xe5, 0x4c, 0x9e, 0x25, 0xf3, 0xce, 0x7d, 0x79, 0xad, 0x42,
-	0x85, 0x91, 0x46, 0xa1, 0x5f, 0x80, 0x75, 0x8f, 0xc6, 0x8c, 0x0b, 0x5d, 0x4c, 0xdb, 0x21, 0xd1,
-	0x14, 0x59, 0x49, 0x71, 0x78, 0x1b, 0xc5, 0x03, 0x45, 0x71, 0x93, 0xbb, 0x83, 0xd6, 0xa6, 0xd5,
-	0x8a, 0xcc, 0x05, 0x56, 0x9b, 0x70, 0x92, 0xb0, 0x46, 0x27, 0x69, 0x6a, 0x22, 0x20, 0x89, 0xde,
-	0xbb, 0x8d, 0x48, 0x77, 0xe8, 0xac, 0xab, 0x83, 0x8a, 0x63, 0x95, 0x22, 0xf8, 0x04, 0x14, 0x02,
-	0xc1, 0xda, 0xe8, 0x84, 0x1a, 0x3e, 0x27, 0xe1, 0xf7, 0x6f, 0x83, 0xd7, 0xbb, 0x6a, 0xda, 0xd1,
-	0x41, 0x2b, 0x43, 0x85, 0x82, 0xf6, 0x01, 0x8c, 0x3a, 0x41, 0xe2, 0x36, 0x43, 0xec, 0x05, 0x24,
-	0xd1, 0xf0, 0x79, 0x09, 0xff, 0xd3, 0xdb, 0xe0, 0xef, 0x2b, 0xf8, 0xeb, 0xce, 0x0e, 0xb2, 0x84,
-	0xf2, 0x37, 0x4a, 0xa7, 0x58, 0xea, 0x20, 0xdf, 0x20, 0x49, 0x18, 0xc4, 0x1a, 0x7f, 0x45, 0xe2,
-	0x3f, 0xba, 0x0d, 0x5f, 0x77, 0xd0, 0xa4, 0x9b, 0x83, 0x72, 0x4a, 0x1c, 0x81, 0x86, 0x34, 0xf6,
-	0xe9, 0x10, 0x74, 0xf5, 0xce, 0xa0, 0x93, 0x6e, 0x0e, 0xca, 0x29, 0x51, 0x81, 0x36, 0xc1, 0x1a,
-	0x4e, 0x12, 0xfa, 0x72, 0xa6, 0x20, 0x50, 0x62, 0xff, 0xec, 0x36, 0xec, 0xe1, 0x77, 0xfa, 0xba,
-	0xb7, 0xf8, 0x4e, 0x0b, 0xed, 0x54, 0x49, 0x7c, 0x00, 0x9b, 0x09, 0xee, 0xcf, 0xf0, 0xac, 0xdf,
-	0xb9, 0xf0, 0xd7, 0x9d, 0x1d, 0x64, 0x09, 0xe5, 0x14, 0xcb, 0x67, 0x60, 0x3d, 0x22, 0x49, 0x93,
-	0xb8, 0x31, 0xe1, 0xac, 0x1d, 0x06, 0x5c, 0xf3, 0x6c, 0xdc, 0x79, 0x1f, 0xdc, 0xe4, 0xee, 0x20,
-	0x28, 0xd5, 0x2f, 0xb4, 0x76, 0xd4, 0xa5, 0xac, 0x85, 0xe3, 0x66, 0x0b, 0x07, 0x9a, 0x65, 0xf3,
-	0xce, 0x5d, 0x3a, 0xed, 0xe8, 0xa0, 0x95, 0xa1, 0x62, 0xb4, 0xd4, 0x1e, 0x8e, 0xbd, 0xce, 0x70,
-	0xa9, 0xef, 0xdd, 0x79, 0xa9, 0x27, 0xdd, 0x1c, 0x94, 0x53, 0xa2, 0x02, 0xbd, 0x0f, 0x4c, 0x75,
-	0x5b, 0x09, 0xfc, 0x52, 0x69, 0x3b, 0xb3, 0x63, 0xa0, 0x65, 0x29, 0x9f, 0xf8, 0x70, 0x1d, 0x2c,
-	0xca, 0xfb, 0x4c, 0xe9, 0xbe, 0x20, 0x42, 0x4a, 0x80, 0x65, 0x60, 0xfa, 0xc4, 0x0b, 0x22, 0x1c,
-	0xb2, 0x52, 0x59, 0x3a, 0x8c, 0xe4, 0x9a, 0x61, 0x16, 0xac, 0x62, 0xcd, 0x30, 0x8b, 0x96, 0x55,
-	0x33, 0x4c, 0xcb, 0x5a, 0xad, 0x19, 0xe6, 0x9a, 0xb5, 0x8e, 0x56, 0xfa, 0x34, 0xa4, 0x6e, 0xf7,
-	0x89, 0x8a, 0x00, 0xe5, 0xc8, 0x4b, 0xcc, 0xf4, 0x57, 0x0b, 0x15, 0x3c, 0xcc, 0x71, 0xd8, 0x67,
-	0xba, 0xaa, 0xc8, 0x52, 0xb5, 0x9e, 0x38, 0x03, 0xf7, 0xc0, 0xa2, 0xb8, 0xa5, 0x11, 0x68, 0x81,
-	0x85, 0x0b, 0xd2, 0x57, 0x27, 0x37, 0x12, 0x43, 0x11, 0x62, 0x17, 0x87, 0x1d, 0xa2, 0x0e, 0x5c,
-	0xa4, 0x04, 0xe7, 0x0c, 0x14, 0xcf, 0x13, 0x1c, 0x33, 0x71, 0xc3, 0xa3, 0xf1, 0x29, 0x6d, 0x32,
-	0x08, 0x81, 0x21, 0x0f, 0x1d, 0xe5, 0x2b, 0xc7, 0xf0, 0xc7, 0xc0, 0x08, 0x69, 0x93, 0xc9, 0xab,
-	0x47, 0x6e, 0x7f, 0xe3, 0xfa, 0x3d, 0xe7, 0x94, 0x36, 0x91, 0x34, 0x71, 0xbe, 0x99, 0x07, 0x0b,
-	0xa7, 0xb4, 0x09, 0x4b, 0x60, 0x19, 0xfb, 0x7e, 0x42, 0x18, 0xd3, 0x48, 0x43, 0x11, 0x6e, 0x82,
-	0x25, 0x4e, 0xdb, 0x81, 0xa7, 0xe0, 0xb2, 0x48, 0x4b, 0x82, 0xd8, 0xc7, 0x1c, 0xcb, 0x53, 0x3a,
-	0x8f, 0xe4, 0x58, 0x5c, 0x98, 0x65, 0x66, 0x6e, 0xdc, 0x89, 0x1a, 0x24, 0x91, 0x87, 0xad, 0x51,
-	0x29, 0x5e, 0xa6, 0x76, 0x4e, 0xea, 0x5f, 0x48, 0x35, 0x9a, 0x14, 0xe0, 0xbb, 0x60, 0x99, 0xf7,
-	0x26, 0x0f, 0xce, 0xb5, 0xcb, 0xd4, 0x2e, 0xf2, 0x71, 0x9a, 0xe2, 0x5c, 0x44, 0x4b, 0xbc, 0x27,
-	0xcf, 0xc7, 0x3d, 0x60, 0xf2, 0x9e, 0x1b, 0xc4, 0x3e, 0xe9, 0xc9, 0xb3, 0xd1, 0xa8, 0xac, 0x5f,
-	0xa6, 0xb6, 0x35, 0x61, 0x7e, 0x22, 0xe6, 0xd0, 0x32, 0xef, 0xc9, 0x01, 0x7c, 0x17, 0x00, 0x15,
-	0x92, 0x64, 0x50, 0x47, 0xdd, 0xca, 0x65, 0x6a, 0x67, 0xa5, 0x56, 0x62, 0x8f, 0x87, 0xd0, 0x01,
-	0x8b, 0x0a, 0xdb, 0x94, 0xd8, 0xf9, 0xcb, 0xd4, 0x36, 0x43, 0xda, 0x54, 0x98, 0x6a, 0x4a, 0x94,
-	0x2a, 0x21, 0x11, 0xed, 0x12, 0x5f, 0x9e, 0x37, 0x26, 0x1a, 0x8a, 0xce, 0x17, 0xf3, 0xc0, 0x3c,
-	0xef, 0x21, 0xc2, 0x3a, 0x21, 0x87, 0x1f, 0x00, 0x4b, 0xde, 0xe6, 0xb0, 0xc7, 0xdd, 0xa9, 0xd2,
-	0x56, 0x1e, 0x8c, 0x4f, 0x87, 0x59, 0x0b, 0x07, 0x15, 0x87, 0xaa, 0x23, 0x5d, 0xff, 0x75, 0xb0,
-	0xd8, 0x08, 0x29, 0x8d, 0x64, 0x27, 0xe4, 0x91, 0x12, 0xe0, 0xc7, 0xb2, 0x6a, 0x72, 0x95, 0x17,
-	0xe4, 0x4d, 0xf9, 0xfb, 0xd7, 0x57, 0x79, 0xa6, 0x55, 0x2a, 0x0f, 0xc4, 0x3d, 0xf9, 0x2a, 0xb5,
-	0x0b, 0x8a, 0x5b, 0xfb, 0x3b, 0x7f, 0xff, 0xee, 0xab, 0x87, 0x19, 0x51, 0x60, 0xd9, 0x4f, 0x16,
-	0x58, 0x48, 0x08, 0x97, 0x2b, 0x97, 0x47, 0x62, 0x28, 0xf6, 0x45, 0x42, 0xba, 0x24, 0xe1, 0xc4,
-	0x97, 0x2b, 0x64, 0xa2, 0x91, 0x2c, 0x36, 0x59, 0x13, 0x33, 0xb7, 0xc3, 0x88, 0xaf, 0x96, 0x03,
-	0x2d, 0x37, 0x31, 0xfb, 0x88, 0x11, 0xff, 0xa9, 0xf1, 0xf9, 0x97, 0xf6, 0x9c, 0x83, 0x41, 0x4e,
-	0x5f, 0xa2, 0x3b, 0xed, 0x90, 0xdc, 0xd2, 0x66, 0xfb, 0x20, 0xcf, 0x38, 0x4d, 0x70, 0x93, 0xb8,
-	0x17, 0xa4, 0xaf, 0x9b, 0x4d, 0xb5, 0x8e, 0xd6, 0xff, 0x8e, 0xf4, 0x19, 0x9a, 0x14, 0x34, 0xc5,
-	0x97, 0x06, 0xc8, 0x9d, 0x27, 0xd8, 0x23, 0xfa, 0x4a, 0x2c, 0x1a, 0x56, 0x88, 0x89, 0xa6, 0xd0,
-	0x92, 0xe0, 0xe6, 0x41, 0x44, 0x68, 0x87, 0xeb, 0x4d, 0x35, 0x14, 0x85, 0x47, 0x42, 0x48, 0x8f,
-	0x78, 0xb2, 0x96, 0x06, 0xd2, 0x12, 0x3c, 0x00, 0x2b, 0x7e, 0xc0, 0x70, 0x23, 0x94, 0xaf, 0x2d,
-	0xef, 0x42, 0xa5, 0x5f, 0xb1, 0x2e, 0x53, 0x3b, 0xaf, 0x27, 0xea, 0x42, 0x8f, 0xa6, 0x24, 0xf8,
-	0x3e, 0x28, 0x8e, 0xdd, 0x64, 0xb4, 0xea, 0x91, 0x59, 0x81, 0x97, 0xa9, 0x5d, 0x18, 0x99, 0xca,
-	0x19, 0x34, 0x23, 0xab, 0x6f, 0x53, 0xa3, 0xd3, 0x94, 0x1d, 0x68, 0x22, 0x25, 0x08, 0x6d, 0x18,
-	0x44, 0x01, 0x97, 0x1d, 0xb7, 0x88, 0x94, 0x00, 0xdf, 0x07, 0x59, 0xda, 0x25, 0x49, 0x12, 0xf8,
-	0xf2, 0xf1, 0x27, 0xda, 0xe0, 0x7b, 0xd7, 0xdb, 0x60, 0xe2, 0xb9, 0x80, 0xc6, 0xf6, 0x22, 0x39,
-	0x12, 0xcb, 0x20, 0x23, 0x12, 0xd1, 0xa4, 0x2f, 0x2f, 0x1d, 0x3a, 0x39, 0x35, 0xf1, 0x5c, 0xea,
-	0xd1, 0x94, 0x04, 0x2b, 0x00, 0x6a, 0xb7, 0x84, 0xf0, 0x4e, 0x12, 0xbb, 0xf2, 0x23, 0x90, 0x97,
-	0xbe, 0x72, 0x2b, 0xaa, 0x59, 0x24, 0x27, 0x9f, 0x61, 0x8e, 0xd1, 0x35, 0x0d, 0xfc, 0x25, 0x80,
-	0x6a, 0x4d, 0xdc, 0xcf, 0x18, 0x1d, 0x3e, 0x27, 0xf5, 0xad, 0x41, 0xf2, 0xab, 0x59, 0x1d, 0xb3,
-	0xa5, 0xa4, 0x1a, 0xa3, 0x3a, 0x8b, 0x9a, 0x61, 0x1a, 0xd6, 0xa2, 0x7e, 0x9d, 0x0e, 0xeb, 0xa7,
-	0xb3, 0x40, 0x6b, 0x43, 0x79, 0x22, 0xbc, 0x87, 0xff, 0xc8, 0x80, 0x89, 0xb7, 0x1c, 0xfc, 0x39,
-	0x28, 0x1f, 0x1d, 0x1f, 0x57, 0xeb, 0x75, 0xf7, 0xfc, 0x93, 0xb3, 0xaa, 0x7b, 0x56, 0x45, 0xcf,
-	0x4f, 0xea, 0xf5, 0x93, 0x0f, 0x5f, 0x9c, 0x56, 0xeb, 0x75, 0x6b, 0xae, 0xfc, 0xce, 0xab, 0xd7,
-	0xdb, 0xa5, 0xb1, 0xfd, 0x99, 0xa8, 0x27, 0x63, 0x01, 0x8d, 0x43, 0xd1, 0xa9, 0xef, 0x81, 0xcd,
-	0x49, 0x6f, 0x54, 0xad, 0x9f, 0xa3, 0x93, 0xe3, 0xf3, 0xea, 0x33, 0x2b, 0x53, 0x2e, 0xbd, 0x7a,
-	0xbd, 0xbd, 0x3e, 0xf6, 0x44, 0x84, 0xf1, 0x24, 0xf0, 0xc4, 0x4e, 0x39, 0x04, 0xa5, 0x9b, 0x39,
-	0xab, 0xcf, 0xac, 0xf9, 0x72, 0xf9, 0xd5, 0xeb, 0xed, 0xcd, 0x9b, 0x18, 0x89, 0x5f, 0x36, 0x3e,
-	0xff, 0xdb, 0xd6, 0x5c, 0xe5, 0xd7, 0x5f, 0x0f, 0xb6, 0x32, 0xdf, 0x0e, 0xb6, 0x32, 0xff, 0x19,
-	0x6c, 0x65, 0xbe, 0x78, 0xb3, 0x35, 0xf7, 0xed, 0x9b, 0xad, 0xb9, 0x7f, 0xbe, 0xd9, 0x9a, 0xfb,
-	0xc3, 0x8f, 0x9a, 0x01, 0x6f, 0x75, 0x1a, 0xbb, 0x1e, 0x8d, 0xf6, 0xd4, 0xe3, 0x5e, 0xfd, 0x76,
-	0xf7, 0x1f, 0xe9, 0x67, 0xbe, 0x78, 0xab, 0xb2, 0xc6, 0x92, 0xfc, 0xb7, 0xca, 0x93, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x1d, 0xfd, 0x0a, 0x4c, 0xaf, 0x11, 0x00, 0x00,
+	// 2232 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x17, 0xa9, 0x95, 0xb4, 0x1a, 0x52, 0xd4, 0x6a, 0x24, 0xd9, 0x6b, 0x3a, 0x5f, 0x91, 0xd9,
+	0x6f, 0x92, 0xaa, 0x46, 0x2a, 0xd9, 0x72, 0xd4, 0x1a, 0x4e, 0xd3, 0x56, 0xa4, 0x18, 0x97, 0xaa,
+	0x7e, 0xb0, 0x43, 0x2a, 0x81, 0x8b, 0x16, 0x8b, 0xe1, 0xee, 0x98, 0xdc, 0x68, 0x77, 0x87, 0xd8,
+	0x59, 0xd2, 0x62, 0xff, 0x82, 0x40, 0xa7, 0xf4, 0xd6, 0x8b, 0x80, 0x00, 0xbd, 0xf4, 0x98, 0x3f,
+	0xa1, 0xc7, 0x20, 0x40, 0x81, 0x1c, 0x8b, 0x02, 0x25, 0x0a, 0xf9, 0x10, 0x40, 0x47, 0x03, 0xbd,
+	0x17, 0xf3, 0x83, 0x3f, 0x25, 0xab, 0x0a, 0xd0, 0x5e, 0xa4, 0xf9, 0xbc, 0x79, 0xef, 0xf3, 0xde,
+	0xbc, 0x79, 0xb3, 0xf3, 0x86, 0x20, 0x4b, 0xe2, 0x26, 0x89, 0x02, 0x2f, 0x8c, 0x37, 0x49, 0x27,
+	0xd8, 0xec, 0x3c, 0xe2, 0xff, 0x36, 0x5a, 0x11, 0x8d, 0x29, 0x34, 0x06, 0x73, 0x1b, 0x5c, 0xd8,
+	0x79, 0x94, 0x5d, 0xc2, 0x81, 0x17, 0xd2, 0x4d, 0xf1, 0x57, 0x2a, 0x65, 0x57, 0x1a, 0xb4, 0x41,
+	0xc5, 0x70, 0x93, 0x8f, 0xa4, 0xd4, 0xfa, 0xd7, 0x0c, 0x98, 0xad, 0xe0, 0x08, 0x07, 0x0c, 0xee,
+	0x00, 0x40, 0x4e, 0xe3, 0x08, 0xdb, 0xc4, 0x6b, 0x31, 0x53, 0xcb, 0x4f, 0xaf, 0xcf, 0x17, 0xac,
+	0x8b, 0x5e, 0x6e, 0xbe, 0xc4, 0xa5, 0xa5, 0x72, 0x85, 0xbd, 0xee, 0xe5, 0x96, 0xba, 0x38, 0xf0,
+	0x9f, 0x5a, 0x43, 0x45, 0x0b, 0xcd, 0x0b, 0x50, 0xf2, 0x5a, 0x0c, 0x6e, 0x81, 0x55, 0xec, 0xfb,
+	0xf4, 0xa5, 0xdd, 0x0e, 0x39, 0x3d, 0x71, 0x62, 0xe2, 0xda, 0xf1, 0x29, 0x33, 0x67, 0xf3, 0x89,
+	0x75, 0x1d, 0x2d, 0x8b, 0xc9, 0xe3, 0xe1, 0x5c, 0xed, 0x94, 0xdb, 0xa4, 0x49, 0x27, 0xb0, 0x9d,
+	0x26, 0x0e, 0x43, 0xe2, 0x33, 0x53, 0x17, 0x8e, 0x17, 0x2f, 0x7a, 0xb9, 0x54, 0xe9, 0x93, 0x83,
+	0xa2, 0x12, 0xa3, 0x14, 0xe9, 0x04, 0x7d, 0x00, 0x7f, 0x07, 0x32, 0xd8, 0x71, 0x08, 0x63, 0xb6,
+	0x43, 0xc3, 0x38, 0xa2, 0xbe, 0x39, 0x9f, 0x4f, 0xac, 0xa7, 0xb6, 0x72, 0x1b, 0x93, 0x99, 0xd8,
+	0xd8, 0x11, 0x7a, 0x45, 0xa9, 0x56, 0x58, 0xfd, 0xba, 0x97, 0x9b, 0xba, 0xe8, 0xe5, 0x16, 0xc6,
+	0xc4, 0x68, 0x01, 0x8f, 0x42, 0xf8, 0x14, 0xdc, 0xc3, 0x4e, 0xec, 0x75, 0x88, 0xcd, 0x62, 0x1c,
+	0x7b, 0x8e, 0xdd, 0x8a, 0x88, 0x43, 0x83, 0x96, 0xe7, 0x13, 0x66, 0x02, 0x1e, 0x1f, 0xba, 0x2b,
+	0x15, 0xaa, 0x62, 0xbe, 0x32, 0x9c, 0x86, 0x15, 0x90, 0x72, 0xda, 0x2c, 0xa6, 0x81, 0x4c, 0x63,
+	0x2a, 0x3f, 0xbd, 0x9e, 0xda, 0xba, 0x7f, 0x35, 0xae, 0xa2, 0x50, 0x2a, 0x95, 0x2b, 0x05, 0xa8,
+	0x62, 0x02, 0x03, 0x11, 0x43, 0x40, 0x72, 0x88, 0xa4, 0x3e, 0x07, 0x19, 0xd6, 0xc4, 0x61, 0xa3,
+	0x89, 0x3d, 0xbb, 0xee, 0x53, 0xe7, 0xc4, 0x4c, 0xe7, 0x13, 0xeb, 0xf3, 0x85, 0xad, 0xbf, 0xf7,
+	0x72, 0xab, 0x0e, 0x65, 0x01, 0x65, 0xcc, 0x3d, 0xd9, 0xf0, 0xe8, 0x66, 0x80, 0xe3, 0xe6, 0x46,
+	0x39, 0x8c, 0x5f, 0xf7, 0x72, 0xab, 0x72, 0x9f, 0xc6, 0x0d, 0x2d, 0xb4, 0xd0, 0x17, 0x14, 0x38,
+	0x86, 0x55, 0x90, 0x76, 0x70, 0xe8, 0xb4, 0x43, 0x45, 0xbc, 0x20, 0x88, 0x1f, 0xde, 0x44, 0xbc,
+	0x2c, 0x89, 0x47, 0xcd, 0x2c, 0x94, 0x92, 0x70, 0x40, 0xda, 0x8a, 0x70, 0xa3, 0x4d, 0x14, 0x69,
+	0xe6, 0xd6, 0xa4, 0xa3, 0x66, 0x16, 0x4a, 0x49, 0x28, 0x48, 0x9f, 0xde, 0x3d, 0xfb, 0xee, 0xab,
+	0x07, 0x90, 0x74, 0x02, 0xca, 0x36, 0x4f, 0xc5, 0x09, 0x90, 0x55, 0xbb, 0xa7, 0xe9, 0x09, 0x23,
+	0xb9, 0xa7, 0xe9, 0x49, 0x63, 0x7a, 0x4f, 0xd3, 0xa7, 0x0d, 0x6d, 0x4f, 0xd3, 0x67, 0x8c, 0xd9,
+	0x3d, 0x4d, 0x9f, 0x33, 0x74, 0x34, 0xcf, 0x4b, 0xcb, 0x25, 0x21, 0x0d, 0x50, 0xda, 0x69, 0x62,
+	0x2f, 0xe4, 0x05, 0xf3, 0xc2, 0x6b, 0x58, 0x7f, 0x4c, 0x80, 0xf9, 0x41, 0xbe, 0x21, 0x04, 0x5a,
+	0x88, 0x03, 0x62, 0x26, 0x78, 0xa8, 0x48, 0x8c, 0xe1, 0x33, 0xa0, 0x13, 0xaf, 0x65, 0xc7, 0xdd,
+	0x16, 0x31, 0x93, 0xf9, 0xc4, 0x7a, 0xe6, 0xba, 0xea, 0x1a, 0x50, 0xd4, 0xba, 0x2d, 0x52, 0x48,
+	0x5d, 0xf4, 0x72, 0x73, 0x0a, 0xa0, 0x39, 0xe2, 0xb5, 0xf8, 0x00, 0x9a, 0x60, 0x8e, 0xb6, 0x1c,
+	0xea, 0x12, 0x66, 0x4e, 0x8b, 0xda, 0xe9, 0x43, 0xb8, 0x02, 0x66, 0x3a, 0xd8, 0x6f, 0x13, 0x53,
+	0xcb, 0x27, 0xd6, 0x35, 0x24, 0x81, 0xf5, 0x87, 0x04, 0x18, 0x2f, 0x4f, 0xb8, 0x03, 0x66, 0x9d,
+	0x88, 0xe0, 0x58, 0x06, 0x98, 0xda, 0xfa, 0xff, 0xff, 0x50, 0xe6, 0x22, 0x18, 0x8d, 0x97, 0x15,
+	0x52, 0x86, 0xf0, 0x23, 0xa0, 0x39, 0xd8, 0xf7, 0xcd, 0xe4, 0xf7, 0x25, 0x10, 0x66, 0xd6, 0x3f,
+	0x12, 0x60, 0xe9, 0x8a, 0x06, 0x74, 0x40, 0x4a, 0x1d, 0x43, 0x91, 0xa5, 0x84, 0xc8, 0xd2, 0x5b,
+	0x6f, 0xe2, 0x16, 0xa4, 0xef, 0xf0, 0x42, 0x1f, 0xe2, 0xd7, 0xbd, 0x1c, 0x94, 0x7b, 0x3f, 0x42,
+	0x64, 0x21, 0x80, 0x07, 0x1a, 0xd0, 0x01, 0xcb, 0xe3, 0x67, 0xdd, 0xf6, 0x3d, 0x16, 0x9b, 0x49,
+	0xf1, 0x99, 0x78, 0x7c, 0xd1, 0xcb, 0x8d, 0x07, 0xb6, 0xef, 0x31, 0x5e, 0x51, 0xd9, 0x31, 0xd6,
+	0x51, 0x4b, 0x0b, 0x2d, 0xe1, 0x49, 0x03, 0xeb, 0x9b, 0x45, 0x90, 0x2a, 0xf2, 0xfa, 0x28, 0x8a,
+	0xf2, 0x80, 0xbf, 0x05, 0x8b, 0x4d, 0x1a, 0x10, 0x16, 0x13, 0xec, 0xaa, 0x32, 0x16, 0xb5, 0x51,
+	0x78, 0x7c, 0x53, 0x19, 0xdf, 0x91, 0x4e, 0x27, 0x2c, 0x2d, 0x94, 0x19, 0x48, 0xe4, 0x09, 0x69,
+	0x82, 0x8c, 0x8b, 0xa9, 0xfd, 0x82, 0x46, 0x27, 0x8a, 0x3c, 0x29, 0xc8, 0x0b, 0x6f, 0x24, 0xbf,
+	0xe8, 0xe5, 0xd2, 0xbb, 0x3b, 0x47, 0x1f, 0xd3, 0xe8, 0x44, 0x50, 0x0c, 0x4f, 0xf8, 0x38, 0x91,
+	0x85, 0xd2, 0x2e, 0xa6, 0x03, 0x35, 0xf8, 0x29, 0x30, 0x06, 0x0a, 0xac, 0xdd, 0x6a, 0xd1, 0x28,
+	0x36, 0xa7, 0xf9, 0xb7, 0xb8, 0xf0, 0xa3, 0x8b, 0x5e, 0x2e, 0xa3, 0x28, 0xab, 0x72, 0xe6, 0x75,
+	0x2f, 0x77, 0x77, 0x82, 0x54, 0xd9, 0x58, 0x28, 0xa3, 0x68, 0x95, 0x2a, 0xac, 0x83, 0x34, 0xf1,
+	0x5a, 0x8f, 0xb6, 0x1f, 0xaa, 0x05, 0x68, 0x62, 0x01, 0x3f, 0xbf, 0x69, 0x01, 0xa9, 0x52, 0xb9,
+	0xf2, 0x68, 0xfb, 0x61, 0x3f, 0x7e, 0x75, 0xe6, 0x47, 0x59, 0x2c, 0x94, 0x92, 0x50, 0x06, 0x5f,
+	0x06, 0x0a, 0xda, 0x4d, 0xcc, 0x9a, 0xe6, 0x8c, 0x70, 0xb1, 0xce, 0x0b, 0x48, 0x32, 0xfd, 0x12,
+	0xb3, 0xe6, 0x30, 0xeb, 0xf5, 0xee, 0xef, 0x71, 0x18, 0x7b, 0xed, 0xa0, 0xcf, 0x05, 0xa4, 0x31,
+	0xd7, 0x1a, 0x84, 0xbb, 0xad, 0xc2, 0x9d, 0xbd, 0x6d, 0xb8, 0xdb, 0xd7, 0x85, 0xbb, 0x3d, 0x1e,
+	0xae, 0xd4, 0x19, 0xf8, 0x78, 0xa2, 0x7c, 0xcc, 0xdd, 0xd6, 0xc7, 0x93, 0xeb, 0x7c, 0x3c, 0x19,
+	0xf7, 0x21, 0x75, 0x78, 0x5d, 0x4e, 0xac, 0xd3, 0xd4, 0x6f, 0x5d, 0x97, 0x57, 0x32, 0x94, 0x19,
+	0x48, 0x24, 0xfb, 0x09, 0x58, 0x71, 0x68, 0xc8, 0x62, 0x2e, 0x0b, 0x69, 0xcb, 0xef, 0x7f, 0xc1,
+	0xe7, 0x85, 0x8b, 0x27, 0x37, 0xb9, 0xb8, 0xaf, 0xae, 0x85, 0x6b, 0xcc, 0x2d, 0xb4, 0x3c, 0x2e,
+	0x96, 0xce, 0x6c, 0x60, 0xb4, 0x48, 0x4c, 0x22, 0x56, 0x6f, 0x47, 0x0d, 0xe5, 0x08, 0x08, 0x47,
+	0x1f, 0xdc, 0xe4, 0x48, 0x55, 0xe8, 0xa4, 0xa9, 0x85, 0x16, 0x87, 0x22, 0xe9, 0xe0, 0x39, 0xc8,
+	0x78, 0xdc, 0x6b, 0xbd, 0xed, 0x2b, 0xfa, 0xd4, 0xad, 0xef, 0xcd, 0x71, 0x43, 0x0b, 0x2d, 0xf4,
+	0x05, 0x92, 0xda, 0x05, 0x30, 0x68, 0x7b, 0x91, 0xdd, 0xf0, 0xb1, 0xe3, 0x91, 0x68, 0xec, 0x5a,
+	0xfe, 0xf1, 0x4d, 0xf4, 0xf7, 0x24, 0xfd, 0x55, 0x63, 0x0b, 0x19, 0x5c, 0xf8, 0x4c, 0xca, 0x06,
+	0x17, 0x69, 0x9d, 0x44, 0xbe, 0xf7, 0xfd, 0x6f, 0xe7, 0x51, 0x33, 0x0b, 0xa5, 0x24, 0x1c, 0x90,
+	0xfa, 0x34, 0x74, 0x69, 0x9f, 0x74, 0xe9, 0xd6, 0xa4, 0xa3, 0x66, 0x16, 0x4a, 0x49, 0x28, 0x49,
+	0x1b, 0x60, 0x19, 0x47, 0x11, 0x7d, 0x39, 0x91, 0x10, 0x28, 0xb8, 0x7f, 0x72, 0x13, 0x77, 0xff,
+	0x3b, 0x7d, 0xd5, 0x9a, 0x7f, 0xa7, 0xb9, 0x74, 0x2c, 0x25, 0x2e, 0x80, 0x8d, 0x08, 0x77, 0x27,
+	0xfc, 0xac, 0xdc, 0x3a, 0xf1, 0x57, 0x8d, 0x2d, 0x64, 0x70, 0xe1, 0x98, 0x97, 0xcf, 0xc0, 0x4a,
+	0x40, 0xa2, 0x06, 0xb1, 0x43, 0x12, 0xb3, 0x96, 0xef, 0xc5, 0xca, 0xcf, 0xea, 0xad, 0xcf, 0xc1,
+	0x75, 0xe6, 0x16, 0x82, 0x42, 0x7c, 0xa8, 0xa4, 0x83, 0x2a, 0x9d, 0xe8, 0xee, 0xee, 0xfc, 0xaf,
+	0xba, 0xbb, 0xbb, 0xff, 0x8d, 0xee, 0xee, 0x1e, 0xd0, 0x65, 0x23, 0xe5, 0xb9, 0xa6, 0x29, 0xda,
+	0x96, 0x39, 0x81, 0xcb, 0x2e, 0x6f, 0x67, 0x44, 0xab, 0x65, 0xde, 0x13, 0x6d, 0x94, 0x04, 0x30,
+	0x0b, 0x74, 0x97, 0x38, 0x5e, 0x80, 0x7d, 0x66, 0x66, 0x85, 0xc1, 0x00, 0xef, 0x69, 0x7a, 0xc6,
+	0x58, 0xdc, 0xd3, 0xf4, 0x45, 0xc3, 0xd8, 0xd3, 0x74, 0xc3, 0x58, 0xda, 0xd3, 0xf4, 0x65, 0x63,
+	0x05, 0x2d, 0x74, 0xa9, 0x4f, 0xed, 0xce, 0x63, 0x19, 0x01, 0x4a, 0x91, 0x97, 0x98, 0xa9, 0xaf,
+	0x16, 0xca, 0x38, 0x38, 0xc6, 0x7e, 0x97, 0xa9, 0xac, 0x22, 0x43, 0xe6, 0x7a, 0xe4, 0x0e, 0xdc,
+	0x04, 0x33, 0xbc, 0x2f, 0x27, 0xd0, 0x00, 0xd3, 0x27, 0xa4, 0xab, 0xba, 0x3a, 0x3e, 0x1c, 0x76,
+	0x5c, 0x49, 0x19, 0xa2, 0x00, 0x56, 0x05, 0x2c, 0xd6, 0x22, 0x1c, 0x32, 0xde, 0xd3, 0xd3, 0x70,
+	0x9f, 0x36, 0x18, 0xef, 0x08, 0xc5, 0xa5, 0xa3, 0x3a, 0x42, 0x3e, 0x86, 0x3f, 0x04, 0x9a, 0x4f,
+	0x1b, 0x4c, 0xb4, 0x1e, 0xa9, 0xad, 0xd5, 0xab, 0x7d, 0xce, 0x3e, 0x6d, 0x20, 0xa1, 0x62, 0x7d,
+	0x93, 0x04, 0xd3, 0xfb, 0xb4, 0xc1, 0x7b, 0x3f, 0xec, 0xba, 0x11, 0x61, 0x4c, 0x31, 0xf5, 0x21,
+	0xbc, 0x03, 0x66, 0x63, 0xda, 0xf2, 0x1c, 0x49, 0x37, 0x8f, 0x14, 0xe2, 0x8e, 0x5d, 0x1c, 0x63,
+	0x71, 0x4b, 0xa7, 0x91, 0x18, 0xf3, 0x27, 0x92, 0x58, 0x99, 0x1d, 0xb6, 0x83, 0x3a, 0x89, 0x64,
+	0xbb, 0x58, 0x58, 0xbc, 0xec, 0xe5, 0x52, 0x42, 0x7e, 0x28, 0xc4, 0x68, 0x14, 0xc0, 0xf7, 0xc1,
+	0x5c, 0x7c, 0x3a, 0x7a, 0x71, 0x2e, 0x5f, 0xf6, 0x72, 0x8b, 0xf1, 0x70, 0x99, 0xfc, 0x5e, 0x44,
+	0xb3, 0xf1, 0x29, 0xff, 0x0f, 0x37, 0x81, 0x1e, 0x9f, 0xda, 0x5e, 0xe8, 0x92, 0x53, 0x71, 0x37,
+	0x6a, 0x85, 0x95, 0xcb, 0x5e, 0xce, 0x18, 0x51, 0x2f, 0xf3, 0x39, 0x34, 0x17, 0x9f, 0x8a, 0x01,
+	0x7c, 0x1f, 0x00, 0x19, 0x92, 0xf0, 0x20, 0xaf, 0xba, 0x85, 0xcb, 0x5e, 0x6e, 0x5e, 0x48, 0x05,
+	0xf7, 0x70, 0x08, 0x2d, 0x30, 0x23, 0xb9, 0x75, 0xc1, 0x9d, 0xbe, 0xec, 0xe5, 0x74, 0x9f, 0x36,
+	0x24, 0xa7, 0x9c, 0xe2, 0xa9, 0x8a, 0x48, 0x40, 0x3b, 0xc4, 0x15, 0xf7, 0x8d, 0x8e, 0xfa, 0xd0,
+	0xfa, 0x22, 0x09, 0xf4, 0xda, 0x29, 0x22, 0xac, 0xed, 0xc7, 0xf0, 0x63, 0x60, 0x88, 0x6e, 0x0e,
+	0x3b, 0xb1, 0x3d, 0x96, 0xda, 0xc2, 0xfd, 0xe1, 0xed, 0x30, 0xa9, 0x61, 0xa1, 0xc5, 0xbe, 0x68,
+	0x47, 0xe5, 0x7f, 0x05, 0xcc, 0xd4, 0x7d, 0x4a, 0x03, 0x51, 0x09, 0x69, 0x24, 0x01, 0xfc, 0x54,
+	0x64, 0x4d, 0xec, 0xf2, 0xb4, 0xe8, 0x94, 0xdf, 0xbe, 0xba, 0xcb, 0x13, 0xa5, 0x52, 0xb8, 0xcf,
+	0xfb, 0xe4, 0xd7, 0xbd, 0x5c, 0x46, 0xfa, 0x56, 0xf6, 0xd6, 0x9f, 0xbf, 0xfb, 0xea, 0x41, 0x82,
+	0x27, 0x58, 0xd4, 0x93, 0x01, 0xa6, 0x23, 0x12, 0x8b, 0x9d, 0x4b, 0x23, 0x3e, 0xe4, 0xe7, 0x22,
+	0x22, 0x1d, 0x12, 0xc5, 0xc4, 0x15, 0x3b, 0xa4, 0xa3, 0x01, 0xe6, 0x87, 0xac, 0x81, 0x99, 0xdd,
+	0x66, 0xc4, 0x95, 0xdb, 0x81, 0xe6, 0x1a, 0x98, 0x1d, 0x33, 0xe2, 0x3e, 0xd5, 0x3e, 0xff, 0x32,
+	0x37, 0x65, 0x61, 0x90, 0x52, 0x4d, 0x74, 0xbb, 0xe5, 0x93, 0x1b, 0xca, 0x6c, 0x0b, 0xa4, 0x59,
+	0x4c, 0x23, 0xdc, 0x20, 0xf6, 0x09, 0xe9, 0xaa, 0x62, 0x93, 0xa5, 0xa3, 0xe4, 0xbf, 0x22, 0x5d,
+	0x86, 0x46, 0x81, 0x72, 0xf1, 0xa5, 0x06, 0x52, 0xb5, 0x08, 0x3b, 0x44, 0xb5, 0xc4, 0xbc, 0x60,
+	0x39, 0x8c, 0x94, 0x0b, 0x85, 0xb8, 0xef, 0xd8, 0x0b, 0x08, 0x6d, 0xc7, 0xea, 0x50, 0xf5, 0x21,
+	0xb7, 0x88, 0x08, 0x39, 0x25, 0x8e, 0xc8, 0xa5, 0x86, 0x14, 0x82, 0xdb, 0x60, 0xc1, 0xf5, 0x18,
+	0xae, 0xfb, 0xe2, 0x7d, 0xed, 0x9c, 0xc8, 0xe5, 0x17, 0x8c, 0xcb, 0x5e, 0x2e, 0xad, 0x26, 0xaa,
+	0x5c, 0x8e, 0xc6, 0x10, 0xfc, 0x10, 0x2c, 0x0e, 0xcd, 0x44, 0xb4, 0xf2, 0x67, 0x85, 0x02, 0xbc,
+	0xec, 0xe5, 0x32, 0x03, 0x55, 0x31, 0x83, 0x26, 0xb0, 0xfc, 0x36, 0xd5, 0xdb, 0x0d, 0x51, 0x81,
+	0x3a, 0x92, 0x80, 0x4b, 0x7d, 0x2f, 0xf0, 0x62, 0x51, 0x71, 0x33, 0x48, 0x02, 0xf8, 0x21, 0x98,
+	0xa7, 0x1d, 0x12, 0x45, 0x9e, 0x2b, 0x9e, 0xfb, 0xbc, 0x0c, 0xfe, 0xef, 0x9a, 0xa7, 0xdf, 0xf0,
+	0xb9, 0x80, 0x86, 0xfa, 0x7c, 0x71, 0x24, 0x14, 0x41, 0x06, 0x24, 0xa0, 0x51, 0xd7, 0x4c, 0x0d,
+	0x17, 0x27, 0x27, 0x0e, 0x84, 0x1c, 0x8d, 0x21, 0x58, 0x00, 0x50, 0x99, 0x45, 0x24, 0x6e, 0x47,
+	0xa1, 0x2d, 0x3e, 0x02, 0x69, 0x61, 0x2b, 0x8e, 0xa2, 0x9c, 0x45, 0x62, 0x72, 0x17, 0xc7, 0x18,
+	0x5d, 0x91, 0xc0, 0x9f, 0x01, 0x28, 0xf7, 0xc4, 0xfe, 0x8c, 0xd1, 0xfe, 0x4b, 0x57, 0x75, 0x0d,
+	0xc2, 0xbf, 0x9c, 0x55, 0x31, 0x1b, 0x12, 0xed, 0x31, 0xaa, 0x56, 0xb1, 0xa7, 0xe9, 0x9a, 0x31,
+	0xa3, 0x1e, 0xce, 0xfd, 0xfc, 0xa9, 0x55, 0xa0, 0xe5, 0x3e, 0x1e, 0x09, 0xef, 0xc1, 0x5f, 0x93,
+	0x60, 0x61, 0xec, 0x05, 0x0c, 0x3f, 0x02, 0xf7, 0x8b, 0xc7, 0xd5, 0xda, 0xd1, 0x81, 0x5d, 0x2a,
+	0x57, 0xec, 0xda, 0xf3, 0x4a, 0xc9, 0x3e, 0x3e, 0xac, 0x56, 0x4a, 0xc5, 0xf2, 0xc7, 0xe5, 0xd2,
+	0xae, 0x31, 0x95, 0x7d, 0xeb, 0xec, 0x3c, 0x6f, 0x8e, 0xd9, 0x1c, 0x87, 0xac, 0x45, 0x1c, 0xef,
+	0x85, 0x47, 0x5c, 0x58, 0x05, 0x3f, 0x98, 0x34, 0x2f, 0x1e, 0x1d, 0x56, 0x6b, 0x3b, 0x87, 0x35,
+	0xfb, 0xd9, 0x4e, 0xd5, 0x3e, 0x38, 0xde, 0xaf, 0x95, 0x2b, 0xfb, 0xe5, 0x12, 0x32, 0x12, 0xd9,
+	0xf7, 0xce, 0xce, 0xf3, 0xd6, 0x18, 0x55, 0x51, 0xb5, 0x99, 0xcf, 0x30, 0x3b, 0x68, 0xfb, 0xb1,
+	0xd7, 0xf2, 0x3d, 0x12, 0xc1, 0x23, 0xf0, 0xee, 0x8d, 0xa4, 0x47, 0x9f, 0x94, 0x10, 0x2a, 0xef,
+	0x96, 0x8c, 0x64, 0xf6, 0x9d, 0xb3, 0xf3, 0x7c, 0xfe, 0x4d, 0x94, 0x47, 0x6a, 0x8f, 0xe1, 0xaf,
+	0xc1, 0x7b, 0x93, 0x84, 0xbb, 0xcf, 0x0f, 0x77, 0x0e, 0xca, 0xc5, 0xc9, 0x20, 0xa7, 0xb3, 0xef,
+	0x9e, 0x9d, 0xe7, 0xdf, 0x1e, 0x63, 0xdc, 0xed, 0x86, 0x38, 0xf0, 0x9c, 0xb1, 0x18, 0xb3, 0xda,
+	0xe7, 0x7f, 0x5a, 0x9b, 0x7a, 0xf0, 0x97, 0x04, 0x18, 0x79, 0x1b, 0xc3, 0x9f, 0x82, 0xec, 0x4e,
+	0xb1, 0x58, 0xaa, 0x56, 0xa5, 0x8f, 0x4a, 0x09, 0x1d, 0x94, 0xab, 0xd5, 0xf2, 0xd1, 0xe1, 0x7e,
+	0xa9, 0x5a, 0xed, 0xe7, 0x72, 0xa8, 0x5f, 0xe1, 0xf5, 0xc9, 0x98, 0x47, 0x43, 0x9f, 0x9f, 0xfc,
+	0x0f, 0xc0, 0x9d, 0x51, 0x6b, 0x54, 0xaa, 0xd6, 0x50, 0xb9, 0x58, 0x2b, 0xed, 0x1a, 0x89, 0xac,
+	0x79, 0x76, 0x9e, 0x5f, 0x19, 0x5a, 0x22, 0xc2, 0xe2, 0xc8, 0xe3, 0x3f, 0xc8, 0xc1, 0x27, 0xc0,
+	0xbc, 0xde, 0x67, 0x69, 0xd7, 0x48, 0x66, 0xb3, 0x67, 0xe7, 0xf9, 0x3b, 0xd7, 0x79, 0x24, 0xae,
+	0x5c, 0x42, 0xe1, 0x17, 0x5f, 0x5f, 0xac, 0x25, 0xbe, 0xbd, 0x58, 0x4b, 0xfc, 0xf3, 0x62, 0x2d,
+	0xf1, 0xc5, 0xab, 0xb5, 0xa9, 0x6f, 0x5f, 0xad, 0x4d, 0xfd, 0xed, 0xd5, 0xda, 0xd4, 0x6f, 0xde,
+	0x6b, 0x78, 0x71, 0xb3, 0x5d, 0xdf, 0x70, 0x68, 0xb0, 0x29, 0x7f, 0xc7, 0x91, 0x7f, 0x3b, 0x5b,
+	0x0f, 0xd5, 0x2f, 0x3a, 0xfc, 0xed, 0xcf, 0xea, 0xb3, 0xe2, 0x87, 0xc9, 0xc7, 0xff, 0x1e, 0x00,
+	0x33, 0x6f, 0x60, 0xa2, 0xf1, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1001,6 +1151,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PragueBlock != nil {
+		{
+			size := m.PragueBlock.Size()
+			i -= size
+			if _, err := m.PragueBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.CancunBlock != nil {
+		{
+			size := m.CancunBlock.Size()
+			i -= size
+			if _, err := m.CancunBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ShanghaiBlock != nil {
+		{
+			size := m.ShanghaiBlock.Size()
+			i -= size
+			if _, err := m.ShanghaiBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CustomEIPs) > 0 {
+		for iNdEx := len(m.CustomEIPs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CustomEIPs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ActiveStaticPrecompiles) > 0 {
 		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActiveStaticPrecompiles[iNdEx])
@@ -1051,6 +1251,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CustomEIP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomEIP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomEIP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Opcodes) > 0 {
+		for iNdEx := len(m.Opcodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Opcodes[iNdEx])
+			copy(dAtA[i:], m.Opcodes[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Opcodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EIPType != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.EIPType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1840,6 +2089,49 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if len(m.CustomEIPs) > 0 {
+		for _, e := range m.CustomEIPs {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.ShanghaiBlock != nil {
+		l = m.ShanghaiBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.CancunBlock != nil {
+		l = m.CancunBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.PragueBlock != nil {
+		l = m.PragueBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *CustomEIP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EIPType != 0 {
+		n += 1 + sovEvm(uint64(m.EIPType))
+	}
+	if len(m.Opcodes) > 0 {
+		for _, s := range m.Opcodes {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.Value != 0 {
+		n += 1 + sovEvm(uint64(m.Value))
+	}
 	return n
 }
 
@@ -2325,6 +2617,300 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomEIPs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomEIPs = append(m.CustomEIPs, CustomEIP{})
+			if err := m.CustomEIPs[len(m.CustomEIPs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ShanghaiBlock = &v
+			if err := m.ShanghaiBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.CancunBlock = &v
+			if err := m.CancunBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PragueBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.PragueBlock = &v
+			if err := m.PragueBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomEIP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomEIP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomEIP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIPType", wireType)
+			}
+			m.EIPType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EIPType |= CustomEIPType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcodes = append(m.Opcodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
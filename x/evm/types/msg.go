@@ -24,6 +24,7 @@ import (
 
 	evmapi "github.com/evmos/evmos/v20/api/ethermint/evm/v1"
 	"github.com/evmos/evmos/v20/types"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -86,6 +87,22 @@ func newMsgEthereumTx(
 	}
 
 	switch {
+	case tx.AuthorizationList != nil:
+		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
+		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
+
+		txData = &SetCodeTx{
+			ChainID:        cid,
+			Amount:         amt,
+			To:             toAddr,
+			GasTipCap:      &gtc,
+			GasFeeCap:      &gfc,
+			Nonce:          tx.Nonce,
+			GasLimit:       tx.GasLimit,
+			Data:           tx.Input,
+			Accesses:       NewAccessList(tx.Accesses),
+			Authorizations: NewAuthorizationList(tx.AuthorizationList),
+		}
 	case tx.GasFeeCap != nil:
 		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
 		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
@@ -129,7 +146,7 @@ func newMsgEthereumTx(
 	}
 
 	msg := MsgEthereumTx{Data: dataAny}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.TxHash().Hex()
 	return &msg
 }
 
@@ -150,6 +167,24 @@ func (msg *MsgEthereumTx) FromEthereumTx(tx *ethtypes.Transaction) error {
 	return nil
 }
 
+// FromSetCodeTx populates the message fields from the given EIP-7702 set code
+// transaction
+func (msg *MsgEthereumTx) FromSetCodeTx(tx *ethutils.SetCodeTx) error {
+	txData, err := NewSetCodeTx(tx)
+	if err != nil {
+		return err
+	}
+
+	anyTxData, err := PackTxData(txData)
+	if err != nil {
+		return err
+	}
+
+	msg.Data = anyTxData
+	msg.Hash = tx.Hash().Hex()
+	return nil
+}
+
 // Route returns the route value of an MsgEthereumTx.
 func (msg MsgEthereumTx) Route() string { return RouterKey }
 
@@ -192,7 +227,7 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 	}
 
 	// Validate Hash field after validated txData to avoid panic
-	txHash := msg.AsTransaction().Hash().Hex()
+	txHash := msg.TxHash().Hex()
 	if msg.Hash != txHash {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tx hash %s, expected: %s", msg.Hash, txHash)
 	}
@@ -231,6 +266,23 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 		return fmt.Errorf("sender address not defined for message")
 	}
 
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		sigHash := setCodeTx.AsSetCodeTx().SigHash()
+		sig, _, err := keyringSigner.SignByAddress(from, sigHash.Bytes(), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+		if err != nil {
+			return err
+		}
+
+		r, s, v := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]), new(big.Int).SetUint64(uint64(sig[64]))
+		setCodeTx.SetSignatureValues(nil, v, r, s)
+		return msg.FromSetCodeTx(setCodeTx.AsSetCodeTx())
+	}
+
 	tx := msg.AsTransaction()
 	txHash := ethSigner.Hash(tx)
 
@@ -284,6 +336,22 @@ func (msg *MsgEthereumTx) GetFrom() sdk.AccAddress {
 	return common.HexToAddress(msg.From).Bytes()
 }
 
+// TxHash returns the Ethereum transaction hash of the msg. Set code
+// transactions are hashed over their EIP-7702 encoding, since they can't be
+// represented as go-ethereum transactions.
+func (msg MsgEthereumTx) TxHash() common.Hash {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return common.Hash{}
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return setCodeTx.AsSetCodeTx().Hash()
+	}
+
+	return ethtypes.NewTx(txData.AsEthereumData()).Hash()
+}
+
 // AsTransaction creates an Ethereum Transaction type from the msg fields
 func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	txData, err := UnpackTxData(msg.Data)
@@ -296,13 +364,32 @@ func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 
 // AsMessage creates an Ethereum core.Message from the msg fields
 func (msg MsgEthereumTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return setCodeTx.AsMessage(signer.ChainID(), baseFee)
+	}
+
 	return msg.AsTransaction().AsMessage(signer, baseFee)
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (msg *MsgEthereumTx) GetSender(chainID *big.Int) (common.Address, error) {
-	signer := ethtypes.LatestSignerForChainID(chainID)
-	from, err := signer.Sender(msg.AsTransaction())
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var from common.Address
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		from, err = setCodeTx.GetSender(chainID)
+	} else {
+		signer := ethtypes.LatestSignerForChainID(chainID)
+		from, err = signer.Sender(msg.AsTransaction())
+	}
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -318,6 +405,14 @@ func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] == SetCodeTxType {
+		tx := &ethutils.SetCodeTx{}
+		if err := tx.UnmarshalBinary(b); err != nil {
+			return err
+		}
+		return msg.FromSetCodeTx(tx)
+	}
+
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(b); err != nil {
 		return err
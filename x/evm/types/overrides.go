@@ -0,0 +1,129 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+// StateOverride is the collection of overridden accounts.
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.11.6/internal/ethapi/api.go#L901
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate performs a stateless validation of the state overrides.
+func (so StateOverride) Validate() error {
+	for addr, account := range so {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		if account.Balance != nil && *account.Balance != nil && (*account.Balance).ToInt().Sign() < 0 {
+			return fmt.Errorf("account %s has a negative balance override", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is a set of header fields to override when executing a message
+// call.
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.11.6/internal/ethapi/api.go#L958
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	Coinbase *common.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+}
+
+// Validate performs a stateless validation of the block overrides.
+func (bo *BlockOverrides) Validate() error {
+	if bo == nil {
+		return nil
+	}
+	if bo.Number != nil && bo.Number.ToInt().Sign() < 0 {
+		return fmt.Errorf("block number override cannot be negative: %s", bo.Number)
+	}
+	if bo.BaseFee != nil && bo.BaseFee.ToInt().Sign() < 0 {
+		return fmt.Errorf("base fee override cannot be negative: %s", bo.BaseFee)
+	}
+	return nil
+}
+
+// Apply overrides the given block context fields with the non-nil values of
+// the block overrides.
+func (bo *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
+	if bo == nil {
+		return
+	}
+	if bo.Number != nil {
+		blockCtx.BlockNumber = new(big.Int).Set(bo.Number.ToInt())
+	}
+	if bo.Time != nil {
+		blockCtx.Time = new(big.Int).SetUint64(uint64(*bo.Time))
+	}
+	if bo.Coinbase != nil {
+		blockCtx.Coinbase = *bo.Coinbase
+	}
+	if bo.BaseFee != nil {
+		blockCtx.BaseFee = new(big.Int).Set(bo.BaseFee.ToInt())
+	}
+}
+
+// OverridableStateDB defines the state methods, on top of the vm.StateDB
+// interface, required to apply state overrides.
+type OverridableStateDB interface {
+	vm.StateDB
+	SetBalance(addr common.Address, amount *big.Int)
+	SetStorage(addr common.Address, storage map[common.Hash]common.Hash)
+}
+
+// Apply overrides the fields of specified accounts into the given state.
+func (so StateOverride) Apply(db OverridableStateDB) error {
+	if err := so.Validate(); err != nil {
+		return err
+	}
+	for addr, account := range so {
+		// Override account nonce.
+		if account.Nonce != nil {
+			db.SetNonce(addr, uint64(*account.Nonce))
+		}
+		// Override account(contract) code.
+		if account.Code != nil {
+			db.SetCode(addr, *account.Code)
+		}
+		// Override account balance.
+		if account.Balance != nil && *account.Balance != nil {
+			db.SetBalance(addr, (*account.Balance).ToInt())
+		}
+		// Replace entire state if caller requires.
+		if account.State != nil {
+			db.SetStorage(addr, *account.State)
+		}
+		// Apply state diff into specified accounts.
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				db.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateOverrideValidate(t *testing.T) {
+	addr := common.BigToAddress(big.NewInt(1))
+	storage := map[common.Hash]common.Hash{{1}: {2}}
+	negBalance := (*hexutil.Big)(big.NewInt(-1))
+
+	testCases := []struct {
+		name      string
+		overrides types.StateOverride
+		expPass   bool
+	}{
+		{"pass - empty", types.StateOverride{}, true},
+		{"pass - state", types.StateOverride{addr: {State: &storage}}, true},
+		{"pass - state diff", types.StateOverride{addr: {StateDiff: &storage}}, true},
+		{"fail - state and state diff", types.StateOverride{addr: {State: &storage, StateDiff: &storage}}, false},
+		{"fail - negative balance", types.StateOverride{addr: {Balance: &negBalance}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.overrides.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestBlockOverridesApply(t *testing.T) {
+	coinbase := common.BigToAddress(big.NewInt(2))
+	blockTime := hexutil.Uint64(1000)
+
+	blockCtx := vm.BlockContext{
+		BlockNumber: big.NewInt(10),
+		Time:        big.NewInt(1),
+		BaseFee:     big.NewInt(5),
+	}
+
+	var nilOverrides *types.BlockOverrides
+	nilOverrides.Apply(&blockCtx)
+	require.Equal(t, big.NewInt(10), blockCtx.BlockNumber)
+
+	overrides := &types.BlockOverrides{
+		Number:   (*hexutil.Big)(big.NewInt(20)),
+		Time:     &blockTime,
+		Coinbase: &coinbase,
+	}
+	require.NoError(t, overrides.Validate())
+
+	overrides.Apply(&blockCtx)
+	require.Equal(t, big.NewInt(20), blockCtx.BlockNumber)
+	require.Equal(t, big.NewInt(1000), blockCtx.Time)
+	require.Equal(t, coinbase, blockCtx.Coinbase)
+	// not overridden
+	require.Equal(t, big.NewInt(5), blockCtx.BaseFee)
+
+	invalid := &types.BlockOverrides{BaseFee: (*hexutil.Big)(big.NewInt(-1))}
+	require.Error(t, invalid.Validate())
+}
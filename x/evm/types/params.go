@@ -8,6 +8,7 @@ import (
 	"slices"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
@@ -70,14 +71,21 @@ func NewParams(
 	}
 }
 
-// DefaultParams returns default evm parameters
+// DefaultParams returns default evm parameters. The Shanghai, Cancun and Prague
+// rules are enabled from genesis.
 func DefaultParams() Params {
+	shanghaiBlock := sdkmath.ZeroInt()
+	cancunBlock := sdkmath.ZeroInt()
+	pragueBlock := sdkmath.ZeroInt()
 	return Params{
 		ExtraEIPs:               DefaultExtraEIPs,
 		AllowUnprotectedTxs:     DefaultAllowUnprotectedTxs,
 		ActiveStaticPrecompiles: DefaultStaticPrecompiles,
 		EVMChannels:             DefaultEVMChannels,
 		AccessControl:           DefaultAccessControl,
+		ShanghaiBlock:           &shanghaiBlock,
+		CancunBlock:             &cancunBlock,
+		PragueBlock:             &pragueBlock,
 	}
 }
 
@@ -101,7 +109,11 @@ func validateChannels(i interface{}) error {
 
 // Validate performs basic validation on evm parameters.
 func (p Params) Validate() error {
-	if err := validateEIPs(p.ExtraEIPs); err != nil {
+	if err := validateCustomEIPs(p.CustomEIPs); err != nil {
+		return err
+	}
+
+	if err := validateExtraEIPs(p.ExtraEIPs, p.CustomEIPs); err != nil {
 		return err
 	}
 
@@ -117,6 +129,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateForkBlocks(p.ShanghaiBlock, p.CancunBlock, p.PragueBlock); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -127,6 +143,68 @@ func (p Params) EIPs() []string {
 	return eips
 }
 
+// ActiveCustomEIPs returns the custom EIPs that are activated in the ExtraEIPs,
+// in their activation order.
+func (p Params) ActiveCustomEIPs() []CustomEIP {
+	active := []CustomEIP{}
+	for _, name := range p.ExtraEIPs {
+		for _, eip := range p.CustomEIPs {
+			if eip.Name == name {
+				active = append(active, eip)
+				break
+			}
+		}
+	}
+	return active
+}
+
+// CustomEIPActivators returns the jump table activators of the custom EIPs
+// defined in the params, keyed by EIP name.
+func (p Params) CustomEIPActivators() map[string]func(*vm.JumpTable) {
+	activators := make(map[string]func(*vm.JumpTable), len(p.CustomEIPs))
+	for _, eip := range p.CustomEIPs {
+		activator, err := eip.Activator()
+		if err != nil {
+			// invalid custom EIPs are rejected on params validation
+			continue
+		}
+		activators[eip.Name] = activator
+	}
+	return activators
+}
+
+// EthereumConfig returns a copy of the given Ethereum chain config where the
+// Shanghai and Cancun rules are only enabled from the block at which both the
+// chain config and the params enable them. This allows to enable the forks on
+// a running chain through an upgrade without changing the rules of the blocks
+// that were already executed.
+func (p Params) EthereumConfig(ethCfg *params.ChainConfig) *params.ChainConfig {
+	cfg := *ethCfg
+	cfg.ShanghaiBlock = laterForkBlock(ethCfg.ShanghaiBlock, getBlockValue(p.ShanghaiBlock))
+	cfg.CancunBlock = laterForkBlock(ethCfg.CancunBlock, getBlockValue(p.CancunBlock))
+	return &cfg
+}
+
+// IsPrague returns true if the Prague rules, i.e. the EIP-7702 set code
+// transactions and code delegations, are enabled at the given height. The
+// Prague fork is not part of the Ethereum chain config, so it is only defined
+// by the params.
+func (p Params) IsPrague(height int64) bool {
+	return p.PragueBlock != nil && p.PragueBlock.LTE(sdkmath.NewInt(height))
+}
+
+// laterForkBlock returns the later of the given fork blocks, or nil if the
+// fork is not enabled in any of them.
+func laterForkBlock(a, b *big.Int) *big.Int {
+	if a == nil || b == nil {
+		return nil
+	}
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
 // GetActiveStaticPrecompilesAddrs is a util function that the Active Precompiles
 // as a slice of addresses.
 func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
@@ -166,6 +244,32 @@ func (act AccessControlType) Validate() error {
 	return nil
 }
 
+// validateForkBlocks checks that the Shanghai, Cancun and Prague blocks are
+// valid and that each fork is not enabled before the previous one.
+func validateForkBlocks(shanghaiBlock, cancunBlock, pragueBlock *sdkmath.Int) error {
+	if err := validateBlock(shanghaiBlock); err != nil {
+		return errorsmod.Wrap(err, "ShanghaiBlock")
+	}
+
+	if err := validateBlock(cancunBlock); err != nil {
+		return errorsmod.Wrap(err, "CancunBlock")
+	}
+
+	if err := validateBlock(pragueBlock); err != nil {
+		return errorsmod.Wrap(err, "PragueBlock")
+	}
+
+	if cancunBlock != nil && (shanghaiBlock == nil || cancunBlock.LT(*shanghaiBlock)) {
+		return fmt.Errorf("cancun block %s cannot be enabled before the shanghai block", cancunBlock)
+	}
+
+	if pragueBlock != nil && (cancunBlock == nil || pragueBlock.LT(*cancunBlock)) {
+		return fmt.Errorf("prague block %s cannot be enabled before the cancun block", pragueBlock)
+	}
+
+	return nil
+}
+
 func validateAccessType(i interface{}) error {
 	accessType, ok := i.(AccessType)
 	if !ok {
@@ -208,10 +312,19 @@ func validateEIPs(i interface{}) error {
 		return fmt.Errorf("invalid EIP slice type: %T", i)
 	}
 
+	return validateExtraEIPs(eips, nil)
+}
+
+// validateExtraEIPs checks that the extra EIPs are unique and are either
+// registered activators or defined in the given custom EIPs.
+func validateExtraEIPs(eips []string, customEIPs []CustomEIP) error {
 	uniqueEIPs := make(map[string]struct{})
 
 	for _, eip := range eips {
-		if !vm.ExistsEipActivator(eip) {
+		isCustomEIP := slices.ContainsFunc(customEIPs, func(customEIP CustomEIP) bool {
+			return customEIP.Name == eip
+		})
+		if !vm.ExistsEipActivator(eip) && !isCustomEIP {
 			return fmt.Errorf("EIP %s is not activateable, valid EIPs are: %s", eip, vm.ActivateableEips())
 		}
 
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -45,6 +48,37 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "negative shanghai block",
+			params: Params{
+				ShanghaiBlock: intPtr(-1),
+			},
+			errContains: "ShanghaiBlock",
+		},
+		{
+			name: "cancun block without shanghai block",
+			params: Params{
+				CancunBlock: intPtr(10),
+			},
+			errContains: "cannot be enabled before the shanghai block",
+		},
+		{
+			name: "cancun block before shanghai block",
+			params: Params{
+				ShanghaiBlock: intPtr(10),
+				CancunBlock:   intPtr(5),
+			},
+			errContains: "cannot be enabled before the shanghai block",
+		},
+		{
+			name: "prague block before cancun block",
+			params: Params{
+				ShanghaiBlock: intPtr(10),
+				CancunBlock:   intPtr(10),
+				PragueBlock:   intPtr(5),
+			},
+			errContains: "cannot be enabled before the cancun block",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -79,6 +113,86 @@ func TestParamsEIPs(t *testing.T) {
 	require.Equal(t, []string{"ethereum_2929", "ethereum_1884", "ethereum_1344"}, actual)
 }
 
+func TestParamsEthereumConfig(t *testing.T) {
+	testCases := []struct {
+		name         string
+		chainBlock   *sdkmath.Int
+		paramsBlock  *sdkmath.Int
+		expForkBlock *big.Int
+	}{
+		{
+			"not enabled on the params",
+			intPtr(0),
+			nil,
+			nil,
+		},
+		{
+			"not enabled on the chain config",
+			nil,
+			intPtr(0),
+			nil,
+		},
+		{
+			"enabled later on the params",
+			intPtr(0),
+			intPtr(100),
+			big.NewInt(100),
+		},
+		{
+			"enabled later on the chain config",
+			intPtr(200),
+			intPtr(100),
+			big.NewInt(200),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chainConfig := DefaultChainConfig("")
+			chainConfig.ShanghaiBlock = tc.chainBlock
+			chainConfig.CancunBlock = tc.chainBlock
+			ethCfg := chainConfig.EthereumConfig(nil)
+
+			params := DefaultParams()
+			params.ShanghaiBlock = tc.paramsBlock
+			params.CancunBlock = tc.paramsBlock
+
+			cfg := params.EthereumConfig(ethCfg)
+			require.Equal(t, tc.expForkBlock, cfg.ShanghaiBlock)
+			require.Equal(t, tc.expForkBlock, cfg.CancunBlock)
+			// the given chain config is not modified
+			require.Equal(t, getBlockValue(tc.chainBlock), ethCfg.ShanghaiBlock)
+		})
+	}
+}
+
+func TestParamsIsPrague(t *testing.T) {
+	testCases := []struct {
+		name        string
+		pragueBlock *sdkmath.Int
+		height      int64
+		expPrague   bool
+	}{
+		{"not enabled", nil, 100, false},
+		{"before the prague block", intPtr(10), 9, false},
+		{"at the prague block", intPtr(10), 10, true},
+		{"after the prague block", intPtr(10), 11, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.PragueBlock = tc.pragueBlock
+			require.Equal(t, tc.expPrague, params.IsPrague(tc.height))
+		})
+	}
+}
+
+func intPtr(i int64) *sdkmath.Int {
+	value := sdkmath.NewInt(i)
+	return &value
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateBool(""))
 	require.NoError(t, validateBool(true))
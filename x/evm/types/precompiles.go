@@ -3,6 +3,17 @@
 
 package types
 
+// EIP-2537 BLS12-381 precompile addresses
+const (
+	BLS12381G1AddPrecompileAddress   = "0x000000000000000000000000000000000000000b"
+	BLS12381G1MSMPrecompileAddress   = "0x000000000000000000000000000000000000000C"
+	BLS12381G2AddPrecompileAddress   = "0x000000000000000000000000000000000000000d"
+	BLS12381G2MSMPrecompileAddress   = "0x000000000000000000000000000000000000000E"
+	BLS12381PairingPrecompileAddress = "0x000000000000000000000000000000000000000F"
+	BLS12381MapG1PrecompileAddress   = "0x0000000000000000000000000000000000000010"
+	BLS12381MapG2PrecompileAddress   = "0x0000000000000000000000000000000000000011"
+)
+
 const (
 	P256PrecompileAddress   = "0x0000000000000000000000000000000000000100"
 	Bech32PrecompileAddress = "0x0000000000000000000000000000000000000400"
@@ -17,6 +28,11 @@ const (
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
 	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
 	EvidencePrecompileAddress     = "0x0000000000000000000000000000000000000807"
+	AuthzPrecompileAddress        = "0x0000000000000000000000000000000000000808"
+	FeegrantPrecompileAddress     = "0x0000000000000000000000000000000000000809"
+	ICAPrecompileAddress          = "0x000000000000000000000000000000000000080a"
+	ERC20ModulePrecompileAddress  = "0x000000000000000000000000000000000000080b"
+	EpochsPrecompileAddress       = "0x000000000000000000000000000000000000080c"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -24,6 +40,13 @@ const (
 // NOTE: To be explicit, this list does not include the dynamically registered EVM extensions
 // like the ERC-20 extensions.
 var AvailableStaticPrecompiles = []string{
+	BLS12381G1AddPrecompileAddress,
+	BLS12381G1MSMPrecompileAddress,
+	BLS12381G2AddPrecompileAddress,
+	BLS12381G2MSMPrecompileAddress,
+	BLS12381PairingPrecompileAddress,
+	BLS12381MapG1PrecompileAddress,
+	BLS12381MapG2PrecompileAddress,
 	P256PrecompileAddress,
 	Bech32PrecompileAddress,
 	StakingPrecompileAddress,
@@ -34,4 +57,9 @@ var AvailableStaticPrecompiles = []string{
 	GovPrecompileAddress,
 	SlashingPrecompileAddress,
 	EvidencePrecompileAddress,
+	AuthzPrecompileAddress,
+	FeegrantPrecompileAddress,
+	ICAPrecompileAddress,
+	ERC20ModulePrecompileAddress,
+	EpochsPrecompileAddress,
 }
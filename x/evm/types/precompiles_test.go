@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBLS12381PrecompileAddresses(t *testing.T) {
+	require.Len(t, vm.PrecompiledContractsBLS, 7)
+
+	for address := range vm.PrecompiledContractsBLS {
+		// the params store the checksummed hex addresses
+		require.True(t, slices.Contains(types.AvailableStaticPrecompiles, address.String()), address.String())
+		require.False(t, slices.Contains(vm.PrecompiledAddressesBerlin, address), address.String())
+		require.NoError(t, types.ValidatePrecompiles([]string{address.String()}))
+	}
+}
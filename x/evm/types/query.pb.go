@@ -881,6 +881,12 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides uses the same json format as the json rpc api state overrides.
+	// They are applied to the state before executing the call.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides uses the same json format as the json rpc api block
+	// overrides. They are applied to the block context of the call.
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -949,6 +955,20 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -1019,6 +1039,295 @@ func (m *EstimateGasResponse) GetVmError() string {
 	return ""
 }
 
+// SimulateV1Request defines SimulateV1 request
+type SimulateV1Request struct {
+	// opts uses the same json format as the json rpc api simulation options.
+	// It contains the calls to execute and the overrides of each simulated block.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *SimulateV1Request) Reset()         { *m = SimulateV1Request{} }
+func (m *SimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Request) ProtoMessage()    {}
+func (*SimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{18}
+}
+
+func (m *SimulateV1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Request.Merge(m, src)
+}
+
+func (m *SimulateV1Request) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Request proto.InternalMessageInfo
+
+func (m *SimulateV1Request) GetOpts() []byte {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *SimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// SimulateV1Response defines SimulateV1 response
+type SimulateV1Response struct {
+	// blocks are the results of the simulated blocks
+	Blocks []SimulatedBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
+}
+
+func (m *SimulateV1Response) Reset()         { *m = SimulateV1Response{} }
+func (m *SimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Response) ProtoMessage()    {}
+func (*SimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{19}
+}
+
+func (m *SimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Response.Merge(m, src)
+}
+
+func (m *SimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Response proto.InternalMessageInfo
+
+func (m *SimulateV1Response) GetBlocks() []SimulatedBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+// SimulatedBlock defines the result of the calls executed in a simulated block
+type SimulatedBlock struct {
+	// number of the simulated block
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// time is the timestamp of the simulated block in seconds
+	Time uint64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// gas_used is the sum of the gas used by the calls of the block
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// base_fee is the EIP1559 base fee of the simulated block
+	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+	// calls are the results of the calls executed in the block
+	Calls []SimulatedCallResult `protobuf:"bytes,5,rep,name=calls,proto3" json:"calls"`
+}
+
+func (m *SimulatedBlock) Reset()         { *m = SimulatedBlock{} }
+func (m *SimulatedBlock) String() string { return proto.CompactTextString(m) }
+func (*SimulatedBlock) ProtoMessage()    {}
+func (*SimulatedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{20}
+}
+
+func (m *SimulatedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SimulatedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SimulatedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedBlock.Merge(m, src)
+}
+
+func (m *SimulatedBlock) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SimulatedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedBlock proto.InternalMessageInfo
+
+func (m *SimulatedBlock) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *SimulatedBlock) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *SimulatedBlock) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *SimulatedBlock) GetCalls() []SimulatedCallResult {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+// SimulatedCallResult defines the result of a single simulated call
+type SimulatedCallResult struct {
+	// ret is the returned data from evm function (result or data supplied with revert
+	// opcode)
+	Ret []byte `protobuf:"bytes,1,opt,name=ret,proto3" json:"ret,omitempty"`
+	// gas_used specifies how much gas was consumed by the call
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// logs contains the logs emitted by the call
+	Logs []*Log `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+	// vm_error is the error returned by vm execution
+	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *SimulatedCallResult) Reset()         { *m = SimulatedCallResult{} }
+func (m *SimulatedCallResult) String() string { return proto.CompactTextString(m) }
+func (*SimulatedCallResult) ProtoMessage()    {}
+func (*SimulatedCallResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{21}
+}
+
+func (m *SimulatedCallResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SimulatedCallResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedCallResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SimulatedCallResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedCallResult.Merge(m, src)
+}
+
+func (m *SimulatedCallResult) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SimulatedCallResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedCallResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedCallResult proto.InternalMessageInfo
+
+func (m *SimulatedCallResult) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *SimulatedCallResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *SimulatedCallResult) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *SimulatedCallResult) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -1046,7 +1355,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{18}
+	return fileDescriptor_e15a877459347994, []int{22}
 }
 
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
@@ -1153,7 +1462,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{19}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
@@ -1218,7 +1527,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
@@ -1318,7 +1627,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
@@ -1359,24 +1668,40 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
-// QueryBaseFeeRequest defines the request type for querying the EIP1559 base
-// fee.
-type QueryBaseFeeRequest struct{}
-
-func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
-func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBaseFeeRequest) ProtoMessage()    {}
-func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,5,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// overrides uses the same json format as the json rpc api state overrides.
+	// They are applied to the state before tracing the call.
+	Overrides []byte `protobuf:"bytes,6,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides uses the same json format as the json rpc api block
+	// overrides. They are applied to the block context of the call.
+	BlockOverrides []byte `protobuf:"bytes,7,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{26}
 }
 
-func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1387,38 +1712,180 @@ func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 	}
 }
 
-func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
 }
 
-func (m *QueryBaseFeeRequest) XXX_Size() int {
+func (m *QueryTraceCallRequest) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
 
-// QueryBaseFeeResponse returns the EIP1559 base fee.
-type QueryBaseFeeResponse struct {
-	// base_fee is the EIP1559 base fee
-	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
 }
 
-func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
-func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBaseFeeResponse) ProtoMessage()    {}
-func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
 }
 
-func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
 }
 
-func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{27}
+}
+
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// QueryBaseFeeRequest defines the request type for querying the EIP1559 base
+// fee.
+type QueryBaseFeeRequest struct{}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{28}
+}
+
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse returns the EIP1559 base fee.
+type QueryBaseFeeResponse struct {
+	// base_fee is the EIP1559 base fee
+	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{29}
+}
+
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
 	} else {
@@ -1452,7 +1919,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{24}
+	return fileDescriptor_e15a877459347994, []int{30}
 }
 
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
@@ -1496,7 +1963,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{25}
+	return fileDescriptor_e15a877459347994, []int{31}
 }
 
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
@@ -1537,7 +2004,7 @@ func (m *QueryConfigRequest) Reset()         { *m = QueryConfigRequest{} }
 func (m *QueryConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigRequest) ProtoMessage()    {}
 func (*QueryConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{26}
+	return fileDescriptor_e15a877459347994, []int{32}
 }
 
 func (m *QueryConfigRequest) XXX_Unmarshal(b []byte) error {
@@ -1581,7 +2048,7 @@ func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
 func (m *QueryConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigResponse) ProtoMessage()    {}
 func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{27}
+	return fileDescriptor_e15a877459347994, []int{33}
 }
 
 func (m *QueryConfigResponse) XXX_Unmarshal(b []byte) error {
@@ -1622,6 +2089,185 @@ func (m *QueryConfigResponse) GetConfig() *ChainConfig {
 	return nil
 }
 
+// QueryCustomEIPsRequest defines the request type for querying the active custom EIPs
+type QueryCustomEIPsRequest struct{}
+
+func (m *QueryCustomEIPsRequest) Reset()         { *m = QueryCustomEIPsRequest{} }
+func (m *QueryCustomEIPsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCustomEIPsRequest) ProtoMessage()    {}
+func (*QueryCustomEIPsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{34}
+}
+
+func (m *QueryCustomEIPsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryCustomEIPsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCustomEIPsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryCustomEIPsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCustomEIPsRequest.Merge(m, src)
+}
+
+func (m *QueryCustomEIPsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryCustomEIPsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCustomEIPsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCustomEIPsRequest proto.InternalMessageInfo
+
+// QueryCustomEIPsResponse returns the active custom EIPs and their gas table.
+type QueryCustomEIPsResponse struct {
+	// custom_eips is the list of custom EIPs activated in the extra_eips
+	CustomEIPs []CustomEIP `protobuf:"bytes,1,rep,name=custom_eips,json=customEips,proto3" json:"custom_eips"`
+	// gas_table is the gas of the opcodes modified by the active custom EIPs
+	GasTable []OpCodeGas `protobuf:"bytes,2,rep,name=gas_table,json=gasTable,proto3" json:"gas_table"`
+}
+
+func (m *QueryCustomEIPsResponse) Reset()         { *m = QueryCustomEIPsResponse{} }
+func (m *QueryCustomEIPsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCustomEIPsResponse) ProtoMessage()    {}
+func (*QueryCustomEIPsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{35}
+}
+
+func (m *QueryCustomEIPsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryCustomEIPsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCustomEIPsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryCustomEIPsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCustomEIPsResponse.Merge(m, src)
+}
+
+func (m *QueryCustomEIPsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryCustomEIPsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCustomEIPsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCustomEIPsResponse proto.InternalMessageInfo
+
+func (m *QueryCustomEIPsResponse) GetCustomEIPs() []CustomEIP {
+	if m != nil {
+		return m.CustomEIPs
+	}
+	return nil
+}
+
+func (m *QueryCustomEIPsResponse) GetGasTable() []OpCodeGas {
+	if m != nil {
+		return m.GasTable
+	}
+	return nil
+}
+
+// OpCodeGas defines the gas of an opcode of the EVM jump table
+type OpCodeGas struct {
+	// opcode is the name of the opcode
+	Opcode string `protobuf:"bytes,1,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	// default_constant_gas is the constant gas of the opcode without the custom EIPs
+	DefaultConstantGas uint64 `protobuf:"varint,2,opt,name=default_constant_gas,json=defaultConstantGas,proto3" json:"default_constant_gas,omitempty"`
+	// constant_gas is the constant gas of the opcode with all the extra EIPs applied
+	ConstantGas uint64 `protobuf:"varint,3,opt,name=constant_gas,json=constantGas,proto3" json:"constant_gas,omitempty"`
+	// dynamic_gas is true if the opcode charges a dynamic gas on top of the constant gas
+	DynamicGas bool `protobuf:"varint,4,opt,name=dynamic_gas,json=dynamicGas,proto3" json:"dynamic_gas,omitempty"`
+}
+
+func (m *OpCodeGas) Reset()         { *m = OpCodeGas{} }
+func (m *OpCodeGas) String() string { return proto.CompactTextString(m) }
+func (*OpCodeGas) ProtoMessage()    {}
+func (*OpCodeGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{36}
+}
+
+func (m *OpCodeGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *OpCodeGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpCodeGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *OpCodeGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpCodeGas.Merge(m, src)
+}
+
+func (m *OpCodeGas) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *OpCodeGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpCodeGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpCodeGas proto.InternalMessageInfo
+
+func (m *OpCodeGas) GetOpcode() string {
+	if m != nil {
+		return m.Opcode
+	}
+	return ""
+}
+
+func (m *OpCodeGas) GetDefaultConstantGas() uint64 {
+	if m != nil {
+		return m.DefaultConstantGas
+	}
+	return 0
+}
+
+func (m *OpCodeGas) GetConstantGas() uint64 {
+	if m != nil {
+		return m.ConstantGas
+	}
+	return 0
+}
+
+func (m *OpCodeGas) GetDynamicGas() bool {
+	if m != nil {
+		return m.DynamicGas
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
@@ -1641,125 +2287,162 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*SimulateV1Request)(nil), "ethermint.evm.v1.SimulateV1Request")
+	proto.RegisterType((*SimulateV1Response)(nil), "ethermint.evm.v1.SimulateV1Response")
+	proto.RegisterType((*SimulatedBlock)(nil), "ethermint.evm.v1.SimulatedBlock")
+	proto.RegisterType((*SimulatedCallResult)(nil), "ethermint.evm.v1.SimulatedCallResult")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "ethermint.evm.v1.QueryTraceCallResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "ethermint.evm.v1.QueryGlobalMinGasPriceRequest")
 	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "ethermint.evm.v1.QueryGlobalMinGasPriceResponse")
 	proto.RegisterType((*QueryConfigRequest)(nil), "ethermint.evm.v1.QueryConfigRequest")
 	proto.RegisterType((*QueryConfigResponse)(nil), "ethermint.evm.v1.QueryConfigResponse")
+	proto.RegisterType((*QueryCustomEIPsRequest)(nil), "ethermint.evm.v1.QueryCustomEIPsRequest")
+	proto.RegisterType((*QueryCustomEIPsResponse)(nil), "ethermint.evm.v1.QueryCustomEIPsResponse")
+	proto.RegisterType((*OpCodeGas)(nil), "ethermint.evm.v1.OpCodeGas")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x13, 0x47,
-	0x1b, 0xce, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0x00, 0xc7, 0x24, 0x76, 0x58, 0xc8, 0x0f,
-	0xf8, 0x60, 0x37, 0xc9, 0xf7, 0x7d, 0x48, 0xdf, 0xd7, 0x43, 0x49, 0xa2, 0x10, 0x28, 0x50, 0xd1,
-	0x6d, 0xd4, 0x43, 0xa5, 0xca, 0x1a, 0xaf, 0x87, 0xf5, 0x2a, 0xde, 0x1d, 0xb3, 0x33, 0xb6, 0x1c,
-	0x10, 0x87, 0xa2, 0xaa, 0x2d, 0xea, 0x05, 0xa9, 0xb7, 0xf6, 0xc2, 0xb1, 0x52, 0x2f, 0xbd, 0xf1,
-	0x2f, 0x70, 0x44, 0xed, 0xa5, 0xea, 0x81, 0x56, 0x50, 0xa9, 0xfd, 0x1b, 0x7a, 0xaa, 0xe6, 0xc7,
-	0xda, 0xbb, 0xb6, 0x37, 0x0e, 0x15, 0xbd, 0xf5, 0x92, 0xec, 0xcc, 0xbc, 0xef, 0xfb, 0x3c, 0xf3,
-	0xce, 0x3b, 0xf3, 0x3e, 0x06, 0x73, 0x98, 0xd5, 0x70, 0xe0, 0xb9, 0x3e, 0x33, 0x71, 0xcb, 0x33,
-	0x5b, 0x6b, 0xe6, 0xdd, 0x26, 0x0e, 0xf6, 0x8d, 0x46, 0x40, 0x18, 0x81, 0xd3, 0x9d, 0x55, 0x03,
-	0xb7, 0x3c, 0xa3, 0xb5, 0x56, 0x38, 0x86, 0x3c, 0xd7, 0x27, 0xa6, 0xf8, 0x2b, 0x8d, 0x0a, 0x17,
-	0x6c, 0x42, 0x3d, 0x42, 0xcd, 0x0a, 0xa2, 0x58, 0x7a, 0x9b, 0xad, 0xb5, 0x0a, 0x66, 0x68, 0xcd,
-	0x6c, 0x20, 0xc7, 0xf5, 0x11, 0x73, 0x89, 0xaf, 0x6c, 0x0b, 0x7d, 0x70, 0x3c, 0xae, 0x5c, 0x9b,
-	0xed, 0x5b, 0x63, 0x6d, 0xb5, 0x34, 0xe3, 0x10, 0x87, 0x88, 0x4f, 0x93, 0x7f, 0xa9, 0xd9, 0x39,
-	0x87, 0x10, 0xa7, 0x8e, 0x4d, 0xd4, 0x70, 0x4d, 0xe4, 0xfb, 0x84, 0x09, 0x24, 0xaa, 0x56, 0x4b,
-	0x6a, 0x55, 0x8c, 0x2a, 0xcd, 0x3b, 0x26, 0x73, 0x3d, 0x4c, 0x19, 0xf2, 0x1a, 0xd2, 0x40, 0xff,
-	0x1f, 0x38, 0xfe, 0x1e, 0x67, 0xbb, 0x61, 0xdb, 0xa4, 0xe9, 0x33, 0x0b, 0xdf, 0x6d, 0x62, 0xca,
-	0x60, 0x1e, 0x64, 0x50, 0xb5, 0x1a, 0x60, 0x4a, 0xf3, 0xda, 0x82, 0xb6, 0x32, 0x61, 0x85, 0xc3,
-	0xff, 0x67, 0x3f, 0x7f, 0x52, 0x1a, 0xf9, 0xfd, 0x49, 0x69, 0x44, 0xb7, 0xc1, 0x4c, 0xdc, 0x95,
-	0x36, 0x88, 0x4f, 0x31, 0xf7, 0xad, 0xa0, 0x3a, 0xf2, 0x6d, 0x1c, 0xfa, 0xaa, 0x21, 0x3c, 0x0d,
-	0x26, 0x6c, 0x52, 0xc5, 0xe5, 0x1a, 0xa2, 0xb5, 0xfc, 0xa8, 0x58, 0xcb, 0xf2, 0x89, 0x6b, 0x88,
-	0xd6, 0xe0, 0x0c, 0x18, 0xf3, 0x09, 0x77, 0x4a, 0x2d, 0x68, 0x2b, 0x69, 0x4b, 0x0e, 0xf4, 0xb7,
-	0xc1, 0xac, 0x00, 0xd9, 0x12, 0xe9, 0xfd, 0x0b, 0x2c, 0x3f, 0xd5, 0x40, 0x61, 0x50, 0x04, 0x45,
-	0x76, 0x11, 0x1c, 0x91, 0x27, 0x57, 0x8e, 0x47, 0x9a, 0x92, 0xb3, 0x1b, 0x72, 0x12, 0x16, 0x40,
-	0x96, 0x72, 0x50, 0xce, 0x6f, 0x54, 0xf0, 0xeb, 0x8c, 0x79, 0x08, 0x24, 0xa3, 0x96, 0xfd, 0xa6,
-	0x57, 0xc1, 0x81, 0xda, 0xc1, 0x94, 0x9a, 0x7d, 0x57, 0x4c, 0xea, 0x37, 0xc0, 0x9c, 0xe0, 0xf1,
-	0x01, 0xaa, 0xbb, 0x55, 0xc4, 0x48, 0xd0, 0xb3, 0x99, 0x33, 0x60, 0xd2, 0x26, 0x7e, 0x2f, 0x8f,
-	0x1c, 0x9f, 0xdb, 0xe8, 0xdb, 0xd5, 0x17, 0x1a, 0x98, 0x4f, 0x88, 0xa6, 0x36, 0xb6, 0x0c, 0x8e,
-	0x86, 0xac, 0xe2, 0x11, 0x43, 0xb2, 0x6f, 0x70, 0x6b, 0x61, 0x11, 0x6d, 0xca, 0x73, 0x7e, 0x9d,
-	0xe3, 0x59, 0x55, 0x45, 0xd4, 0x71, 0x1d, 0x56, 0x44, 0xfa, 0x0d, 0x05, 0xf6, 0x3e, 0x23, 0x01,
-	0x72, 0x86, 0x83, 0xc1, 0x69, 0x90, 0xda, 0xc3, 0xfb, 0xaa, 0xde, 0xf8, 0x67, 0x04, 0xfe, 0xa2,
-	0x82, 0xef, 0x04, 0x53, 0xf0, 0x33, 0x60, 0xac, 0x85, 0xea, 0xcd, 0x10, 0x5c, 0x0e, 0xf4, 0xcb,
-	0x60, 0x5a, 0x95, 0x52, 0xf5, 0xb5, 0x36, 0xb9, 0x0c, 0x8e, 0x45, 0xfc, 0x14, 0x04, 0x04, 0x69,
-	0x5e, 0xfb, 0xc2, 0x6b, 0xd2, 0x12, 0xdf, 0xfa, 0x3d, 0x00, 0x85, 0xe1, 0x6e, 0xfb, 0x26, 0x71,
-	0x68, 0x08, 0x01, 0x41, 0x5a, 0xdc, 0x18, 0x19, 0x5f, 0x7c, 0xc3, 0xab, 0x00, 0x74, 0xdf, 0x15,
-	0xb1, 0xb7, 0xdc, 0xfa, 0x92, 0x21, 0x8b, 0xd6, 0xe0, 0x8f, 0x90, 0x21, 0x9f, 0x30, 0xf5, 0x08,
-	0x19, 0xb7, 0xbb, 0xa9, 0xb2, 0x22, 0x9e, 0x11, 0x92, 0x8f, 0x34, 0x95, 0xd8, 0x10, 0x5c, 0xf1,
-	0x3c, 0x0f, 0xd2, 0x75, 0xe2, 0xf0, 0xdd, 0xa5, 0x56, 0x72, 0xeb, 0x27, 0x8c, 0xde, 0xd7, 0xd0,
-	0xb8, 0x49, 0x1c, 0x4b, 0x98, 0xc0, 0x9d, 0x01, 0xa4, 0x96, 0x87, 0x92, 0x92, 0x38, 0x51, 0x56,
-	0xfa, 0x8c, 0xca, 0xc3, 0x6d, 0x14, 0x20, 0x2f, 0xcc, 0x83, 0x6e, 0x29, 0x82, 0xe1, 0xac, 0x22,
-	0xf8, 0x16, 0x18, 0x6f, 0x88, 0x19, 0x91, 0xa0, 0xdc, 0x7a, 0xbe, 0x9f, 0xa2, 0xf4, 0xd8, 0x9c,
-	0x78, 0xf6, 0xa2, 0x34, 0xf2, 0xcd, 0x6f, 0xdf, 0x5d, 0xd0, 0x2c, 0xe5, 0xa2, 0x3f, 0xd5, 0xc0,
-	0x91, 0x6d, 0x56, 0xdb, 0x42, 0xf5, 0x7a, 0x24, 0xdd, 0x28, 0x70, 0x68, 0x78, 0x30, 0xfc, 0x1b,
-	0x9e, 0x02, 0x19, 0x07, 0xd1, 0xb2, 0x8d, 0x1a, 0xea, 0x8e, 0x8c, 0x3b, 0x88, 0x6e, 0xa1, 0x06,
-	0xfc, 0x08, 0x4c, 0x37, 0x02, 0xd2, 0x20, 0x14, 0x07, 0x9d, 0x7b, 0xc6, 0xef, 0xc8, 0xe4, 0xe6,
-	0xfa, 0x1f, 0x2f, 0x4a, 0x86, 0xe3, 0xb2, 0x5a, 0xb3, 0x62, 0xd8, 0xc4, 0x33, 0x55, 0x83, 0x90,
-	0xff, 0x2e, 0xd1, 0xea, 0x9e, 0xc9, 0xf6, 0x1b, 0x98, 0x1a, 0x5b, 0xdd, 0x0b, 0x6e, 0x1d, 0x0d,
-	0x63, 0x85, 0x97, 0x73, 0x16, 0x64, 0xed, 0x1a, 0x72, 0xfd, 0xb2, 0x5b, 0xcd, 0xa7, 0x17, 0xb4,
-	0x95, 0x94, 0x95, 0x11, 0xe3, 0xeb, 0x55, 0x7d, 0x17, 0x1c, 0xdf, 0xa6, 0xcc, 0xf5, 0x10, 0xc3,
-	0x3b, 0xa8, 0x9b, 0x8d, 0x69, 0x90, 0x72, 0x90, 0x24, 0x9f, 0xb6, 0xf8, 0x27, 0x9f, 0x09, 0x30,
-	0x13, 0xbc, 0x27, 0x2d, 0xfe, 0xc9, 0xa3, 0xb6, 0xbc, 0x32, 0x0e, 0x02, 0x22, 0x2f, 0xf4, 0x84,
-	0x95, 0x69, 0x79, 0xdb, 0x7c, 0xa8, 0x3f, 0x4a, 0x87, 0x55, 0x10, 0x20, 0x1b, 0xef, 0xb6, 0xc3,
-	0xa4, 0xac, 0x81, 0x94, 0x47, 0x1d, 0x95, 0xe1, 0x52, 0x7f, 0x86, 0x6f, 0x51, 0x67, 0x9b, 0xcf,
-	0xe1, 0xa6, 0xb7, 0xdb, 0xb6, 0xb8, 0x2d, 0xbc, 0x02, 0x26, 0x19, 0x0f, 0x52, 0xb6, 0x89, 0x7f,
-	0xc7, 0x75, 0x04, 0x52, 0x6e, 0x7d, 0xbe, 0xdf, 0x57, 0x40, 0x6d, 0x09, 0x23, 0x2b, 0xc7, 0xba,
-	0x03, 0xb8, 0x05, 0x26, 0x1b, 0x01, 0xae, 0x62, 0x1b, 0x53, 0x4a, 0x02, 0x9a, 0x4f, 0x8b, 0x12,
-	0x1c, 0x8a, 0x1e, 0x73, 0xe2, 0xef, 0x6a, 0xa5, 0x4e, 0xec, 0xbd, 0xf0, 0x05, 0x1b, 0x13, 0x69,
-	0xcc, 0x89, 0x39, 0xf9, 0x7e, 0xc1, 0x79, 0x00, 0xa4, 0x89, 0xb8, 0x66, 0xe3, 0x22, 0x23, 0x13,
-	0x62, 0x46, 0x74, 0xa6, 0x6b, 0xe1, 0x32, 0x6f, 0x9e, 0xf9, 0x8c, 0xd8, 0x46, 0xc1, 0x90, 0x9d,
-	0xd5, 0x08, 0x3b, 0xab, 0xb1, 0x1b, 0x76, 0xd6, 0xcd, 0x29, 0x5e, 0x66, 0x8f, 0x7f, 0x2e, 0x69,
-	0xb2, 0xd4, 0x64, 0x24, 0xbe, 0x3c, 0xb0, 0x5a, 0xb2, 0x7f, 0x4f, 0xb5, 0x4c, 0xc4, 0xaa, 0x05,
-	0xea, 0x60, 0x4a, 0xee, 0xc1, 0x43, 0xed, 0x32, 0x2f, 0x10, 0x10, 0x49, 0xc3, 0x2d, 0xd4, 0xde,
-	0x41, 0xf4, 0x9d, 0x74, 0x76, 0x74, 0x3a, 0x65, 0x65, 0x59, 0xbb, 0xec, 0xfa, 0x55, 0xdc, 0xd6,
-	0x2f, 0xa8, 0xc7, 0xb1, 0x53, 0x0a, 0xdd, 0x97, 0xab, 0x8a, 0x18, 0x0a, 0x2f, 0x08, 0xff, 0xd6,
-	0x9f, 0xa6, 0xc0, 0xc9, 0xae, 0xf1, 0x26, 0x8f, 0x1a, 0x29, 0x1d, 0xd6, 0x0e, 0xdf, 0x8f, 0xe1,
-	0xa5, 0xc3, 0xda, 0xf4, 0x0d, 0x94, 0xce, 0x3f, 0xa7, 0x7e, 0xc8, 0x53, 0xd7, 0x2f, 0x81, 0x53,
-	0x7d, 0x07, 0x77, 0xc0, 0x41, 0x9f, 0xe8, 0xf4, 0x7a, 0x8a, 0xaf, 0xe2, 0xb0, 0xa7, 0xe8, 0x37,
-	0x3b, 0x7d, 0x5c, 0x4d, 0xab, 0x10, 0xff, 0x01, 0x59, 0xfe, 0xf0, 0x97, 0xef, 0x60, 0xd5, 0x4b,
-	0x37, 0x67, 0x7f, 0x7a, 0x51, 0x3a, 0x21, 0x77, 0x48, 0xab, 0x7b, 0x86, 0x4b, 0x4c, 0x0f, 0xb1,
-	0x9a, 0x71, 0xdd, 0x67, 0xbc, 0xc7, 0x0b, 0x6f, 0xbd, 0xa4, 0xd4, 0xcd, 0x4e, 0x9d, 0x54, 0x50,
-	0xfd, 0x96, 0xeb, 0xef, 0x20, 0x7a, 0x3b, 0x70, 0x3b, 0xd2, 0x42, 0xb7, 0x41, 0x31, 0xc9, 0x40,
-	0x01, 0x6f, 0x80, 0x29, 0xcf, 0xf5, 0xf9, 0xa6, 0xcb, 0x0d, 0xbe, 0xa0, 0xd0, 0xe7, 0xf9, 0x29,
-	0x25, 0x33, 0xc8, 0x79, 0xdd, 0x50, 0x9d, 0x2e, 0xa4, 0xea, 0xab, 0xb3, 0xd3, 0xe3, 0xb1, 0x59,
-	0x85, 0xf7, 0x5f, 0x30, 0xae, 0x8a, 0x55, 0x4b, 0x2a, 0xd6, 0x2d, 0x7e, 0x2a, 0xca, 0x4d, 0x19,
-	0xaf, 0x7f, 0x7f, 0x04, 0x8c, 0x89, 0x70, 0xf0, 0x63, 0x0d, 0x64, 0x94, 0x88, 0x83, 0x8b, 0xfd,
-	0xce, 0x03, 0x54, 0x7a, 0x61, 0x69, 0x98, 0x99, 0xe4, 0xa6, 0x2f, 0x3f, 0xfc, 0xe1, 0xd7, 0x2f,
-	0x47, 0xcf, 0xc0, 0x12, 0xff, 0x4d, 0x41, 0x68, 0xf8, 0xcb, 0x42, 0x89, 0x38, 0xf3, 0xbe, 0x2a,
-	0xca, 0x07, 0xf0, 0x2b, 0x0d, 0x4c, 0xc5, 0x74, 0x32, 0xfc, 0x57, 0x02, 0xc4, 0x20, 0x3d, 0x5e,
-	0xb8, 0x78, 0x38, 0x63, 0xc5, 0xca, 0x10, 0xac, 0x56, 0xe0, 0x52, 0x9c, 0x55, 0x28, 0xc7, 0xfb,
-	0xc8, 0x7d, 0xab, 0x81, 0xe9, 0x5e, 0xb9, 0x0b, 0x8d, 0x04, 0xc8, 0x04, 0x95, 0x5d, 0x30, 0x0f,
-	0x6d, 0xaf, 0x58, 0x5e, 0x16, 0x2c, 0x57, 0xa1, 0x11, 0x67, 0xd9, 0x0a, 0xed, 0xbb, 0x44, 0xa3,
-	0xea, 0xfd, 0x01, 0x7c, 0xa8, 0x81, 0x8c, 0x12, 0xb5, 0x89, 0xc7, 0x19, 0xd7, 0xcb, 0x89, 0xc7,
-	0xd9, 0xa3, 0x8d, 0xf5, 0x15, 0x41, 0x49, 0x87, 0x0b, 0x71, 0x4a, 0x4a, 0x20, 0xd3, 0x48, 0xca,
-	0x3e, 0xd3, 0x40, 0x46, 0x49, 0xdb, 0x44, 0x12, 0x71, 0x1d, 0x9d, 0x48, 0xa2, 0x47, 0x21, 0xeb,
-	0x97, 0x04, 0x89, 0x65, 0xb8, 0x18, 0x27, 0x41, 0xa5, 0x59, 0x97, 0x83, 0x79, 0x7f, 0x0f, 0xef,
-	0x3f, 0x80, 0x2d, 0x90, 0xe6, 0xea, 0x17, 0xea, 0x89, 0x25, 0xd2, 0x91, 0xd4, 0x85, 0xb3, 0x07,
-	0xda, 0x28, 0xfc, 0x45, 0x81, 0x5f, 0x82, 0xf3, 0xbd, 0xd5, 0x53, 0x8d, 0x65, 0x80, 0x82, 0x71,
-	0x29, 0xfe, 0xe0, 0xb9, 0x84, 0xa8, 0x31, 0x8d, 0x59, 0x58, 0x1c, 0x62, 0xa5, 0xd0, 0xe7, 0x04,
-	0xfa, 0x49, 0x38, 0x13, 0x47, 0x97, 0xa2, 0x12, 0x32, 0x90, 0x51, 0x9a, 0x12, 0x2e, 0xf4, 0xc7,
-	0x8b, 0xcb, 0xcd, 0xc2, 0xf2, 0xb0, 0x8e, 0x18, 0x62, 0x16, 0x05, 0x66, 0x1e, 0x9e, 0x8c, 0x63,
-	0x62, 0x56, 0x2b, 0xdb, 0x1c, 0xea, 0x1e, 0xc8, 0x45, 0x04, 0xe1, 0x21, 0x90, 0x07, 0xec, 0x75,
-	0x80, 0xa2, 0xd4, 0x75, 0x81, 0x3b, 0x07, 0x0b, 0x3d, 0xb8, 0xca, 0x94, 0x3f, 0xb1, 0xb0, 0x0d,
-	0x32, 0x4a, 0x25, 0x24, 0xd6, 0x59, 0x5c, 0x50, 0x26, 0xd6, 0x59, 0x8f, 0xd8, 0x48, 0xda, 0xb5,
-	0x94, 0x07, 0xac, 0x0d, 0x3f, 0xd1, 0x00, 0xe8, 0xb6, 0x2e, 0xb8, 0x72, 0x50, 0xd8, 0xa8, 0x2c,
-	0x29, 0x9c, 0x3f, 0x84, 0xa5, 0xe2, 0x70, 0x46, 0x70, 0x38, 0x0d, 0x67, 0x07, 0x71, 0x10, 0xbd,
-	0x94, 0x27, 0x40, 0xb5, 0xbe, 0x03, 0x6e, 0x7b, 0xb4, 0x63, 0x1e, 0x70, 0xdb, 0x63, 0x1d, 0x34,
-	0x29, 0x01, 0x61, 0x57, 0x85, 0x5f, 0x6b, 0xe0, 0x58, 0x5f, 0x1b, 0x84, 0x49, 0xef, 0x5c, 0x52,
-	0x47, 0x2d, 0xac, 0x1e, 0xde, 0x41, 0x11, 0x3b, 0x2b, 0x88, 0xcd, 0xc3, 0xd3, 0x71, 0x62, 0xb1,
-	0xae, 0xcb, 0xef, 0x9f, 0x52, 0x64, 0xe7, 0x12, 0x6f, 0x75, 0xa4, 0xbb, 0x26, 0xde, 0xbf, 0x78,
-	0xb7, 0x4d, 0xba, 0x7f, 0xb2, 0xa9, 0x6e, 0x5e, 0x79, 0xf6, 0xb2, 0xa8, 0x3d, 0x7f, 0x59, 0xd4,
-	0x7e, 0x79, 0x59, 0xd4, 0x1e, 0xbf, 0x2a, 0x8e, 0x3c, 0x7f, 0x55, 0x1c, 0xf9, 0xf1, 0x55, 0x71,
-	0xe4, 0xc3, 0xa5, 0x88, 0xd8, 0xea, 0x78, 0x12, 0x6a, 0xb6, 0xd6, 0x57, 0xcd, 0xb6, 0x88, 0x22,
-	0x04, 0x57, 0x65, 0x5c, 0x08, 0xbc, 0x7f, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x02, 0xd3, 0xdf,
-	0xf6, 0x1a, 0x14, 0x00, 0x00,
+	// 2085 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xdd, 0x6f, 0x1b, 0x59,
+	0x15, 0xcf, 0xc4, 0x8e, 0x1d, 0x1f, 0x27, 0xdd, 0xf4, 0xc6, 0x6d, 0xdd, 0x69, 0x12, 0xa7, 0xd3,
+	0xe6, 0xa3, 0xa5, 0x1d, 0x27, 0x01, 0x56, 0x02, 0x24, 0xd8, 0xc6, 0x4a, 0xb3, 0x65, 0x5b, 0x36,
+	0xcc, 0x86, 0x95, 0x40, 0x42, 0xd6, 0xf5, 0xcc, 0xad, 0x33, 0x8a, 0x67, 0xc6, 0x3b, 0xf7, 0xda,
+	0x72, 0xba, 0xaa, 0x04, 0x2b, 0x04, 0xbb, 0xe2, 0x65, 0x25, 0x9e, 0x80, 0x97, 0x7d, 0x44, 0xda,
+	0x17, 0xde, 0x90, 0xf8, 0x07, 0xd8, 0xc7, 0x95, 0x10, 0xd2, 0x8a, 0x87, 0x82, 0x5a, 0x24, 0xf8,
+	0x1b, 0x78, 0x40, 0xe8, 0x7e, 0x8c, 0x3d, 0x13, 0x7b, 0x6c, 0x17, 0x2d, 0x12, 0x48, 0xfb, 0x62,
+	0xdf, 0x8f, 0xf3, 0xf1, 0x3b, 0xe7, 0x9e, 0x7b, 0xe6, 0x9c, 0x0b, 0x2b, 0x84, 0x9d, 0x90, 0xd0,
+	0x73, 0x7d, 0x56, 0x25, 0x5d, 0xaf, 0xda, 0xdd, 0xad, 0xbe, 0xd3, 0x21, 0xe1, 0x99, 0xd9, 0x0e,
+	0x03, 0x16, 0xa0, 0xa5, 0xfe, 0xae, 0x49, 0xba, 0x9e, 0xd9, 0xdd, 0xd5, 0x2f, 0x62, 0xcf, 0xf5,
+	0x83, 0xaa, 0xf8, 0x95, 0x44, 0xfa, 0x6d, 0x3b, 0xa0, 0x5e, 0x40, 0xab, 0x0d, 0x4c, 0x89, 0xe4,
+	0xae, 0x76, 0x77, 0x1b, 0x84, 0xe1, 0xdd, 0x6a, 0x1b, 0x37, 0x5d, 0x1f, 0x33, 0x37, 0xf0, 0x15,
+	0xad, 0x3e, 0xa4, 0x8e, 0xcb, 0x95, 0x7b, 0x57, 0x87, 0xf6, 0x58, 0x4f, 0x6d, 0x95, 0x9a, 0x41,
+	0x33, 0x10, 0xc3, 0x2a, 0x1f, 0xa9, 0xd5, 0x95, 0x66, 0x10, 0x34, 0x5b, 0xa4, 0x8a, 0xdb, 0x6e,
+	0x15, 0xfb, 0x7e, 0xc0, 0x84, 0x26, 0xaa, 0x76, 0x2b, 0x6a, 0x57, 0xcc, 0x1a, 0x9d, 0xc7, 0x55,
+	0xe6, 0x7a, 0x84, 0x32, 0xec, 0xb5, 0x25, 0x81, 0xf1, 0x35, 0x58, 0xfe, 0x2e, 0x47, 0x7b, 0xcf,
+	0xb6, 0x83, 0x8e, 0xcf, 0x2c, 0xf2, 0x4e, 0x87, 0x50, 0x86, 0xca, 0x90, 0xc7, 0x8e, 0x13, 0x12,
+	0x4a, 0xcb, 0xda, 0xba, 0xb6, 0x5d, 0xb0, 0xa2, 0xe9, 0xd7, 0xe7, 0xdf, 0xff, 0xa8, 0x32, 0xf3,
+	0x8f, 0x8f, 0x2a, 0x33, 0x86, 0x0d, 0xa5, 0x24, 0x2b, 0x6d, 0x07, 0x3e, 0x25, 0x9c, 0xb7, 0x81,
+	0x5b, 0xd8, 0xb7, 0x49, 0xc4, 0xab, 0xa6, 0xe8, 0x1a, 0x14, 0xec, 0xc0, 0x21, 0xf5, 0x13, 0x4c,
+	0x4f, 0xca, 0xb3, 0x62, 0x6f, 0x9e, 0x2f, 0xbc, 0x8e, 0xe9, 0x09, 0x2a, 0xc1, 0x9c, 0x1f, 0x70,
+	0xa6, 0xcc, 0xba, 0xb6, 0x9d, 0xb5, 0xe4, 0xc4, 0xf8, 0x16, 0x5c, 0x15, 0x4a, 0x6a, 0xc2, 0xbd,
+	0xff, 0x01, 0xca, 0x9f, 0x6a, 0xa0, 0x8f, 0x92, 0xa0, 0xc0, 0x6e, 0xc0, 0x05, 0x79, 0x72, 0xf5,
+	0xa4, 0xa4, 0x45, 0xb9, 0x7a, 0x4f, 0x2e, 0x22, 0x1d, 0xe6, 0x29, 0x57, 0xca, 0xf1, 0xcd, 0x0a,
+	0x7c, 0xfd, 0x39, 0x17, 0x81, 0xa5, 0xd4, 0xba, 0xdf, 0xf1, 0x1a, 0x24, 0x54, 0x16, 0x2c, 0xaa,
+	0xd5, 0xef, 0x88, 0x45, 0xe3, 0x0d, 0x58, 0x11, 0x38, 0xde, 0xc6, 0x2d, 0xd7, 0xc1, 0x2c, 0x08,
+	0xcf, 0x19, 0x73, 0x1d, 0x16, 0xec, 0xc0, 0x3f, 0x8f, 0xa3, 0xc8, 0xd7, 0xee, 0x0d, 0x59, 0xf5,
+	0x73, 0x0d, 0x56, 0x53, 0xa4, 0x29, 0xc3, 0xb6, 0xe0, 0x95, 0x08, 0x55, 0x52, 0x62, 0x04, 0xf6,
+	0x73, 0x34, 0x2d, 0x0a, 0xa2, 0x7d, 0x79, 0xce, 0x2f, 0x73, 0x3c, 0x3b, 0x50, 0x4a, 0xb2, 0x4e,
+	0x0a, 0x22, 0xe3, 0x0d, 0xa5, 0xec, 0x2d, 0x16, 0x84, 0xb8, 0x39, 0x59, 0x19, 0x5a, 0x82, 0xcc,
+	0x29, 0x39, 0x53, 0xf1, 0xc6, 0x87, 0x31, 0xf5, 0x77, 0xa0, 0x94, 0x14, 0xa6, 0xd4, 0x97, 0x60,
+	0xae, 0x8b, 0x5b, 0x9d, 0x48, 0xb9, 0x9c, 0x18, 0xaf, 0xc2, 0x92, 0x0a, 0x25, 0xe7, 0xa5, 0x8c,
+	0xdc, 0x82, 0x8b, 0x31, 0x3e, 0xa5, 0x02, 0x41, 0x96, 0xc7, 0xbe, 0xe0, 0x5a, 0xb0, 0xc4, 0xd8,
+	0x78, 0x02, 0x48, 0x10, 0x1e, 0xf7, 0x1e, 0x06, 0x4d, 0x1a, 0xa9, 0x40, 0x90, 0x15, 0x37, 0x46,
+	0xca, 0x17, 0x63, 0x74, 0x1f, 0x60, 0x90, 0x57, 0x84, 0x6d, 0xc5, 0xbd, 0x4d, 0x53, 0x06, 0xad,
+	0xc9, 0x93, 0x90, 0x29, 0x53, 0x98, 0x4a, 0x42, 0xe6, 0xd1, 0xc0, 0x55, 0x56, 0x8c, 0x33, 0x06,
+	0xf2, 0x03, 0x0d, 0x96, 0x13, 0xca, 0x15, 0xce, 0x5b, 0x90, 0x6d, 0x05, 0x4d, 0x6e, 0x5d, 0x66,
+	0xbb, 0xb8, 0x77, 0xc9, 0x3c, 0x9f, 0x0d, 0xcd, 0x87, 0x41, 0xd3, 0x12, 0x24, 0xe8, 0x70, 0x04,
+	0xa8, 0xad, 0x89, 0xa0, 0xa4, 0x9e, 0x38, 0x2a, 0xa3, 0xa4, 0xfc, 0x70, 0x84, 0x43, 0xec, 0x45,
+	0x7e, 0x30, 0x2c, 0x58, 0x4e, 0xac, 0x2a, 0x80, 0xdf, 0x80, 0x5c, 0x5b, 0xac, 0x08, 0x07, 0x15,
+	0xf7, 0xca, 0xc3, 0x10, 0x25, 0xc7, 0x7e, 0xe1, 0x93, 0x67, 0x95, 0x99, 0xdf, 0xfc, 0xfd, 0xb7,
+	0xb7, 0x35, 0x4b, 0xb1, 0x18, 0xff, 0xd2, 0xe0, 0xc2, 0x01, 0x3b, 0xa9, 0xe1, 0x56, 0x2b, 0xe6,
+	0x6e, 0x1c, 0x36, 0x69, 0x74, 0x30, 0x7c, 0x8c, 0xae, 0x40, 0xbe, 0x89, 0x69, 0xdd, 0xc6, 0x6d,
+	0x75, 0x47, 0x72, 0x4d, 0x4c, 0x6b, 0xb8, 0x8d, 0x7e, 0x08, 0x4b, 0xed, 0x30, 0x68, 0x07, 0x94,
+	0x84, 0xfd, 0x7b, 0xc6, 0xef, 0xc8, 0xc2, 0xfe, 0xde, 0x3f, 0x9f, 0x55, 0xcc, 0xa6, 0xcb, 0x4e,
+	0x3a, 0x0d, 0xd3, 0x0e, 0xbc, 0xaa, 0xfa, 0x40, 0xc8, 0xbf, 0xbb, 0xd4, 0x39, 0xad, 0xb2, 0xb3,
+	0x36, 0xa1, 0x66, 0x6d, 0x70, 0xc1, 0xad, 0x57, 0x22, 0x59, 0xd1, 0xe5, 0xbc, 0x0a, 0xf3, 0xf6,
+	0x09, 0x76, 0xfd, 0xba, 0xeb, 0x94, 0xb3, 0xeb, 0xda, 0x76, 0xc6, 0xca, 0x8b, 0xf9, 0x03, 0x07,
+	0xad, 0x40, 0x21, 0xe8, 0x92, 0x30, 0x74, 0x1d, 0x42, 0xcb, 0x73, 0x02, 0xeb, 0x60, 0x81, 0x5f,
+	0xff, 0x46, 0x2b, 0xb0, 0x4f, 0xeb, 0x03, 0x9a, 0x9c, 0xa0, 0xb9, 0x20, 0x96, 0xdf, 0x8c, 0x56,
+	0x8d, 0x63, 0x58, 0x3e, 0xa0, 0xcc, 0xf5, 0x30, 0x23, 0x87, 0x78, 0xe0, 0xd4, 0x25, 0xc8, 0x34,
+	0xb1, 0xf4, 0x41, 0xd6, 0xe2, 0x43, 0xbe, 0x12, 0x12, 0x26, 0xcc, 0x5f, 0xb0, 0xf8, 0x90, 0x83,
+	0xeb, 0x7a, 0x75, 0x12, 0x86, 0x81, 0xcc, 0x0b, 0x05, 0x2b, 0xdf, 0xf5, 0x0e, 0xf8, 0xd4, 0xf8,
+	0xbd, 0x06, 0x17, 0xdf, 0x72, 0xbd, 0x4e, 0x0b, 0x33, 0xf2, 0xf6, 0x6e, 0xcc, 0xb3, 0x41, 0x9b,
+	0xf5, 0x3d, 0xcb, 0xc7, 0xff, 0x83, 0x9e, 0x35, 0xbe, 0x0f, 0x28, 0x8e, 0x5d, 0x79, 0xa4, 0x06,
+	0x39, 0xe1, 0xba, 0xe8, 0x26, 0xac, 0x0f, 0x87, 0x59, 0xc4, 0xe5, 0xec, 0x73, 0xc2, 0x44, 0xb8,
+	0x49, 0x56, 0xe3, 0x4f, 0x1a, 0x5c, 0x48, 0x52, 0xa1, 0xcb, 0x90, 0x53, 0xb9, 0x55, 0x3a, 0x5b,
+	0xcd, 0xb8, 0xb3, 0xf8, 0xc7, 0x5a, 0x79, 0x45, 0x8c, 0x39, 0x68, 0xee, 0xac, 0x0e, 0x25, 0x8e,
+	0xca, 0xc4, 0xdc, 0x79, 0xdf, 0xa3, 0xc4, 0x41, 0x5f, 0x81, 0x79, 0x7e, 0xc3, 0xea, 0x8f, 0x09,
+	0x11, 0xf6, 0x14, 0xf6, 0xaf, 0xfe, 0xf9, 0x59, 0xe5, 0x92, 0x74, 0x0a, 0x75, 0x4e, 0x4d, 0x37,
+	0xa8, 0x7a, 0x98, 0x9d, 0x98, 0x0f, 0x7c, 0xc6, 0x93, 0x29, 0x25, 0xf7, 0x09, 0x41, 0xf7, 0x61,
+	0xce, 0xc6, 0xad, 0x16, 0x0f, 0x20, 0x6e, 0xd3, 0xc6, 0x18, 0x9b, 0xe4, 0x15, 0xa1, 0x9d, 0x16,
+	0x8b, 0x1b, 0x26, 0xd9, 0x8d, 0xf7, 0x35, 0x58, 0x1e, 0x41, 0x19, 0x05, 0x8d, 0x96, 0x08, 0x9a,
+	0xbe, 0x09, 0xb3, 0x49, 0x13, 0xa2, 0x4c, 0x93, 0x99, 0x9c, 0x69, 0xe2, 0xa1, 0x97, 0x4d, 0x86,
+	0xde, 0x07, 0xd9, 0x28, 0x8f, 0x85, 0xd8, 0x26, 0xc7, 0xbd, 0x28, 0xf8, 0x76, 0x21, 0xe3, 0xd1,
+	0xa6, 0xca, 0x11, 0x95, 0x61, 0xe1, 0x8f, 0x68, 0xf3, 0x80, 0xaf, 0x91, 0x8e, 0x77, 0xdc, 0xb3,
+	0x38, 0x2d, 0x7a, 0x0d, 0x16, 0x18, 0x17, 0x52, 0xb7, 0x03, 0xff, 0xb1, 0xdb, 0x14, 0x2e, 0x2f,
+	0xee, 0xad, 0x0e, 0xf3, 0x0a, 0x55, 0x35, 0x41, 0x64, 0x15, 0xd9, 0x60, 0x82, 0x6a, 0xb0, 0xd0,
+	0x0e, 0x89, 0x43, 0x6c, 0x42, 0x69, 0x10, 0xd2, 0x72, 0x76, 0x3d, 0x33, 0x8d, 0xf6, 0x04, 0x13,
+	0xaf, 0x0c, 0xe4, 0x5d, 0x56, 0x71, 0x32, 0x27, 0xc2, 0xb5, 0x28, 0xd6, 0xe4, 0x17, 0x18, 0xad,
+	0x02, 0x48, 0x12, 0xf1, 0xa1, 0xc8, 0x09, 0x8f, 0x14, 0xc4, 0x8a, 0xa8, 0xad, 0x5e, 0x8f, 0xb6,
+	0x45, 0x44, 0xe5, 0x85, 0x19, 0xba, 0x29, 0x6b, 0x43, 0x33, 0xaa, 0x0d, 0xcd, 0xe3, 0xa8, 0x36,
+	0xdc, 0x5f, 0xe4, 0x07, 0xfc, 0xe1, 0x5f, 0x2a, 0x9a, 0x3c, 0x64, 0x29, 0x89, 0x6f, 0x8f, 0xbc,
+	0x95, 0xf3, 0xff, 0x9d, 0x5b, 0x59, 0x48, 0xe6, 0x3b, 0x03, 0x16, 0xa5, 0x0d, 0x1e, 0xee, 0xd5,
+	0x79, 0x6e, 0x82, 0x98, 0x1b, 0x1e, 0xe1, 0xde, 0x21, 0xa6, 0xdf, 0xce, 0xce, 0xcf, 0x2e, 0x65,
+	0xac, 0x79, 0xd6, 0xab, 0xbb, 0xbe, 0x43, 0x7a, 0xc6, 0x6d, 0xf5, 0x79, 0xef, 0x87, 0xc2, 0xe0,
+	0xdb, 0xeb, 0x60, 0x86, 0xa3, 0x44, 0xc4, 0xc7, 0xc6, 0xef, 0x32, 0x70, 0x79, 0x40, 0x2c, 0xee,
+	0x66, 0x2c, 0x74, 0x58, 0x2f, 0xba, 0xf7, 0x93, 0x43, 0x87, 0xf5, 0xe8, 0xe7, 0x10, 0x3a, 0x5f,
+	0x9c, 0xfa, 0x94, 0xa7, 0x6e, 0xdc, 0x85, 0x2b, 0x43, 0x07, 0x37, 0xe6, 0xa0, 0xff, 0x30, 0x0b,
+	0x97, 0x06, 0xf4, 0xff, 0x87, 0x5f, 0xfe, 0xf3, 0xb1, 0x35, 0xf7, 0xd2, 0xb1, 0x95, 0xa8, 0x1d,
+	0x72, 0x53, 0xd4, 0x0e, 0xf9, 0x91, 0xb5, 0xc3, 0x1d, 0xb8, 0x7c, 0xde, 0x91, 0x63, 0xfc, 0x7e,
+	0xa9, 0xdf, 0x25, 0x88, 0x6f, 0x4f, 0x54, 0xd5, 0x3d, 0x84, 0x52, 0x72, 0x59, 0x89, 0x88, 0x7f,
+	0xd0, 0xb4, 0x69, 0x3f, 0x68, 0x46, 0x45, 0xf5, 0x45, 0x87, 0xad, 0xa0, 0x81, 0x5b, 0x8f, 0x5c,
+	0xff, 0x10, 0xd3, 0xa3, 0xd0, 0xed, 0x37, 0x25, 0x86, 0x0d, 0x6b, 0x69, 0x04, 0x4a, 0xf1, 0x3d,
+	0x58, 0xf4, 0x5c, 0x9f, 0x07, 0x5b, 0xbd, 0xcd, 0x37, 0x94, 0xf6, 0x55, 0x7e, 0x3b, 0xd2, 0x11,
+	0x14, 0xbd, 0x81, 0xa8, 0x7e, 0xfd, 0xaa, 0x7c, 0xdf, 0xb7, 0x74, 0x39, 0xb1, 0xaa, 0xf4, 0x7d,
+	0x15, 0x72, 0xea, 0x20, 0xb5, 0xb4, 0x83, 0xac, 0xf1, 0x93, 0x57, 0x6c, 0x8a, 0xd8, 0x28, 0x2b,
+	0xe7, 0xd7, 0x3a, 0x94, 0x05, 0xde, 0xc1, 0x83, 0xa3, 0x7e, 0x9d, 0xfc, 0xb1, 0x06, 0x57, 0x86,
+	0xb6, 0x94, 0xb2, 0x23, 0x28, 0xda, 0x62, 0xb5, 0x4e, 0xdc, 0x76, 0x94, 0xd2, 0xae, 0x8d, 0xd0,
+	0x18, 0xb1, 0xee, 0x23, 0x6e, 0xf7, 0xf3, 0x67, 0x15, 0x88, 0x49, 0x03, 0x29, 0xe3, 0xc0, 0x6d,
+	0x53, 0xf4, 0x4d, 0x28, 0x70, 0x57, 0x31, 0xdc, 0x68, 0xf1, 0x62, 0x25, 0x45, 0xde, 0x9b, 0x6d,
+	0xde, 0xfc, 0x1c, 0x62, 0xba, 0x9f, 0xe5, 0xf2, 0x2c, 0x5e, 0x04, 0x1c, 0x73, 0x16, 0xe3, 0x97,
+	0x1a, 0x14, 0xfa, 0xbb, 0xbc, 0x1a, 0x0a, 0xda, 0xfd, 0xbe, 0xa8, 0x60, 0xa9, 0x19, 0xda, 0x81,
+	0x92, 0x43, 0x1e, 0xe3, 0x4e, 0x8b, 0xf1, 0xa8, 0xa7, 0x0c, 0xfb, 0x4c, 0xa4, 0x03, 0x79, 0x27,
+	0x91, 0xda, 0xab, 0xa9, 0x2d, 0x2e, 0x49, 0xf5, 0xd3, 0x7d, 0x4a, 0x59, 0x2f, 0x15, 0xed, 0x18,
+	0x49, 0x05, 0x8a, 0xce, 0x99, 0x8f, 0x3d, 0xd7, 0x16, 0x14, 0xfc, 0x9a, 0xcd, 0x5b, 0xa0, 0x96,
+	0x0e, 0x31, 0xdd, 0xfb, 0xec, 0x22, 0xcc, 0x09, 0x4f, 0xa2, 0x1f, 0x6b, 0x90, 0x57, 0x2d, 0x36,
+	0x1a, 0x51, 0x25, 0x8d, 0x78, 0x43, 0xd1, 0x37, 0x27, 0x91, 0xc9, 0x23, 0x31, 0xb6, 0xde, 0xfb,
+	0xe3, 0xdf, 0x7e, 0x31, 0x7b, 0x1d, 0x55, 0xf8, 0x8b, 0x4f, 0x40, 0xa3, 0x77, 0x1f, 0xd5, 0x62,
+	0x57, 0xdf, 0x55, 0xc9, 0xe5, 0x29, 0xfa, 0x95, 0x06, 0x8b, 0x89, 0x57, 0x0c, 0xf4, 0xa5, 0x14,
+	0x15, 0xa3, 0x5e, 0x4b, 0xf4, 0x3b, 0xd3, 0x11, 0x2b, 0x54, 0xa6, 0x40, 0xb5, 0x8d, 0x36, 0x93,
+	0xa8, 0xa2, 0xc7, 0x92, 0x21, 0x70, 0x1f, 0x6b, 0xb0, 0x74, 0xfe, 0x31, 0x02, 0x99, 0x29, 0x2a,
+	0x53, 0xde, 0x40, 0xf4, 0xea, 0xd4, 0xf4, 0x0a, 0xe5, 0xab, 0x02, 0xe5, 0x0e, 0x32, 0x93, 0x28,
+	0xbb, 0x11, 0xfd, 0x00, 0x68, 0xfc, 0x6d, 0xe5, 0x29, 0x7a, 0x4f, 0x83, 0xbc, 0x7a, 0x72, 0x48,
+	0x3d, 0xce, 0xe4, 0x6b, 0x86, 0xbe, 0x39, 0x89, 0x4c, 0x41, 0xda, 0x16, 0x90, 0x0c, 0xb4, 0x9e,
+	0x84, 0xa4, 0x9e, 0x2f, 0x68, 0xcc, 0x65, 0x3f, 0xd3, 0x20, 0xaf, 0x1e, 0x1e, 0x52, 0x41, 0x24,
+	0x5f, 0x39, 0xf4, 0xcd, 0x49, 0x64, 0x0a, 0xc4, 0x5d, 0x01, 0x62, 0x0b, 0x6d, 0x24, 0x41, 0x50,
+	0x49, 0x36, 0xc0, 0x50, 0x7d, 0xf7, 0x94, 0x9c, 0x3d, 0x45, 0x5d, 0xc8, 0xf2, 0x0b, 0x88, 0x8c,
+	0xd4, 0x10, 0xe9, 0x3f, 0x78, 0xe8, 0x37, 0xc6, 0xd2, 0x28, 0xfd, 0x1b, 0x42, 0x7f, 0x05, 0xad,
+	0x9e, 0x8f, 0x1e, 0x27, 0xe1, 0x01, 0x0a, 0x39, 0xd9, 0x9a, 0xa3, 0x9b, 0x29, 0x52, 0x13, 0x2f,
+	0x00, 0xfa, 0xc6, 0x04, 0x2a, 0xa5, 0x7d, 0x45, 0x68, 0xbf, 0x8c, 0x4a, 0x49, 0xed, 0xb2, 0xe5,
+	0x47, 0x0c, 0xf2, 0xaa, 0xe3, 0x47, 0x23, 0x7a, 0xb8, 0xe4, 0x63, 0x80, 0xbe, 0x35, 0xa9, 0xda,
+	0x8b, 0x74, 0xae, 0x09, 0x9d, 0x65, 0x74, 0x39, 0xa9, 0x93, 0xb0, 0x93, 0x3a, 0x6f, 0x91, 0xd0,
+	0x13, 0x28, 0xc6, 0xfa, 0xec, 0x29, 0x34, 0x8f, 0xb0, 0x75, 0x44, 0xa3, 0x6e, 0x18, 0x42, 0xef,
+	0x0a, 0xd2, 0xcf, 0xe9, 0x55, 0xa4, 0x3c, 0xb1, 0xa1, 0x27, 0x00, 0x83, 0x86, 0x16, 0xdd, 0x48,
+	0x6f, 0xf2, 0xfa, 0xad, 0xba, 0x7e, 0x73, 0x3c, 0x91, 0x52, 0x7e, 0x5d, 0x28, 0xbf, 0x86, 0xae,
+	0x9e, 0x0b, 0x33, 0x45, 0x59, 0xef, 0xee, 0xa2, 0x1e, 0xe4, 0x55, 0xf5, 0x9d, 0x1a, 0xe3, 0xc9,
+	0x46, 0x4d, 0xdf, 0x9c, 0x44, 0x36, 0xde, 0xe3, 0xb2, 0x34, 0x62, 0x3d, 0xf4, 0x13, 0x0d, 0x60,
+	0x50, 0x12, 0xa2, 0xed, 0x71, 0x62, 0xe3, 0xe5, 0xbe, 0x7e, 0x6b, 0x0a, 0xca, 0xf1, 0x0e, 0x90,
+	0x18, 0x44, 0xb5, 0x84, 0x7e, 0xa4, 0x41, 0xa1, 0x5f, 0x20, 0xa1, 0xad, 0x71, 0xb2, 0xe3, 0xc7,
+	0xbf, 0x3d, 0x99, 0x50, 0x61, 0x58, 0x17, 0x18, 0x74, 0x54, 0x1e, 0x85, 0x41, 0xc4, 0x5e, 0x8f,
+	0x27, 0x3b, 0xd9, 0xf0, 0xa7, 0x27, 0xbb, 0x78, 0x51, 0xa6, 0x6f, 0x4e, 0x22, 0x1b, 0x7f, 0x06,
+	0x51, 0xe1, 0x86, 0x7e, 0xad, 0xc1, 0xc5, 0xa1, 0x4a, 0x0b, 0xa5, 0xa5, 0xf9, 0xb4, 0xa2, 0x4d,
+	0xdf, 0x99, 0x9e, 0x41, 0x01, 0xbb, 0x21, 0x80, 0xad, 0xa2, 0x6b, 0x49, 0x60, 0x89, 0xc2, 0x8e,
+	0xa7, 0x1f, 0x55, 0x10, 0xdf, 0x4c, 0x4d, 0x6a, 0xb1, 0x02, 0x4e, 0xdf, 0x98, 0x40, 0x35, 0x3e,
+	0xfd, 0xc8, 0xba, 0x4d, 0x84, 0xe5, 0xa0, 0x94, 0x4a, 0x0d, 0xcb, 0xa1, 0xb2, 0x4e, 0xbf, 0x35,
+	0x05, 0xe5, 0xf8, 0xb0, 0x8c, 0x55, 0x7e, 0xfb, 0xaf, 0x7d, 0xf2, 0x7c, 0x4d, 0xfb, 0xf4, 0xf9,
+	0x9a, 0xf6, 0xd7, 0xe7, 0x6b, 0xda, 0x87, 0x2f, 0xd6, 0x66, 0x3e, 0x7d, 0xb1, 0x36, 0xf3, 0xd9,
+	0x8b, 0xb5, 0x99, 0x1f, 0x6c, 0xc6, 0x5a, 0x97, 0x3e, 0x7b, 0x40, 0xab, 0xdd, 0xbd, 0x9d, 0x6a,
+	0x4f, 0x88, 0x12, 0xed, 0x4b, 0x23, 0x27, 0x5a, 0xc8, 0x2f, 0xff, 0x7b, 0x00, 0x89, 0x2d, 0x42,
+	0xa0, 0x3e, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1796,10 +2479,14 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
@@ -1810,6 +2497,9 @@ type QueryClient interface {
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// CustomEIPs queries the active custom EIPs and the resulting gas of the
+	// opcodes they modify
+	CustomEIPs(ctx context.Context, in *QueryCustomEIPsRequest, opts ...grpc.CallOption) (*QueryCustomEIPsResponse, error)
 }
 
 type queryClient struct {
@@ -1901,6 +2591,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1919,6 +2618,15 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
@@ -1946,6 +2654,15 @@ func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) CustomEIPs(ctx context.Context, in *QueryCustomEIPsRequest, opts ...grpc.CallOption) (*QueryCustomEIPsResponse, error) {
+	out := new(QueryCustomEIPsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CustomEIPs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1968,10 +2685,14 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
@@ -1982,6 +2703,9 @@ type QueryServer interface {
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// CustomEIPs queries the active custom EIPs and the resulting gas of the
+	// opcodes they modify
+	CustomEIPs(context.Context, *QueryCustomEIPsRequest) (*QueryCustomEIPsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2023,6 +2747,10 @@ func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRe
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
 
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
+
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -2031,6 +2759,10 @@ func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTrace
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
 
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
+
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -2043,6 +2775,10 @@ func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigReq
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
 
+func (*UnimplementedQueryServer) CustomEIPs(ctx context.Context, req *QueryCustomEIPsRequest) (*QueryCustomEIPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CustomEIPs not implemented")
+}
+
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
@@ -2209,26 +2945,44 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTraceTxRequest)
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).TraceTx(ctx, in)
+		return srv.(QueryServer).SimulateV1(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/TraceTx",
+		FullMethod: "/ethermint.evm.v1.Query/SimulateV1",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TraceTx(ctx, req.(*QueryTraceTxRequest))
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTraceBlockRequest)
+func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceTx(ctx, req.(*QueryTraceTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2245,6 +2999,24 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -2299,71 +3071,104 @@ func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethermint.evm.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Account",
-			Handler:    _Query_Account_Handler,
-		},
-		{
-			MethodName: "CosmosAccount",
-			Handler:    _Query_CosmosAccount_Handler,
-		},
-		{
-			MethodName: "ValidatorAccount",
-			Handler:    _Query_ValidatorAccount_Handler,
-		},
-		{
-			MethodName: "Balance",
-			Handler:    _Query_Balance_Handler,
-		},
-		{
-			MethodName: "Storage",
-			Handler:    _Query_Storage_Handler,
-		},
-		{
-			MethodName: "Code",
-			Handler:    _Query_Code_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "EthCall",
-			Handler:    _Query_EthCall_Handler,
-		},
-		{
-			MethodName: "EstimateGas",
-			Handler:    _Query_EstimateGas_Handler,
-		},
-		{
-			MethodName: "TraceTx",
-			Handler:    _Query_TraceTx_Handler,
-		},
-		{
-			MethodName: "TraceBlock",
-			Handler:    _Query_TraceBlock_Handler,
-		},
-		{
-			MethodName: "BaseFee",
-			Handler:    _Query_BaseFee_Handler,
-		},
-		{
-			MethodName: "GlobalMinGasPrice",
-			Handler:    _Query_GlobalMinGasPrice_Handler,
-		},
-		{
-			MethodName: "Config",
-			Handler:    _Query_Config_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ethermint/evm/v1/query.proto",
+func _Query_CustomEIPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCustomEIPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CustomEIPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/CustomEIPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CustomEIPs(ctx, req.(*QueryCustomEIPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
+var (
+	Query_serviceDesc  = _Query_serviceDesc
+	_Query_serviceDesc = grpc.ServiceDesc{
+		ServiceName: "ethermint.evm.v1.Query",
+		HandlerType: (*QueryServer)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Account",
+				Handler:    _Query_Account_Handler,
+			},
+			{
+				MethodName: "CosmosAccount",
+				Handler:    _Query_CosmosAccount_Handler,
+			},
+			{
+				MethodName: "ValidatorAccount",
+				Handler:    _Query_ValidatorAccount_Handler,
+			},
+			{
+				MethodName: "Balance",
+				Handler:    _Query_Balance_Handler,
+			},
+			{
+				MethodName: "Storage",
+				Handler:    _Query_Storage_Handler,
+			},
+			{
+				MethodName: "Code",
+				Handler:    _Query_Code_Handler,
+			},
+			{
+				MethodName: "Params",
+				Handler:    _Query_Params_Handler,
+			},
+			{
+				MethodName: "EthCall",
+				Handler:    _Query_EthCall_Handler,
+			},
+			{
+				MethodName: "EstimateGas",
+				Handler:    _Query_EstimateGas_Handler,
+			},
+			{
+				MethodName: "SimulateV1",
+				Handler:    _Query_SimulateV1_Handler,
+			},
+			{
+				MethodName: "TraceTx",
+				Handler:    _Query_TraceTx_Handler,
+			},
+			{
+				MethodName: "TraceBlock",
+				Handler:    _Query_TraceBlock_Handler,
+			},
+			{
+				MethodName: "TraceCall",
+				Handler:    _Query_TraceCall_Handler,
+			},
+			{
+				MethodName: "BaseFee",
+				Handler:    _Query_BaseFee_Handler,
+			},
+			{
+				MethodName: "GlobalMinGasPrice",
+				Handler:    _Query_GlobalMinGasPrice_Handler,
+			},
+			{
+				MethodName: "Config",
+				Handler:    _Query_Config_Handler,
+			},
+			{
+				MethodName: "CustomEIPs",
+				Handler:    _Query_CustomEIPs_Handler,
+			},
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: "ethermint/evm/v1/query.proto",
+	}
+)
+
 func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2930,6 +3735,20 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2999,7 +3818,7 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateV1Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3009,57 +3828,67 @@ func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
-		i--
-		dAtA[i] = 0x50
-	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x20
 	}
 	if len(m.ProposerAddress) > 0 {
 		i -= len(m.ProposerAddress)
 		copy(dAtA[i:], m.ProposerAddress)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
 		i--
-		dAtA[i] = 0x42
-	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err4 != nil {
-		return 0, err4
+		dAtA[i] = 0x1a
 	}
-	i -= n4
-	i = encodeVarintQuery(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x10
 	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0xa
 	}
-	if len(m.Predecessors) > 0 {
-		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3067,37 +3896,77 @@ func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0xa
 		}
 	}
-	if m.TraceConfig != nil {
-		{
-			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.Msg != nil {
+	if m.BaseFee != nil {
 		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Time != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *SimulatedCallResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3107,27 +3976,53 @@ func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulatedCallResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulatedCallResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3137,12 +4032,12 @@ func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3164,11 +4059,139 @@ func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x42
 	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err7 != nil {
-		return 0, err7
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n7
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Predecessors) > 0 {
+		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
 	i = encodeVarintQuery(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x3a
@@ -3243,6 +4266,109 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3415,36 +4541,160 @@ func (m *QueryConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCustomEIPsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCustomEIPsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCustomEIPsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCustomEIPsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *QueryCustomEIPsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCustomEIPsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasTable) > 0 {
+		for iNdEx := len(m.GasTable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasTable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CustomEIPs) > 0 {
+		for iNdEx := len(m.CustomEIPs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CustomEIPs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpCodeGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpCodeGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpCodeGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DynamicGas {
+		i--
+		if m.DynamicGas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConstantGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConstantGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DefaultConstantGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DefaultConstantGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opcode) > 0 {
+		i -= len(m.Opcode)
+		copy(dAtA[i:], m.Opcode)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opcode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Balance)
 	if l > 0 {
@@ -3682,6 +4932,14 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3705,6 +4963,98 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *SimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *SimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulatedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.Time != 0 {
+		n += 1 + sovQuery(uint64(m.Time))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulatedCallResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3811,6 +5161,54 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryBaseFeeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3875,22 +5273,74 @@ func (m *QueryConfigResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryCustomEIPsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryCustomEIPsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CustomEIPs) > 0 {
+		for _, e := range m.CustomEIPs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GasTable) > 0 {
+		for _, e := range m.GasTable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
 
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *OpCodeGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opcode)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DefaultConstantGas != 0 {
+		n += 1 + sovQuery(uint64(m.DefaultConstantGas))
+	}
+	if m.ConstantGas != 0 {
+		n += 1 + sovQuery(uint64(m.ConstantGas))
+	}
+	if m.DynamicGas {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
@@ -5550,6 +7000,74 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5708,7 +7226,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulateV1Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5731,15 +7249,172 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateV1Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SimulateV1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateV1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5766,16 +7441,811 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Blocks = append(m.Blocks, SimulatedBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SimulatedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulatedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulatedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, SimulatedCallResult{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SimulatedCallResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulatedCallResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulatedCallResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5802,16 +8272,14 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Txs = append(m.Txs, &MsgEthereumTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5838,8 +8306,10 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6021,7 +8491,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6044,10 +8514,10 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6106,7 +8576,7 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6129,17 +8599,17 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6149,31 +8619,50 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &MsgEthereumTx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6183,33 +8672,31 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.BlockNumber = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6219,16 +8706,16 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6238,29 +8725,33 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6270,28 +8761,29 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6318,35 +8810,67 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BlockMaxGas = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6356,11 +8880,77 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6383,7 +8973,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6406,17 +8996,17 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6426,24 +9016,26 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6468,7 +9060,7 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6491,10 +9083,10 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6519,7 +9111,7 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6542,15 +9134,15 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6578,9 +9170,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFee = &v
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6606,7 +9196,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6629,10 +9219,10 @@ func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6657,7 +9247,7 @@ func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6680,17 +9270,17 @@ func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6700,23 +9290,25 @@ func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &ChainConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6742,7 +9334,7 @@ func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCustomEIPsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6765,10 +9357,10 @@ func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCustomEIPsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCustomEIPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6793,7 +9385,7 @@ func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCustomEIPsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6816,15 +9408,15 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCustomEIPsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCustomEIPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomEIPs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6851,13 +9443,186 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &ChainConfig{}
+			m.CustomEIPs = append(m.CustomEIPs, CustomEIP{})
+			if err := m.CustomEIPs[len(m.CustomEIPs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasTable = append(m.GasTable, OpCodeGas{})
+			if err := m.GasTable[len(m.GasTable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *OpCodeGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpCodeGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpCodeGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultConstantGas", wireType)
+			}
+			m.DefaultConstantGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultConstantGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantGas", wireType)
+			}
+			m.ConstantGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConstantGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicGas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicGas = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,249 @@
+package types_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *TxDataTestSuite) signedAuthorization(chainID *big.Int, nonce uint64) (ethutils.SetCodeAuthorization, common.Address) {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	auth, err := ethutils.SignSetCode(key, ethutils.SetCodeAuthorization{
+		ChainID: chainID,
+		Address: suite.addr,
+		Nonce:   nonce,
+	})
+	suite.Require().NoError(err)
+
+	return auth, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func (suite *TxDataTestSuite) TestNewSetCodeTx() {
+	auth, _ := suite.signedAuthorization(suite.bigInt, 1)
+
+	testCases := []struct {
+		name     string
+		expError bool
+		tx       *ethutils.SetCodeTx
+	}{
+		{
+			"non-empty tx",
+			false,
+			&ethutils.SetCodeTx{
+				ChainID:   suite.bigInt,
+				Nonce:     1,
+				Data:      []byte("data"),
+				Gas:       100,
+				Value:     big.NewInt(1),
+				GasTipCap: big.NewInt(1),
+				GasFeeCap: big.NewInt(2),
+				To:        suite.addr,
+				AuthList:  []ethutils.SetCodeAuthorization{auth},
+				V:         suite.bigInt,
+				R:         suite.bigInt,
+				S:         suite.bigInt,
+			},
+		},
+		{
+			"value out of bounds tx",
+			true,
+			&ethutils.SetCodeTx{
+				ChainID:  suite.bigInt,
+				Value:    suite.overflowBigInt,
+				To:       suite.addr,
+				AuthList: []ethutils.SetCodeAuthorization{auth},
+			},
+		},
+		{
+			"gas fee cap out of bounds tx",
+			true,
+			&ethutils.SetCodeTx{
+				ChainID:   suite.bigInt,
+				GasFeeCap: suite.overflowBigInt,
+				To:        suite.addr,
+				AuthList:  []ethutils.SetCodeAuthorization{auth},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tx, err := types.NewSetCodeTx(tc.tx)
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+		suite.Require().NotEmpty(tx, tc.name)
+		suite.Require().Equal(uint8(types.SetCodeTxType), tx.TxType(), tc.name)
+		suite.Require().Equal(tc.tx.Hash(), tx.AsSetCodeTx().Hash(), tc.name)
+	}
+}
+
+func (suite *TxDataTestSuite) TestSetCodeTxValidate() {
+	auth, _ := suite.signedAuthorization(suite.bigInt, 1)
+	authList := types.NewAuthorizationList([]ethutils.SetCodeAuthorization{auth})
+
+	invalidAuth := authList[0]
+	invalidAuth.ChainID = suite.sdkMinusOneInt
+
+	testCases := []struct {
+		name     string
+		tx       types.SetCodeTx
+		expError bool
+	}{
+		{
+			"empty",
+			types.SetCodeTx{},
+			true,
+		},
+		{
+			"gas fee cap < gas tip cap",
+			types.SetCodeTx{
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkZeroInt,
+			},
+			true,
+		},
+		{
+			"to address is empty",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				ChainID:        &suite.sdkInt,
+				Authorizations: authList,
+			},
+			true,
+		},
+		{
+			"chain ID not present on SetCode txs",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				To:             suite.hexAddr,
+				Authorizations: authList,
+			},
+			true,
+		},
+		{
+			"empty authorization list",
+			types.SetCodeTx{
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				ChainID:   &suite.sdkInt,
+			},
+			true,
+		},
+		{
+			"invalid authorization chain ID",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				To:             suite.hexAddr,
+				ChainID:        &suite.sdkInt,
+				Authorizations: types.AuthorizationList{invalidAuth},
+			},
+			true,
+		},
+		{
+			"no errors",
+			types.SetCodeTx{
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				To:             suite.hexAddr,
+				ChainID:        &suite.sdkInt,
+				Authorizations: authList,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.tx.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+	}
+}
+
+func (suite *TxDataTestSuite) TestSetCodeTxAuthority() {
+	auth, authority := suite.signedAuthorization(suite.bigInt, 1)
+	authList := types.NewAuthorizationList([]ethutils.SetCodeAuthorization{auth})
+
+	recovered, err := authList[0].Authority()
+	suite.Require().NoError(err)
+	suite.Require().Equal(authority, recovered)
+
+	// tampering with the authorization changes the recovered authority
+	authList[0].Nonce++
+	recovered, err = authList[0].Authority()
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(authority, recovered)
+}
+
+func (suite *TxDataTestSuite) TestSetCodeTxSender() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	auth, _ := suite.signedAuthorization(common.Big0, 0)
+
+	ethTx := &ethutils.SetCodeTx{
+		ChainID:   suite.bigInt,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       100000,
+		To:        suite.addr,
+		Value:     common.Big0,
+		AuthList:  []ethutils.SetCodeAuthorization{auth},
+	}
+	sigHash := ethTx.SigHash()
+	sig, err := crypto.Sign(sigHash[:], key)
+	suite.Require().NoError(err)
+	ethTx.R = new(big.Int).SetBytes(sig[:32])
+	ethTx.S = new(big.Int).SetBytes(sig[32:64])
+	ethTx.V = new(big.Int).SetUint64(uint64(sig[64]))
+
+	tx, err := types.NewSetCodeTx(ethTx)
+	suite.Require().NoError(err)
+
+	sender, err := tx.GetSender(suite.bigInt)
+	suite.Require().NoError(err)
+	suite.Require().Equal(crypto.PubkeyToAddress(key.PublicKey), sender)
+
+	_, err = tx.GetSender(big.NewInt(2))
+	suite.Require().Error(err, "chain id mismatch")
+
+	// the canonical encoding round trips through the msg
+	bz, err := ethTx.MarshalBinary()
+	suite.Require().NoError(err)
+
+	msg := &types.MsgEthereumTx{}
+	suite.Require().NoError(msg.UnmarshalBinary(bz))
+	suite.Require().Equal(ethTx.Hash().Hex(), msg.Hash)
+	suite.Require().Equal(ethTx.Hash(), msg.TxHash())
+}
+
+func (suite *TxDataTestSuite) TestSetCodeTxAuthorizationGas() {
+	auth, _ := suite.signedAuthorization(suite.bigInt, 1)
+	tx := types.SetCodeTx{
+		Authorizations: types.NewAuthorizationList([]ethutils.SetCodeAuthorization{auth, auth}),
+	}
+
+	suite.Require().Equal(2*types.PerEmptyAccountCost, tx.AuthorizationGas())
+}
@@ -0,0 +1,88 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// MaxSimulateBlocks is the maximum number of blocks that can be simulated
+// on a single eth_simulateV1 request.
+const MaxSimulateBlocks = 256
+
+// SimOpts are the inputs of eth_simulateV1.
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.14.11/internal/ethapi/simulate.go
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// state left by the previous calls and blocks.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides StateOverride     `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// Validate performs a stateless validation of the simulation options.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+	for i, block := range opts.BlockStateCalls {
+		if err := block.BlockOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+		if err := block.StateOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SanitizeBlocks returns the block overrides of each simulated block with the
+// number and time always set. Blocks without a number or time override are
+// built on top of the previous one, increasing the number by one and the time
+// by one second, starting from the given base block.
+//
+// It returns an error if the block numbers are not strictly increasing or the
+// timestamps are decreasing.
+func (opts SimOpts) SanitizeBlocks(baseNumber *big.Int, baseTime uint64) ([]BlockOverrides, error) {
+	blocks := make([]BlockOverrides, len(opts.BlockStateCalls))
+
+	prevNumber := new(big.Int).Set(baseNumber)
+	prevTime := baseTime
+	for i, block := range opts.BlockStateCalls {
+		var overrides BlockOverrides
+		if block.BlockOverrides != nil {
+			overrides = *block.BlockOverrides
+		}
+
+		if overrides.Number == nil {
+			overrides.Number = (*hexutil.Big)(new(big.Int).Add(prevNumber, big.NewInt(1)))
+		} else if overrides.Number.ToInt().Cmp(prevNumber) <= 0 {
+			return nil, fmt.Errorf("block numbers must be in order: %s <= %s", overrides.Number.ToInt(), prevNumber)
+		}
+
+		if overrides.Time == nil {
+			blockTime := hexutil.Uint64(prevTime + 1)
+			overrides.Time = &blockTime
+		} else if uint64(*overrides.Time) < prevTime {
+			return nil, fmt.Errorf("block timestamps must be in order: %d < %d", uint64(*overrides.Time), prevTime)
+		}
+
+		prevNumber = overrides.Number.ToInt()
+		prevTime = uint64(*overrides.Time)
+		blocks[i] = overrides
+	}
+
+	return blocks, nil
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimOptsValidate(t *testing.T) {
+	negBaseFee := (*hexutil.Big)(big.NewInt(-1))
+
+	testCases := []struct {
+		name    string
+		opts    types.SimOpts
+		expPass bool
+	}{
+		{"fail - empty input", types.SimOpts{}, false},
+		{"fail - too many blocks", types.SimOpts{BlockStateCalls: make([]types.SimBlock, types.MaxSimulateBlocks+1)}, false},
+		{"fail - invalid block overrides", types.SimOpts{BlockStateCalls: []types.SimBlock{{BlockOverrides: &types.BlockOverrides{BaseFee: negBaseFee}}}}, false},
+		{"pass - single block", types.SimOpts{BlockStateCalls: []types.SimBlock{{}}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.opts.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSimOptsSanitizeBlocks(t *testing.T) {
+	blockTime := hexutil.Uint64(500)
+	oldTime := hexutil.Uint64(50)
+
+	opts := types.SimOpts{BlockStateCalls: []types.SimBlock{
+		{},
+		{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(20)), Time: &blockTime}},
+		{},
+	}}
+
+	blocks, err := opts.SanitizeBlocks(big.NewInt(10), 100)
+	require.NoError(t, err)
+	require.Len(t, blocks, 3)
+	require.Equal(t, big.NewInt(11), blocks[0].Number.ToInt())
+	require.Equal(t, hexutil.Uint64(101), *blocks[0].Time)
+	require.Equal(t, big.NewInt(20), blocks[1].Number.ToInt())
+	require.Equal(t, blockTime, *blocks[1].Time)
+	require.Equal(t, big.NewInt(21), blocks[2].Number.ToInt())
+	require.Equal(t, hexutil.Uint64(501), *blocks[2].Time)
+
+	opts = types.SimOpts{BlockStateCalls: []types.SimBlock{
+		{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(10))}},
+	}}
+	_, err = opts.SanitizeBlocks(big.NewInt(10), 100)
+	require.Error(t, err, "block number must be greater than the base block")
+
+	opts = types.SimOpts{BlockStateCalls: []types.SimBlock{
+		{BlockOverrides: &types.BlockOverrides{Time: &oldTime}},
+	}}
+	_, err = opts.SanitizeBlocks(big.NewInt(10), 100)
+	require.Error(t, err, "block time cannot decrease")
+}
@@ -9,11 +9,12 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 )
 
 // EvmTxArgs encapsulates all possible params to create all EVM txs types.
-// This includes LegacyTx, DynamicFeeTx and AccessListTx
+// This includes LegacyTx, DynamicFeeTx, AccessListTx and SetCodeTx
 type EvmTxArgs struct {
 	Nonce     uint64
 	GasLimit  uint64
@@ -25,10 +26,17 @@ type EvmTxArgs struct {
 	GasTipCap *big.Int
 	To        *common.Address
 	Accesses  *ethtypes.AccessList
+	// AuthorizationList is the EIP-7702 authorization list of set code txs
+	AuthorizationList []ethutils.SetCodeAuthorization
 }
 
 // ToTxData converts the EvmTxArgs to TxData
 func (args *EvmTxArgs) ToTxData() (TxData, error) {
+	if args.AuthorizationList != nil {
+		// set code txs can't be represented as go-ethereum transactions
+		return UnpackTxData(NewTx(args).Data)
+	}
+
 	ethTx := NewTx(args).AsTransaction()
 	return NewTxDataFromTx(ethTx)
 }
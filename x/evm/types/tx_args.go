@@ -5,6 +5,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	stdmath "math"
 	"math/big"
 
 	sdkmath "cosmossdk.io/math"
@@ -12,7 +13,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 )
 
 // TransactionArgs represents the arguments to construct a new transaction
@@ -38,6 +42,40 @@ type TransactionArgs struct {
 	// Introduced by AccessListTxType transaction.
 	AccessList *ethtypes.AccessList `json:"accessList,omitempty"`
 	ChainID    *hexutil.Big         `json:"chainId,omitempty"`
+
+	// Introduced by SetCodeTxType transaction.
+	AuthorizationList []SetCodeAuthorizationArgs `json:"authorizationList,omitempty"`
+}
+
+// SetCodeAuthorizationArgs represents an EIP-7702 authorization tuple in its
+// JSON-RPC representation.
+type SetCodeAuthorizationArgs struct {
+	ChainID hexutil.Big    `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	YParity hexutil.Uint64 `json:"yParity"`
+	R       hexutil.Big    `json:"r"`
+	S       hexutil.Big    `json:"s"`
+}
+
+// ToEthAuthorization converts the arguments to an EIP-7702 authorization
+// tuple.
+func (a SetCodeAuthorizationArgs) ToEthAuthorization() ethutils.SetCodeAuthorization {
+	// an out of range y parity is kept invalid so that the authority can't
+	// be recovered
+	yParity := uint8(stdmath.MaxUint8)
+	if uint64(a.YParity) < stdmath.MaxUint8 {
+		yParity = uint8(a.YParity)
+	}
+
+	return ethutils.SetCodeAuthorization{
+		ChainID: new(big.Int).Set(a.ChainID.ToInt()),
+		Address: a.Address,
+		Nonce:   uint64(a.Nonce),
+		V:       yParity,
+		R:       new(big.Int).Set(a.R.ToInt()),
+		S:       new(big.Int).Set(a.S.ToInt()),
+	}
 }
 
 // String return the struct in a string format
@@ -156,10 +194,15 @@ func (args *TransactionArgs) ToTransaction() *MsgEthereumTx {
 
 // ToMessage converts the arguments to the Message type used by the core evm.
 // This assumes that setTxDefaults has been called.
-func (args *TransactionArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (ethtypes.Message, error) {
+func (args *TransactionArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (core.Message, error) {
 	// Reject invalid combinations of pre- and post-1559 fee styles
 	if args.GasPrice != nil && (args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil) {
-		return ethtypes.Message{}, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
+		return nil, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
+	}
+
+	// The set code transactions can't create contracts
+	if args.To == nil && len(args.AuthorizationList) > 0 {
+		return nil, errors.New(`missing "to" in set code transaction`)
 	}
 
 	// Set sender address or use zero address if none specified.
@@ -227,7 +270,19 @@ func (args *TransactionArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (e
 	}
 
 	msg := ethtypes.NewMessage(addr, args.To, nonce, value, gas, gasPrice, gasFeeCap, gasTipCap, data, accessList, true)
-	return msg, nil
+	if len(args.AuthorizationList) == 0 {
+		return msg, nil
+	}
+
+	authList := make([]ethutils.SetCodeAuthorization, len(args.AuthorizationList))
+	for i, auth := range args.AuthorizationList {
+		authList[i] = auth.ToEthAuthorization()
+	}
+
+	return SetCodeMessage{
+		Message:           msg,
+		AuthorizationList: authList,
+	}, nil
 }
 
 // GetFrom retrieves the transaction sender address.
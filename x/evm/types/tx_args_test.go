@@ -1,12 +1,15 @@
 package types_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -226,6 +229,68 @@ func (suite *TxDataTestSuite) TestToMessageEVM() {
 	}
 }
 
+func (suite *TxDataTestSuite) TestToMessageAuthorizationList() {
+	auth, authority := suite.signedAuthorization(suite.bigInt, 1)
+	authArgs := types.SetCodeAuthorizationArgs{
+		ChainID: hexutil.Big(*auth.ChainID),
+		Address: auth.Address,
+		Nonce:   hexutil.Uint64(auth.Nonce),
+		YParity: hexutil.Uint64(auth.V),
+		R:       hexutil.Big(*auth.R),
+		S:       hexutil.Big(*auth.S),
+	}
+
+	testCases := []struct {
+		name     string
+		txArgs   types.TransactionArgs
+		expError bool
+	}{
+		{
+			"set code call",
+			types.TransactionArgs{
+				From:              &suite.addr,
+				To:                &suite.addr,
+				AuthorizationList: []types.SetCodeAuthorizationArgs{authArgs},
+			},
+			false,
+		},
+		{
+			"set code without recipient",
+			types.TransactionArgs{
+				From:              &suite.addr,
+				AuthorizationList: []types.SetCodeAuthorizationArgs{authArgs},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		res, err := tc.txArgs.ToMessage(0, nil)
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+		setCodeMsg, ok := res.(types.SetCodeMessage)
+		suite.Require().True(ok, tc.name)
+		suite.Require().Equal([]ethutils.SetCodeAuthorization{auth}, setCodeMsg.AuthorizationList, tc.name)
+
+		recovered, err := setCodeMsg.AuthorizationList[0].Authority()
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(authority, recovered, tc.name)
+	}
+
+	// the authorization list round trips through its JSON-RPC representation
+	bz, err := json.Marshal(types.TransactionArgs{AuthorizationList: []types.SetCodeAuthorizationArgs{authArgs}})
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(bz), `"authorizationList"`)
+
+	var decoded types.TransactionArgs
+	suite.Require().NoError(json.Unmarshal(bz, &decoded))
+	suite.Require().Equal(auth, decoded.AuthorizationList[0].ToEthAuthorization())
+}
+
 func (suite *TxDataTestSuite) TestGetFrom() {
 	testCases := []struct {
 		name       string
@@ -14,6 +14,8 @@ func GetTxTypeName(txType int) string {
 		return "LegacyTxType"
 	case gethtypes.AccessListTxType:
 		return "AccessListTxType"
+	case SetCodeTxType:
+		return "SetCodeTxType"
 	default:
 		panic("unknown tx type")
 	}
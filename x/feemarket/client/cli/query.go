@@ -3,6 +3,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -24,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
+		GetBaseFeeInputsCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -116,3 +120,41 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBaseFeeInputsCmd queries the inputs used to calculate the base fee of the
+// last blocks
+func GetBaseFeeInputsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee-inputs [blocks]",
+		Short: "Get the inputs used to calculate the base fee of the last blocks",
+		Long: `Get the inputs used to calculate the base fee of the last blocks.
+If the number of blocks is not provided, it will return all the stored blocks.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var blocks uint64
+			if len(args) == 1 {
+				blocks, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid number of blocks %s: %w", args[0], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseFeeInputs(cmd.Context(), &types.QueryBaseFeeInputsRequest{Blocks: blocks})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
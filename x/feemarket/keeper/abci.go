@@ -16,7 +16,7 @@ import (
 
 // BeginBlock updates base fee
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
-	baseFee := k.CalculateBaseFee(ctx)
+	baseFee, inputs := k.calculateBaseFee(ctx)
 
 	// return immediately if base fee is nil
 	if baseFee.IsNil() {
@@ -25,6 +25,12 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 
 	k.SetBaseFee(ctx, baseFee)
 
+	// store the inputs used for the calculation to be queried and used by the
+	// AIMD algorithm sliding window
+	if inputs != nil {
+		k.SetBaseFeeInputs(ctx, *inputs)
+	}
+
 	defer func() {
 		floatBaseFee, err := baseFee.Float64()
 		if err != nil {
@@ -45,7 +51,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	return nil
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted and gas used.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
@@ -78,6 +84,7 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(minGasMultiplier)
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
+	k.SetBlockGasUsed(ctx, gasUsed.Uint64())
 
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
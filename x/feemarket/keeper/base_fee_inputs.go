@@ -0,0 +1,58 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// SetBaseFeeInputs stores the inputs used to calculate the base fee of a block
+// and prunes the inputs older than MaxBaseFeeInputsHistory blocks.
+// CONTRACT: this should be only called during BeginBlock.
+func (k Keeper) SetBaseFeeInputs(ctx sdk.Context, inputs types.BaseFeeInputs) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeInputs)
+	store.Set(sdk.Uint64ToBigEndian(uint64(inputs.Height)), k.cdc.MustMarshal(&inputs)) // #nosec G115 -- block height is never negative
+
+	pruneHeight := inputs.Height - types.MaxBaseFeeInputsHistory
+	if pruneHeight <= 0 {
+		return
+	}
+
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(pruneHeight)+1)) // #nosec G115 -- pruneHeight is positive
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetBaseFeeInputs returns the stored base fee inputs of the last given number
+// of blocks, ordered from the most recent block. A zero value returns all the
+// stored base fee inputs.
+func (k Keeper) GetBaseFeeInputs(ctx sdk.Context, blocks uint64) []types.BaseFeeInputs {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeInputs)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var inputs []types.BaseFeeInputs
+	for ; iterator.Valid(); iterator.Next() {
+		if blocks > 0 && uint64(len(inputs)) == blocks {
+			break
+		}
+
+		var in types.BaseFeeInputs
+		k.cdc.MustUnmarshal(iterator.Value(), &in)
+		inputs = append(inputs, in)
+	}
+
+	return inputs
+}
@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/evmos/evmos/v20/x/feemarket/types"
 )
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
@@ -14,11 +16,19 @@ import (
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
+	baseFee, _ := k.calculateBaseFee(ctx)
+	return baseFee
+}
+
+// calculateBaseFee calculates the base fee for the current block and returns
+// it together with the inputs used for the calculation. The inputs are nil if
+// the base fee is not calculated from the parent block.
+func (k Keeper) calculateBaseFee(ctx sdk.Context) (sdkmath.LegacyDec, *types.BaseFeeInputs) {
 	params := k.GetParams(ctx)
 
 	// Ignore the calculation if not enabled
 	if !params.IsBaseFeeEnabled(ctx.BlockHeight()) {
-		return sdkmath.LegacyDec{}
+		return sdkmath.LegacyDec{}, nil
 	}
 
 	consParams := ctx.ConsensusParams()
@@ -27,7 +37,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
 	if ctx.BlockHeight() == params.EnableHeight {
-		return params.BaseFee
+		return params.BaseFee, nil
 	}
 
 	// get the block gas used and the base fee values for the parent block.
@@ -36,10 +46,23 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	// persistent KVStore after EndBlock (ABCI Commit).
 	parentBaseFee := params.BaseFee
 	if parentBaseFee.IsNil() {
-		return sdkmath.LegacyDec{}
+		return sdkmath.LegacyDec{}, nil
 	}
 
-	parentGasUsed := k.GetBlockGasWanted(ctx)
+	inputs := &types.BaseFeeInputs{
+		Height:           ctx.BlockHeight(),
+		BaseFeeAlgorithm: params.BaseFeeAlgorithm,
+		GasInput:         params.GasInput,
+		ParentGasWanted:  k.GetBlockGasWanted(ctx),
+		ParentGasUsed:    k.GetBlockGasUsed(ctx),
+		ParentBaseFee:    parentBaseFee,
+		LearningRate:     sdkmath.LegacyZeroDec(),
+	}
+
+	parentGasUsed := inputs.ParentGasWanted
+	if params.GasInput == types.GasInputGasUsed {
+		parentGasUsed = inputs.ParentGasUsed
+	}
 
 	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
 
@@ -47,27 +70,52 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
 		gasLimit = sdkmath.NewInt(consParams.Block.MaxGas)
 	}
+	inputs.GasLimit = gasLimit.Uint64()
 
 	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
 	// validation
 	parentGasTargetInt := gasLimit.Quo(sdkmath.NewIntFromUint64(uint64(params.ElasticityMultiplier)))
 	if !parentGasTargetInt.IsUint64() {
-		return sdkmath.LegacyDec{}
+		return sdkmath.LegacyDec{}, nil
 	}
 
 	parentGasTarget := parentGasTargetInt.Uint64()
-	baseFeeChangeDenominator := sdkmath.NewIntFromUint64(uint64(params.BaseFeeChangeDenominator))
+	inputs.GasTarget = parentGasTarget
 
-	// If the parent gasUsed is the same as the target, the baseFee remains
-	// unchanged.
-	if parentGasUsed == parentGasTarget {
-		return parentBaseFee
+	// the AIMD learning rate is updated on every block, including the blocks
+	// that don't change the base fee, so that it is carried to the next block
+	if params.BaseFeeAlgorithm == types.BaseFeeAlgorithmAIMD {
+		inputs.LearningRate = k.calculateLearningRate(ctx, params, parentGasUsed, inputs.GasLimit)
 	}
 
-	if parentGasTargetInt.IsZero() {
-		return sdkmath.LegacyZeroDec()
+	var baseFee sdkmath.LegacyDec
+	switch {
+	case parentGasUsed == parentGasTarget:
+		// If the parent gasUsed is the same as the target, the baseFee remains
+		// unchanged.
+		baseFee = parentBaseFee
+	case parentGasTargetInt.IsZero():
+		baseFee = sdkmath.LegacyZeroDec()
+	case params.BaseFeeAlgorithm == types.BaseFeeAlgorithmAIMD:
+		baseFee = calculateAIMDBaseFee(params, parentBaseFee, parentGasUsed, parentGasTarget, inputs.LearningRate)
+	default:
+		baseFee = calculateEIP1559BaseFee(params, parentBaseFee, parentGasUsed, parentGasTarget)
 	}
 
+	inputs.BaseFee = params.BoundBaseFee(baseFee)
+	return inputs.BaseFee, inputs
+}
+
+// calculateEIP1559BaseFee adjusts the parent base fee by the gas used delta
+// with respect to the target, bounded by the base fee change denominator.
+func calculateEIP1559BaseFee(
+	params types.Params,
+	parentBaseFee sdkmath.LegacyDec,
+	parentGasUsed, parentGasTarget uint64,
+) sdkmath.LegacyDec {
+	parentGasTargetInt := sdkmath.NewIntFromUint64(parentGasTarget)
+	baseFeeChangeDenominator := sdkmath.NewIntFromUint64(uint64(params.BaseFeeChangeDenominator))
+
 	if parentGasUsed > parentGasTarget {
 		// If the parent block used more gas than its target, the baseFee should
 		// increase.
@@ -93,3 +141,73 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	// the min gas price don't even reach the mempool.
 	return sdkmath.LegacyMaxDec(parentBaseFee.Sub(baseFeeDelta), params.MinGasPrice)
 }
+
+// calculateAIMDBaseFee adjusts the parent base fee proportionally to the
+// relative gas used delta with respect to the target, scaled by the learning
+// rate:
+//
+//	baseFee = parentBaseFee * (1 + learningRate * (gasUsed - target) / target)
+func calculateAIMDBaseFee(
+	params types.Params,
+	parentBaseFee sdkmath.LegacyDec,
+	parentGasUsed, parentGasTarget uint64,
+	learningRate sdkmath.LegacyDec,
+) sdkmath.LegacyDec {
+	gasUsed := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(parentGasUsed))
+	gasTarget := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(parentGasTarget))
+
+	utilizationDelta := gasUsed.Sub(gasTarget).Quo(gasTarget)
+	baseFee := parentBaseFee.Mul(sdkmath.LegacyOneDec().Add(learningRate.Mul(utilizationDelta)))
+
+	// Set global min gas price as lower bound of the base fee, transactions below
+	// the min gas price don't even reach the mempool.
+	return sdkmath.LegacyMaxDec(baseFee, params.MinGasPrice)
+}
+
+// calculateLearningRate returns the AIMD learning rate for the current block.
+// The learning rate of the previous block is increased additively by alpha
+// when the average block utilization over the window is outside of the
+// [gamma, 1 - gamma] bounds, and decreased multiplicatively by beta otherwise.
+func (k Keeper) calculateLearningRate(ctx sdk.Context, params types.Params, parentGasUsed, gasLimit uint64) sdkmath.LegacyDec {
+	aimd := params.AIMD
+	learningRate := aimd.MinLearningRate
+
+	totalGasUsed := sdkmath.NewIntFromUint64(parentGasUsed)
+	totalGasLimit := sdkmath.NewIntFromUint64(gasLimit)
+
+	// the parent block is part of the window, so only the previous window - 1
+	// blocks are retrieved from the history. The inputs of the current block
+	// are skipped as they are already stored when queried after BeginBlock.
+	var count uint64
+	for _, in := range k.GetBaseFeeInputs(ctx, aimd.Window) {
+		if in.Height >= ctx.BlockHeight() {
+			continue
+		}
+		if count == aimd.Window-1 {
+			break
+		}
+		if count == 0 && in.BaseFeeAlgorithm == types.BaseFeeAlgorithmAIMD {
+			learningRate = in.LearningRate
+		}
+		count++
+
+		gasUsed := in.ParentGasWanted
+		if params.GasInput == types.GasInputGasUsed {
+			gasUsed = in.ParentGasUsed
+		}
+
+		totalGasUsed = totalGasUsed.Add(sdkmath.NewIntFromUint64(gasUsed))
+		totalGasLimit = totalGasLimit.Add(sdkmath.NewIntFromUint64(in.GasLimit))
+	}
+
+	utilization := sdkmath.LegacyZeroDec()
+	if totalGasLimit.IsPositive() {
+		utilization = sdkmath.LegacyNewDecFromInt(totalGasUsed).QuoInt(totalGasLimit)
+	}
+
+	if utilization.LTE(aimd.Gamma) || utilization.GTE(sdkmath.LegacyOneDec().Sub(aimd.Gamma)) {
+		return sdkmath.LegacyMinDec(learningRate.Add(aimd.Alpha), aimd.MaxLearningRate)
+	}
+
+	return sdkmath.LegacyMaxDec(learningRate.Mul(aimd.Beta), aimd.MinLearningRate)
+}
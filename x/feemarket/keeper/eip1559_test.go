@@ -7,6 +7,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -127,3 +128,169 @@ func TestCalculateBaseFee(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateBaseFeeModes(t *testing.T) {
+	var (
+		nw             *network.UnitTestNetwork
+		ctx            sdk.Context
+		initialBaseFee math.LegacyDec
+	)
+
+	testCases := []struct {
+		name                 string
+		parentBlockGasWanted uint64
+		parentBlockGasUsed   uint64
+		malleate             func(params *types.Params)
+		expFee               func() math.LegacyDec
+	}{
+		{
+			"gas wanted input - parent block wanted the same gas as its target",
+			50,
+			100,
+			func(params *types.Params) {
+				params.GasInput = types.GasInputGasWanted
+			},
+			func() math.LegacyDec { return initialBaseFee },
+		},
+		{
+			"gas used input - parent block used more gas than its target",
+			50,
+			100,
+			func(params *types.Params) {
+				params.GasInput = types.GasInputGasUsed
+			},
+			func() math.LegacyDec { return initialBaseFee.Add(initialBaseFee.QuoInt64(8)) },
+		},
+		{
+			"max base fee - base fee increase is bounded",
+			100,
+			100,
+			func(params *types.Params) {
+				params.MaxBaseFee = initialBaseFee
+			},
+			func() math.LegacyDec { return initialBaseFee },
+		},
+		{
+			"min base fee - base fee decrease is bounded",
+			25,
+			25,
+			func(params *types.Params) {
+				params.MinBaseFee = initialBaseFee
+			},
+			func() math.LegacyDec { return initialBaseFee },
+		},
+		{
+			"AIMD - full blocks increase the initial learning rate",
+			100,
+			100,
+			func(params *types.Params) {
+				params.BaseFeeAlgorithm = types.BaseFeeAlgorithmAIMD
+				params.GasInput = types.GasInputGasUsed
+
+				// the learning rate of blocks calculated with EIP-1559 is ignored
+				nw.App.FeeMarketKeeper.SetBaseFeeInputs(ctx, types.BaseFeeInputs{
+					Height:           ctx.BlockHeight() - 1,
+					BaseFeeAlgorithm: types.BaseFeeAlgorithmEIP1559,
+					ParentGasUsed:    100,
+					GasLimit:         100,
+					ParentBaseFee:    initialBaseFee,
+					BaseFee:          initialBaseFee,
+					LearningRate:     math.LegacyZeroDec(),
+				})
+			},
+			// utilization = (100 + 100) / 200 = 1
+			// learning rate = 0.01 + 0.025 = 0.035
+			// base fee = base fee * (1 + 0.035 * (100 - 50) / 50)
+			func() math.LegacyDec { return initialBaseFee.Mul(math.LegacyNewDecWithPrec(1035, 3)) },
+		},
+		{
+			"AIMD - window utilization within gamma bounds decreases the previous learning rate",
+			60,
+			60,
+			func(params *types.Params) {
+				params.BaseFeeAlgorithm = types.BaseFeeAlgorithmAIMD
+				params.GasInput = types.GasInputGasUsed
+
+				nw.App.FeeMarketKeeper.SetBaseFeeInputs(ctx, types.BaseFeeInputs{
+					Height:           ctx.BlockHeight() - 1,
+					BaseFeeAlgorithm: types.BaseFeeAlgorithmAIMD,
+					ParentGasUsed:    50,
+					GasLimit:         100,
+					ParentBaseFee:    initialBaseFee,
+					BaseFee:          initialBaseFee,
+					LearningRate:     math.LegacyNewDecWithPrec(2, 1),
+				})
+			},
+			// utilization = (60 + 50) / 200 = 0.55
+			// learning rate = 0.2 * 0.95 = 0.19
+			// base fee = base fee * (1 + 0.19 * (60 - 50) / 50)
+			func() math.LegacyDec { return initialBaseFee.Mul(math.LegacyNewDecWithPrec(1038, 3)) },
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// reset network and context
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext().WithBlockHeight(1000)
+
+			params := nw.App.FeeMarketKeeper.GetParams(ctx)
+			initialBaseFee = params.BaseFee
+
+			tc.malleate(&params)
+			err := nw.App.FeeMarketKeeper.SetParams(ctx, params)
+			require.NoError(t, err)
+
+			// Set parent block gas
+			nw.App.FeeMarketKeeper.SetBlockGasWanted(ctx, tc.parentBlockGasWanted)
+			nw.App.FeeMarketKeeper.SetBlockGasUsed(ctx, tc.parentBlockGasUsed)
+
+			// Set next block target/gasLimit through Consensus Param MaxGas
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			consParams := tmproto.ConsensusParams{Block: &blockParams}
+			ctx = ctx.WithConsensusParams(consParams)
+
+			fee := nw.App.FeeMarketKeeper.CalculateBaseFee(ctx)
+			require.Equal(t, tc.expFee(), fee, tc.name)
+		})
+	}
+}
+
+func TestAIMDLearningRateOnTarget(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext().WithBlockHeight(1000)
+
+	params := nw.App.FeeMarketKeeper.GetParams(ctx)
+	initialBaseFee := params.BaseFee
+	params.BaseFeeAlgorithm = types.BaseFeeAlgorithmAIMD
+	params.GasInput = types.GasInputGasUsed
+	require.NoError(t, nw.App.FeeMarketKeeper.SetParams(ctx, params))
+
+	nw.App.FeeMarketKeeper.SetBaseFeeInputs(ctx, types.BaseFeeInputs{
+		Height:           ctx.BlockHeight() - 1,
+		BaseFeeAlgorithm: types.BaseFeeAlgorithmAIMD,
+		ParentGasUsed:    50,
+		GasLimit:         100,
+		ParentBaseFee:    initialBaseFee,
+		BaseFee:          initialBaseFee,
+		LearningRate:     math.LegacyNewDecWithPrec(2, 1),
+	})
+
+	// the parent block used exactly its target
+	nw.App.FeeMarketKeeper.SetBlockGasUsed(ctx, 50)
+	consParams := tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10}}
+	ctx = ctx.WithConsensusParams(consParams)
+
+	require.NoError(t, nw.App.FeeMarketKeeper.BeginBlock(ctx))
+
+	// the base fee doesn't change but the learning rate is still updated:
+	// utilization = (50 + 50) / 200 = 0.5
+	// learning rate = 0.2 * 0.95 = 0.19
+	require.Equal(t, initialBaseFee, nw.App.FeeMarketKeeper.GetBaseFee(ctx))
+	inputs := nw.App.FeeMarketKeeper.GetBaseFeeInputs(ctx, 1)
+	require.Len(t, inputs, 1)
+	require.Equal(t, ctx.BlockHeight(), inputs[0].Height)
+	require.Equal(t, math.LegacyNewDecWithPrec(19, 2), inputs[0].LearningRate)
+}
@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/evmos/v20/x/feemarket/types"
 )
@@ -48,3 +50,16 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// BaseFeeInputs implements the Query/BaseFeeInputs gRPC method
+func (k Keeper) BaseFeeInputs(c context.Context, req *types.QueryBaseFeeInputsRequest) (*types.QueryBaseFeeInputsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBaseFeeInputsResponse{
+		Inputs: k.GetBaseFeeInputs(ctx, req.Blocks),
+	}, nil
+}
@@ -133,3 +133,58 @@ func TestQueryBlockGas(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryBaseFeeInputs(t *testing.T) {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	testCases := []struct {
+		name       string
+		blocks     uint64
+		expHeights []int64
+	}{
+		{
+			"pass - last two blocks",
+			2,
+			[]int64{1002, 1001},
+		},
+		{
+			"pass - all stored blocks",
+			0,
+			[]int64{1002, 1001, 1000},
+		},
+		{
+			"pass - more blocks than stored",
+			10,
+			[]int64{1002, 1001, 1000},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// reset network and context
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+			qc := nw.GetFeeMarketClient()
+
+			// the inputs stored during the network setup are pruned
+			for height := int64(1000); height <= 1002; height++ {
+				nw.App.FeeMarketKeeper.SetBaseFeeInputs(ctx, types.BaseFeeInputs{
+					Height:        height,
+					ParentBaseFee: sdkmath.LegacyOneDec(),
+					BaseFee:       sdkmath.LegacyOneDec(),
+					LearningRate:  sdkmath.LegacyZeroDec(),
+				})
+			}
+
+			res, err := qc.BaseFeeInputs(ctx.Context(), &types.QueryBaseFeeInputsRequest{Blocks: tc.blocks})
+			require.NoError(t, err)
+
+			heights := make([]int64, 0, len(res.Inputs))
+			for _, in := range res.Inputs {
+				heights = append(heights, in.Height)
+			}
+			require.Equal(t, tc.expHeights, heights, tc.name)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -73,3 +74,36 @@ func TestSetGetGasFee(t *testing.T) {
 		})
 	}
 }
+
+func TestSetGetBlockGasUsed(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	nw.App.FeeMarketKeeper.SetBlockGasUsed(ctx, uint64(1000000))
+	require.Equal(t, uint64(1000000), nw.App.FeeMarketKeeper.GetBlockGasUsed(ctx))
+}
+
+func TestBaseFeeInputsPruning(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	setInputs := func(height int64) {
+		nw.App.FeeMarketKeeper.SetBaseFeeInputs(ctx, types.BaseFeeInputs{
+			Height:        height,
+			ParentBaseFee: math.LegacyOneDec(),
+			BaseFee:       math.LegacyOneDec(),
+			LearningRate:  math.LegacyZeroDec(),
+		})
+	}
+
+	setInputs(1000)
+	setInputs(1000 + types.MaxBaseFeeInputsHistory - 1)
+	require.Equal(t, int64(1000), nw.App.FeeMarketKeeper.GetBaseFeeInputs(ctx, 0)[1].Height)
+
+	// the inputs older than the history are pruned
+	setInputs(1000 + types.MaxBaseFeeInputsHistory)
+	inputs := nw.App.FeeMarketKeeper.GetBaseFeeInputs(ctx, 0)
+	require.Len(t, inputs, 2)
+	require.Equal(t, int64(1000+types.MaxBaseFeeInputsHistory), inputs[0].Height)
+	require.Equal(t, int64(1000+types.MaxBaseFeeInputsHistory-1), inputs[1].Height)
+}
@@ -28,6 +28,14 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		params.MinGasMultiplier = math.LegacyZeroDec()
 	}
 
+	if params.MinBaseFee.IsNil() {
+		params.MinBaseFee = math.LegacyZeroDec()
+	}
+
+	if params.MaxBaseFee.IsNil() {
+		params.MaxBaseFee = math.LegacyZeroDec()
+	}
+
 	return
 }
 
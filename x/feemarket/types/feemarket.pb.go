@@ -25,6 +25,66 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// GasInput defines the parent block gas value used as input for the base fee
+// calculation.
+type GasInput int32
+
+const (
+	// GAS_INPUT_GAS_WANTED uses the block gas wanted, bounded by the min gas
+	// multiplier
+	GasInputGasWanted GasInput = 0
+	// GAS_INPUT_GAS_USED uses the gas consumed by the block transactions
+	GasInputGasUsed GasInput = 1
+)
+
+var GasInput_name = map[int32]string{
+	0: "GAS_INPUT_GAS_WANTED",
+	1: "GAS_INPUT_GAS_USED",
+}
+
+var GasInput_value = map[string]int32{
+	"GAS_INPUT_GAS_WANTED": 0,
+	"GAS_INPUT_GAS_USED":   1,
+}
+
+func (x GasInput) String() string {
+	return proto.EnumName(GasInput_name, int32(x))
+}
+
+func (GasInput) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
+// BaseFeeAlgorithm defines the algorithm used to calculate the base fee.
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_EIP1559 adjusts the base fee with the fixed change
+	// denominator of EIP-1559
+	BaseFeeAlgorithmEIP1559 BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_AIMD adjusts the base fee with a learning rate that is
+	// updated based on the block utilization over a sliding window of blocks
+	BaseFeeAlgorithmAIMD BaseFeeAlgorithm = 1
+)
+
+var BaseFeeAlgorithm_name = map[int32]string{
+	0: "BASE_FEE_ALGORITHM_EIP1559",
+	1: "BASE_FEE_ALGORITHM_AIMD",
+}
+
+var BaseFeeAlgorithm_value = map[string]int32{
+	"BASE_FEE_ALGORITHM_EIP1559": 0,
+	"BASE_FEE_ALGORITHM_AIMD":    1,
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return proto.EnumName(BaseFeeAlgorithm_name, int32(x))
+}
+
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+
 // Params defines the feemarket module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -44,6 +104,18 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// gas_input defines the parent block gas value used as input for the base
+	// fee calculation.
+	GasInput GasInput `protobuf:"varint,9,opt,name=gas_input,json=gasInput,proto3,enum=ethermint.feemarket.v1.GasInput" json:"gas_input,omitempty"`
+	// base_fee_algorithm defines the algorithm used to calculate the base fee.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,10,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=ethermint.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// aimd defines the parameters of the AIMD base fee algorithm.
+	AIMD AIMDParams `protobuf:"bytes,11,opt,name=aimd,proto3" json:"aimd"`
+	// min_base_fee defines the lower bound of the calculated base fee.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// max_base_fee defines the upper bound of the calculated base fee. A zero
+	// value disables the upper bound.
+	MaxBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -107,8 +179,201 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetGasInput() GasInput {
+	if m != nil {
+		return m.GasInput
+	}
+	return GasInputGasWanted
+}
+
+func (m *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.BaseFeeAlgorithm
+	}
+	return BaseFeeAlgorithmEIP1559
+}
+
+func (m *Params) GetAIMD() AIMDParams {
+	if m != nil {
+		return m.AIMD
+	}
+	return AIMDParams{}
+}
+
+// AIMDParams defines the parameters of the additive increase multiplicative
+// decrease (AIMD) base fee algorithm.
+type AIMDParams struct {
+	// window defines the number of blocks used to compute the average block
+	// utilization.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// alpha defines the amount the learning rate is increased by when the
+	// average block utilization is outside of the gamma bounds.
+	Alpha cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=alpha,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"alpha"`
+	// beta defines the factor the learning rate is multiplied by when the
+	// average block utilization is within the gamma bounds.
+	Beta cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=beta,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"beta"`
+	// gamma defines the average block utilization bounds [gamma, 1 - gamma]
+	// within which the learning rate is decreased.
+	Gamma cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=gamma,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gamma"`
+	// min_learning_rate defines the lower bound of the learning rate.
+	MinLearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=min_learning_rate,json=minLearningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_learning_rate"`
+	// max_learning_rate defines the upper bound of the learning rate.
+	MaxLearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_learning_rate,json=maxLearningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_learning_rate"`
+}
+
+func (m *AIMDParams) Reset()         { *m = AIMDParams{} }
+func (m *AIMDParams) String() string { return proto.CompactTextString(m) }
+func (*AIMDParams) ProtoMessage()    {}
+func (*AIMDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *AIMDParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AIMDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AIMDParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AIMDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AIMDParams.Merge(m, src)
+}
+func (m *AIMDParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *AIMDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AIMDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AIMDParams proto.InternalMessageInfo
+
+func (m *AIMDParams) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// BaseFeeInputs defines the inputs used to calculate the base fee of a block.
+type BaseFeeInputs struct {
+	// height is the block height the base fee was calculated for.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee_algorithm is the algorithm used to calculate the base fee.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,2,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=ethermint.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// gas_input is the parent block gas value used as input.
+	GasInput GasInput `protobuf:"varint,3,opt,name=gas_input,json=gasInput,proto3,enum=ethermint.feemarket.v1.GasInput" json:"gas_input,omitempty"`
+	// parent_gas_wanted is the gas wanted of the parent block.
+	ParentGasWanted uint64 `protobuf:"varint,4,opt,name=parent_gas_wanted,json=parentGasWanted,proto3" json:"parent_gas_wanted,omitempty"`
+	// parent_gas_used is the gas used of the parent block.
+	ParentGasUsed uint64 `protobuf:"varint,5,opt,name=parent_gas_used,json=parentGasUsed,proto3" json:"parent_gas_used,omitempty"`
+	// gas_limit is the block gas limit.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// gas_target is the block gas target.
+	GasTarget uint64 `protobuf:"varint,7,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+	// parent_base_fee is the base fee of the parent block.
+	ParentBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=parent_base_fee,json=parentBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"parent_base_fee"`
+	// base_fee is the calculated base fee.
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+	// learning_rate is the learning rate used by the AIMD algorithm. It is zero
+	// for the EIP-1559 algorithm.
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+}
+
+func (m *BaseFeeInputs) Reset()         { *m = BaseFeeInputs{} }
+func (m *BaseFeeInputs) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeInputs) ProtoMessage()    {}
+func (*BaseFeeInputs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *BaseFeeInputs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeInputs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeInputs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeInputs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeInputs.Merge(m, src)
+}
+func (m *BaseFeeInputs) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeInputs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeInputs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeInputs proto.InternalMessageInfo
+
+func (m *BaseFeeInputs) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BaseFeeInputs) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.BaseFeeAlgorithm
+	}
+	return BaseFeeAlgorithmEIP1559
+}
+
+func (m *BaseFeeInputs) GetGasInput() GasInput {
+	if m != nil {
+		return m.GasInput
+	}
+	return GasInputGasWanted
+}
+
+func (m *BaseFeeInputs) GetParentGasWanted() uint64 {
+	if m != nil {
+		return m.ParentGasWanted
+	}
+	return 0
+}
+
+func (m *BaseFeeInputs) GetParentGasUsed() uint64 {
+	if m != nil {
+		return m.ParentGasUsed
+	}
+	return 0
+}
+
+func (m *BaseFeeInputs) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *BaseFeeInputs) GetGasTarget() uint64 {
+	if m != nil {
+		return m.GasTarget
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.GasInput", GasInput_name, GasInput_value)
+	proto.RegisterEnum("ethermint.feemarket.v1.BaseFeeAlgorithm", BaseFeeAlgorithm_name, BaseFeeAlgorithm_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*AIMDParams)(nil), "ethermint.feemarket.v1.AIMDParams")
+	proto.RegisterType((*BaseFeeInputs)(nil), "ethermint.feemarket.v1.BaseFeeInputs")
 }
 
 func init() {
@@ -116,34 +381,65 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x5d, 0xb3, 0xed, 0x76, 0xeb, 0xb2, 0xd2, 0x62, 0x15, 0x14, 0xb5, 0x22, 0x5d, 0x81, 0x84,
-	0xa2, 0x0a, 0x25, 0x94, 0xde, 0x90, 0xb8, 0x6c, 0xab, 0x82, 0x50, 0x91, 0xaa, 0x1c, 0x38, 0x70,
-	0xb1, 0x9c, 0x74, 0x9a, 0x8c, 0x1a, 0xdb, 0xab, 0xd8, 0x8d, 0xd8, 0x5f, 0xe0, 0xc4, 0x67, 0x70,
-	0xec, 0x67, 0xf4, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xcd, 0xa1, 0xbf, 0x81, 0x1a, 0xd3, 0xa4, 0x1c,
-	0xf7, 0x32, 0x1a, 0xbf, 0xf7, 0xe6, 0x79, 0xec, 0x19, 0xfa, 0x0a, 0x6c, 0x0e, 0xa5, 0x44, 0x65,
-	0xa3, 0x33, 0x00, 0x29, 0xca, 0x73, 0xb0, 0x51, 0xb5, 0xd7, 0x1d, 0xc2, 0x59, 0xa9, 0xad, 0x66,
-	0xcf, 0x5a, 0x5d, 0xd8, 0x51, 0xd5, 0xde, 0xd6, 0x13, 0x21, 0x51, 0xe9, 0xa8, 0x89, 0x4e, 0xba,
-	0xb5, 0x99, 0xe9, 0x4c, 0x37, 0x69, 0x74, 0x97, 0x39, 0xf4, 0x45, 0xdd, 0xa7, 0x83, 0x13, 0x51,
-	0x0a, 0x69, 0x98, 0x4f, 0x37, 0x94, 0xe6, 0x89, 0x30, 0xc0, 0xcf, 0x00, 0x3c, 0x32, 0x21, 0xc1,
-	0x30, 0x5e, 0x57, 0x7a, 0x2a, 0x0c, 0x1c, 0x01, 0xb0, 0xf7, 0x74, 0xfb, 0x9e, 0xe4, 0x69, 0x2e,
-	0x54, 0x06, 0xfc, 0x14, 0x94, 0x96, 0xa8, 0x84, 0xd5, 0xa5, 0xf7, 0x68, 0x42, 0x82, 0x51, 0xec,
-	0x25, 0x4e, 0x7d, 0xd0, 0x08, 0x0e, 0x3b, 0x9e, 0xed, 0xd3, 0xa7, 0x50, 0x08, 0x63, 0x31, 0x45,
-	0x3b, 0xe7, 0xf2, 0xa2, 0xb0, 0x38, 0x2b, 0x10, 0x4a, 0xaf, 0xdf, 0x14, 0x6e, 0x76, 0xe4, 0xe7,
-	0x96, 0x63, 0x2f, 0xe9, 0x08, 0x94, 0x48, 0x0a, 0xe0, 0x39, 0x60, 0x96, 0x5b, 0x6f, 0x75, 0x42,
-	0x82, 0x7e, 0xfc, 0xd8, 0x81, 0x1f, 0x1b, 0x8c, 0x1d, 0xd0, 0x61, 0xdb, 0xf5, 0x60, 0x42, 0x82,
-	0xf5, 0x69, 0x70, 0x75, 0xb3, 0xd3, 0xfb, 0x7d, 0xb3, 0xb3, 0x9d, 0x6a, 0x23, 0xb5, 0x31, 0xa7,
-	0xe7, 0x21, 0xea, 0x48, 0x0a, 0x9b, 0x87, 0xc7, 0x90, 0x89, 0x74, 0x7e, 0x08, 0xe9, 0xcf, 0xdb,
-	0xcb, 0x5d, 0x12, 0xaf, 0xfd, 0xeb, 0x97, 0x1d, 0xd3, 0x91, 0x44, 0xc5, 0x33, 0x61, 0xf8, 0xac,
-	0xc4, 0x14, 0xbc, 0xb5, 0x25, 0x9d, 0x36, 0x24, 0xaa, 0x0f, 0xc2, 0x9c, 0xdc, 0x15, 0xb3, 0x2f,
-	0x94, 0xdd, 0xbb, 0x3d, 0x78, 0xe9, 0x70, 0x49, 0xcb, 0xb1, 0xb3, 0xec, 0xfe, 0xe3, 0xdd, 0xf3,
-	0xef, 0xb7, 0x97, 0xbb, 0x1e, 0x54, 0x52, 0x9b, 0xe8, 0xdb, 0x83, 0xd5, 0x70, 0x23, 0xfc, 0xb4,
-	0x32, 0x5c, 0x19, 0xaf, 0xc6, 0x63, 0x54, 0x68, 0x51, 0x14, 0xed, 0x2c, 0xa7, 0x47, 0x57, 0x0b,
-	0x9f, 0x5c, 0x2f, 0x7c, 0xf2, 0x67, 0xe1, 0x93, 0x1f, 0xb5, 0xdf, 0xbb, 0xae, 0xfd, 0xde, 0xaf,
-	0xda, 0xef, 0x7d, 0x7d, 0x9d, 0xa1, 0xcd, 0x2f, 0x92, 0x30, 0xd5, 0x32, 0x72, 0xb6, 0x2e, 0x56,
-	0x6f, 0xdf, 0xfc, 0x77, 0x81, 0x9d, 0xcf, 0xc0, 0x24, 0x83, 0x66, 0x69, 0xf6, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x0f, 0xe9, 0x83, 0xfe, 0x9f, 0x02, 0x00, 0x00,
+	// 925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0xb7, 0x62, 0xc5, 0xb5, 0x99, 0x78, 0x91, 0xb9, 0xb4, 0x15, 0x1c, 0xd4, 0x11, 0x32, 0xa0,
+	0x30, 0xbc, 0xc1, 0x5e, 0x52, 0xe4, 0xb0, 0x3f, 0x1d, 0x60, 0xd7, 0x8e, 0xeb, 0xc2, 0xe9, 0x0c,
+	0xc5, 0x59, 0x81, 0x5d, 0x04, 0xda, 0x66, 0x65, 0xa2, 0x22, 0x65, 0x48, 0x4c, 0xe2, 0x7c, 0x83,
+	0xc1, 0x18, 0x86, 0x7d, 0x81, 0x9c, 0x76, 0xd9, 0xb1, 0x87, 0x7d, 0x88, 0x1e, 0x7b, 0x1c, 0x06,
+	0x2c, 0x18, 0x92, 0x43, 0xbf, 0xc6, 0x40, 0x4a, 0x96, 0x65, 0xa3, 0x39, 0x68, 0xbb, 0x08, 0xe4,
+	0x7b, 0xbf, 0xf7, 0xe3, 0x23, 0x7f, 0xef, 0x3d, 0x08, 0x3c, 0xc6, 0x7c, 0x8c, 0x3d, 0x4a, 0x18,
+	0xaf, 0xbd, 0xc6, 0x98, 0x22, 0xef, 0x0d, 0xe6, 0xb5, 0xf3, 0xfd, 0xc5, 0xa6, 0x3a, 0xf1, 0x5c,
+	0xee, 0xc2, 0x07, 0x11, 0xae, 0xba, 0x70, 0x9d, 0xef, 0x17, 0x0b, 0x88, 0x12, 0xe6, 0xd6, 0xe4,
+	0x37, 0x80, 0x16, 0xb7, 0x6d, 0xd7, 0x76, 0xe5, 0xb2, 0x26, 0x56, 0x81, 0x75, 0xef, 0xef, 0x0c,
+	0xc8, 0xf4, 0x90, 0x87, 0xa8, 0x0f, 0x4b, 0x60, 0x83, 0xb9, 0xd6, 0x00, 0xf9, 0xd8, 0x7a, 0x8d,
+	0xb1, 0xae, 0x18, 0x4a, 0x39, 0x6b, 0xe6, 0x98, 0xdb, 0x40, 0x3e, 0x3e, 0xc2, 0x18, 0x3e, 0x05,
+	0x3b, 0x73, 0xa7, 0x35, 0x1c, 0x23, 0x66, 0x63, 0x6b, 0x84, 0x99, 0x4b, 0x09, 0x43, 0xdc, 0xf5,
+	0xf4, 0x35, 0x43, 0x29, 0xe7, 0x4d, 0x7d, 0x10, 0xa0, 0x9f, 0x49, 0x40, 0x73, 0xe1, 0x87, 0x4f,
+	0xc0, 0x7d, 0xec, 0x20, 0x9f, 0x93, 0x21, 0xe1, 0x97, 0x16, 0x3d, 0x73, 0x38, 0x99, 0x38, 0x04,
+	0x7b, 0x7a, 0x5a, 0x06, 0x6e, 0x2f, 0x9c, 0xc7, 0x91, 0x0f, 0x7e, 0x06, 0xf2, 0x98, 0xa1, 0x81,
+	0x83, 0xad, 0x31, 0x26, 0xf6, 0x98, 0xeb, 0xeb, 0x86, 0x52, 0x4e, 0x9b, 0x9b, 0x81, 0xf1, 0xb9,
+	0xb4, 0xc1, 0x67, 0x20, 0x1b, 0x65, 0x9d, 0x31, 0x94, 0x72, 0xae, 0x51, 0x7e, 0x77, 0xbd, 0x9b,
+	0xfa, 0xeb, 0x7a, 0x77, 0x67, 0xe8, 0xfa, 0xd4, 0xf5, 0xfd, 0xd1, 0x9b, 0x2a, 0x71, 0x6b, 0x14,
+	0xf1, 0x71, 0xb5, 0x8b, 0x6d, 0x34, 0xbc, 0x6c, 0xe2, 0xe1, 0xef, 0x1f, 0xde, 0x56, 0x14, 0xf3,
+	0x5e, 0x98, 0x2f, 0xec, 0x82, 0x3c, 0x25, 0xcc, 0xb2, 0x91, 0x6f, 0x4d, 0x3c, 0x32, 0xc4, 0xfa,
+	0xbd, 0x84, 0x4c, 0x1b, 0x94, 0xb0, 0x36, 0xf2, 0x7b, 0x22, 0x18, 0xfe, 0x00, 0xe0, 0x9c, 0x2d,
+	0x76, 0xd3, 0x6c, 0x42, 0x4a, 0x2d, 0xa0, 0x8c, 0xbd, 0xc7, 0x53, 0x90, 0x13, 0x9c, 0x84, 0x4d,
+	0xce, 0xb8, 0x9e, 0x33, 0x94, 0xf2, 0x27, 0x07, 0x46, 0xf5, 0xe3, 0x35, 0x50, 0x6d, 0x23, 0xbf,
+	0x23, 0x70, 0x66, 0xd6, 0x0e, 0x57, 0x22, 0xad, 0x48, 0x42, 0xe4, 0xd8, 0xae, 0x47, 0xf8, 0x98,
+	0xea, 0x40, 0xf2, 0x94, 0xef, 0xe2, 0x09, 0xf5, 0xaf, 0xcf, 0xf1, 0xa6, 0x36, 0x58, 0xb1, 0xc0,
+	0x0e, 0x50, 0x11, 0xa1, 0x23, 0x7d, 0xc3, 0x50, 0xca, 0x1b, 0x07, 0x7b, 0x77, 0x31, 0xd5, 0x3b,
+	0xc7, 0xcd, 0xa0, 0xd8, 0x1a, 0x05, 0xf1, 0x08, 0x37, 0xd7, 0xbb, 0xaa, 0xb0, 0x05, 0xb7, 0x95,
+	0x14, 0xf0, 0x05, 0xd8, 0x14, 0x2f, 0x17, 0x09, 0xba, 0x99, 0xf0, 0xcd, 0x00, 0x25, 0x6c, 0x5e,
+	0xb1, 0x82, 0x0b, 0x4d, 0x17, 0x5c, 0xf9, 0xc4, 0x5c, 0x68, 0x1a, 0x72, 0x7d, 0xfd, 0x68, 0xf6,
+	0xe1, 0x6d, 0x45, 0xc7, 0xe7, 0xd4, 0xf5, 0x6b, 0xd3, 0x58, 0x53, 0x06, 0xf7, 0x79, 0xa1, 0x66,
+	0x55, 0x6d, 0xdd, 0xd4, 0x08, 0x23, 0x9c, 0x20, 0x27, 0x3a, 0x72, 0xef, 0x97, 0x34, 0x00, 0x8b,
+	0x6b, 0xc3, 0x07, 0x20, 0x73, 0x41, 0xd8, 0xc8, 0xbd, 0x90, 0xed, 0xa5, 0x9a, 0xe1, 0x0e, 0x7e,
+	0x07, 0xd6, 0x91, 0x33, 0x19, 0x23, 0x7d, 0x2d, 0x61, 0x8a, 0x41, 0x18, 0xfc, 0x16, 0xa8, 0x03,
+	0xcc, 0x91, 0x9e, 0x4e, 0x18, 0x2e, 0xa3, 0xc4, 0xe9, 0x36, 0xa2, 0x14, 0xe9, 0x6a, 0xd2, 0xd3,
+	0x65, 0x18, 0xec, 0x83, 0x82, 0xd0, 0xcc, 0xc1, 0xc8, 0x63, 0x84, 0xd9, 0x96, 0x87, 0x38, 0xd6,
+	0xd7, 0x13, 0x72, 0x6d, 0x51, 0xc2, 0xba, 0x21, 0x83, 0x89, 0x38, 0x96, 0xac, 0x68, 0xba, 0xc2,
+	0x9a, 0x49, 0xcc, 0x8a, 0xa6, 0x71, 0xd6, 0xbd, 0x3f, 0x54, 0x90, 0x0f, 0x35, 0x95, 0x3d, 0x21,
+	0x35, 0x09, 0x87, 0x8b, 0x22, 0x87, 0x4b, 0xb8, 0xbb, 0xa3, 0x59, 0xd6, 0xfe, 0x77, 0xb3, 0x2c,
+	0xf5, 0x70, 0x3a, 0x71, 0x0f, 0x57, 0x40, 0x61, 0x82, 0x3c, 0xcc, 0xb8, 0x9c, 0x2e, 0x17, 0x88,
+	0x71, 0x3c, 0x92, 0xc2, 0xa9, 0xe6, 0x56, 0xe0, 0x68, 0x23, 0xff, 0x95, 0x34, 0xc3, 0xc7, 0x60,
+	0x2b, 0x86, 0x3d, 0xf3, 0xf1, 0x48, 0xca, 0xa2, 0x9a, 0xf9, 0x08, 0x79, 0xea, 0xe3, 0x11, 0xdc,
+	0x09, 0x52, 0x72, 0x08, 0x25, 0x5c, 0x3e, 0xb1, 0x2a, 0x0f, 0xec, 0x8a, 0x3d, 0x7c, 0x04, 0x80,
+	0x70, 0x72, 0xe4, 0xd9, 0x98, 0xcb, 0xb1, 0xa8, 0x9a, 0x02, 0xde, 0x97, 0x06, 0xd8, 0x8b, 0xce,
+	0x88, 0xfa, 0x2c, 0xe9, 0x9c, 0x0b, 0xb3, 0x99, 0xb7, 0x6d, 0x7c, 0x9e, 0xe7, 0xfe, 0xeb, 0x3c,
+	0x3f, 0x06, 0xf9, 0xe5, 0xca, 0x01, 0x09, 0x99, 0x36, 0x9d, 0x58, 0xd9, 0x54, 0x18, 0xc8, 0xce,
+	0xb5, 0x80, 0x35, 0xb0, 0xdd, 0xae, 0x9f, 0x58, 0x9d, 0x97, 0xbd, 0xd3, 0xbe, 0x25, 0x56, 0xaf,
+	0xea, 0x2f, 0xfb, 0xad, 0xa6, 0x96, 0x2a, 0xde, 0x9f, 0x5d, 0x19, 0x85, 0x39, 0x6e, 0x21, 0xc3,
+	0xe7, 0x00, 0x2e, 0x07, 0x9c, 0x9e, 0xb4, 0x9a, 0x9a, 0x52, 0xfc, 0x74, 0x76, 0x65, 0x6c, 0xc5,
+	0xe0, 0x42, 0x8b, 0xa2, 0xfa, 0xd3, 0x6f, 0xa5, 0x54, 0xe5, 0x67, 0x05, 0x68, 0xab, 0xb5, 0x04,
+	0xbf, 0x01, 0xc5, 0x46, 0xfd, 0xa4, 0x65, 0x1d, 0xb5, 0x5a, 0x56, 0xbd, 0xdb, 0xfe, 0xde, 0xec,
+	0xf4, 0x9f, 0x1f, 0x5b, 0xad, 0x4e, 0x6f, 0xff, 0xf0, 0xf0, 0x2b, 0x2d, 0x55, 0xdc, 0x99, 0x5d,
+	0x19, 0x0f, 0x57, 0xa3, 0x42, 0x37, 0x3c, 0x04, 0x0f, 0x3f, 0x12, 0x2c, 0x66, 0x93, 0xa6, 0x14,
+	0xf5, 0xd9, 0x95, 0xb1, 0xbd, 0x1a, 0x29, 0x7c, 0x41, 0x3a, 0x8d, 0xa3, 0x77, 0x37, 0x25, 0xe5,
+	0xfd, 0x4d, 0x49, 0xf9, 0xe7, 0xa6, 0xa4, 0xfc, 0x7a, 0x5b, 0x4a, 0xbd, 0xbf, 0x2d, 0xa5, 0xfe,
+	0xbc, 0x2d, 0xa5, 0x7e, 0xfc, 0xc2, 0x26, 0x7c, 0x7c, 0x36, 0xa8, 0x0e, 0x5d, 0x5a, 0x0b, 0xa6,
+	0x63, 0xf0, 0x3d, 0x3f, 0xf8, 0x72, 0x69, 0x4e, 0xf2, 0xcb, 0x09, 0xf6, 0x07, 0x19, 0xf9, 0xd7,
+	0xf1, 0xe4, 0xdf, 0x01, 0x00, 0x78, 0x8c, 0x4e, 0xe1, 0xe0, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -166,6 +462,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.AIMD.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.BaseFeeAlgorithm != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeAlgorithm))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GasInput != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasInput))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -218,57 +554,900 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		} else {
 			dAtA[i] = 0
 		}
-		i--
-		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AIMDParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AIMDParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AIMDParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxLearningRate.Size()
+		i -= size
+		if _, err := m.MaxLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinLearningRate.Size()
+		i -= size
+		if _, err := m.MinLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Gamma.Size()
+		i -= size
+		if _, err := m.Gamma.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Beta.Size()
+		i -= size
+		if _, err := m.Beta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Alpha.Size()
+		i -= size
+		if _, err := m.Alpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Window != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeInputs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeInputs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeInputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ParentBaseFee.Size()
+		i -= size
+		if _, err := m.ParentBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.GasTarget != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasTarget))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ParentGasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.ParentGasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ParentGasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.ParentGasWanted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasInput != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasInput))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseFeeAlgorithm != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeAlgorithm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoBaseFee {
+		n += 2
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	}
+	if m.EnableHeight != 0 {
+		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasMultiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasInput != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasInput))
+	}
+	if m.BaseFeeAlgorithm != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeAlgorithm))
+	}
+	l = m.AIMD.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *AIMDParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovFeemarket(uint64(m.Window))
+	}
+	l = m.Alpha.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Beta.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Gamma.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinLearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxLearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BaseFeeInputs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	if m.BaseFeeAlgorithm != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeAlgorithm))
+	}
+	if m.GasInput != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasInput))
+	}
+	if m.ParentGasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.ParentGasWanted))
+	}
+	if m.ParentGasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.ParentGasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
+	if m.GasTarget != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasTarget))
+	}
+	l = m.ParentBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.LearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoBaseFee = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+			}
+			m.EnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInput", wireType)
+			}
+			m.GasInput = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasInput |= GasInput(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+			}
+			m.BaseFeeAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AIMD", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AIMD.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AIMDParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AIMDParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AIMDParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeemarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NoBaseFee {
-		n += 2
-	}
-	if m.BaseFeeChangeDenominator != 0 {
-		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
-	}
-	if m.ElasticityMultiplier != 0 {
-		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
-	}
-	if m.EnableHeight != 0 {
-		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.BaseFee.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasMultiplier.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	return n
-}
-
-func sovFeemarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeemarket(x uint64) (n int) {
-	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BaseFeeInputs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -291,17 +1470,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BaseFeeInputs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BaseFeeInputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -311,17 +1490,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoBaseFee = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
 			}
-			m.BaseFeeChangeDenominator = 0
+			m.BaseFeeAlgorithm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -331,16 +1509,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				m.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInput", wireType)
 			}
-			m.ElasticityMultiplier = 0
+			m.GasInput = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -350,16 +1528,35 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				m.GasInput |= GasInput(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGasWanted", wireType)
+			}
+			m.ParentGasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentGasWanted |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGasUsed", wireType)
 			}
-			m.EnableHeight = 0
+			m.ParentGasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -369,14 +1566,52 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EnableHeight |= int64(b&0x7F) << shift
+				m.ParentGasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
+			}
+			m.GasTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasTarget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentBaseFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -404,13 +1639,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParentBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -438,13 +1673,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -472,7 +1707,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
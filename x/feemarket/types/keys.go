@@ -18,10 +18,16 @@ const (
 	TransientKey = "transient_" + ModuleName
 )
 
+// MaxBaseFeeInputsHistory is the number of blocks for which the base fee
+// inputs are stored.
+const MaxBaseFeeInputsHistory = 100
+
 // prefix bytes for the feemarket persistent store
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockGasUsed
+	prefixBaseFeeInputs
 )
 
 const (
@@ -31,6 +37,8 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasUsed   = []byte{prefixBlockGasUsed}
+	KeyPrefixBaseFeeInputs  = []byte{prefixBaseFeeInputs}
 )
 
 // Transient Store key prefixes
@@ -21,6 +21,22 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultMinBaseFee is 0 (i.e disabled)
+	DefaultMinBaseFee = math.LegacyZeroDec()
+	// DefaultMaxBaseFee is 0 (i.e disabled)
+	DefaultMaxBaseFee = math.LegacyZeroDec()
+	// DefaultAIMDWindow is 8 blocks
+	DefaultAIMDWindow = uint64(8)
+	// DefaultAIMDAlpha is 0.025
+	DefaultAIMDAlpha = math.LegacyNewDecWithPrec(25, 3)
+	// DefaultAIMDBeta is 0.95
+	DefaultAIMDBeta = math.LegacyNewDecWithPrec(95, 2)
+	// DefaultAIMDGamma is 0.25
+	DefaultAIMDGamma = math.LegacyNewDecWithPrec(25, 2)
+	// DefaultAIMDMinLearningRate is 0.01
+	DefaultAIMDMinLearningRate = math.LegacyNewDecWithPrec(1, 2)
+	// DefaultAIMDMaxLearningRate is 0.5
+	DefaultAIMDMaxLearningRate = math.LegacyNewDecWithPrec(5, 1)
 )
 
 // Parameter keys
@@ -84,6 +100,23 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		GasInput:                 GasInputGasWanted,
+		BaseFeeAlgorithm:         BaseFeeAlgorithmEIP1559,
+		AIMD:                     DefaultAIMDParams(),
+		MinBaseFee:               DefaultMinBaseFee,
+		MaxBaseFee:               DefaultMaxBaseFee,
+	}
+}
+
+// DefaultAIMDParams returns the default AIMD base fee algorithm parameters
+func DefaultAIMDParams() AIMDParams {
+	return AIMDParams{
+		Window:          DefaultAIMDWindow,
+		Alpha:           DefaultAIMDAlpha,
+		Beta:            DefaultAIMDBeta,
+		Gamma:           DefaultAIMDGamma,
+		MinLearningRate: DefaultAIMDMinLearningRate,
+		MaxLearningRate: DefaultAIMDMaxLearningRate,
 	}
 }
 
@@ -105,7 +138,67 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := validateGasInput(p.GasInput); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeAlgorithm(p.BaseFeeAlgorithm); err != nil {
+		return err
+	}
+
+	// the AIMD parameters are only required when the algorithm is selected, so
+	// they must be set explicitly when switching to AIMD if they aren't set yet
+	if p.BaseFeeAlgorithm == BaseFeeAlgorithmAIMD {
+		if err := p.AIMD.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return validateBaseFeeBounds(p.MinBaseFee, p.MaxBaseFee)
+}
+
+// Validate performs basic validation on the AIMD base fee algorithm parameters.
+func (p AIMDParams) Validate() error {
+	if p.Window == 0 || p.Window > MaxBaseFeeInputsHistory {
+		return fmt.Errorf("AIMD window must be between 1 and %d: %d", MaxBaseFeeInputsHistory, p.Window)
+	}
+
+	for _, v := range []math.LegacyDec{p.Alpha, p.Beta, p.Gamma, p.MinLearningRate, p.MaxLearningRate} {
+		if err := validateMinGasMultiplier(v); err != nil {
+			return fmt.Errorf("invalid AIMD parameter: %w", err)
+		}
+	}
+
+	if p.Gamma.GT(math.LegacyNewDecWithPrec(5, 1)) {
+		return fmt.Errorf("AIMD gamma cannot be greater than 0.5: %s", p.Gamma)
+	}
+
+	if p.MinLearningRate.GT(p.MaxLearningRate) {
+		return fmt.Errorf(
+			"AIMD min learning rate cannot be greater than max learning rate: %s > %s",
+			p.MinLearningRate, p.MaxLearningRate,
+		)
+	}
+
+	return nil
+}
+
+// BoundBaseFee returns the base fee bounded by the min and max base fee
+// parameters. A nil or zero max base fee disables the upper bound.
+func (p Params) BoundBaseFee(baseFee math.LegacyDec) math.LegacyDec {
+	if !p.MinBaseFee.IsNil() && baseFee.LT(p.MinBaseFee) {
+		baseFee = p.MinBaseFee
+	}
+
+	if !p.MaxBaseFee.IsNil() && p.MaxBaseFee.IsPositive() && baseFee.GT(p.MaxBaseFee) {
+		baseFee = p.MaxBaseFee
+	}
+
+	return baseFee
 }
 
 func validateBool(i interface{}) error {
@@ -185,6 +278,54 @@ func validateEnableHeight(i interface{}) error {
 	return nil
 }
 
+func validateGasInput(i interface{}) error {
+	value, ok := i.(GasInput)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := GasInput_name[int32(value)]; !ok {
+		return fmt.Errorf("invalid gas input: %d", value)
+	}
+
+	return nil
+}
+
+func validateBaseFeeAlgorithm(i interface{}) error {
+	value, ok := i.(BaseFeeAlgorithm)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := BaseFeeAlgorithm_name[int32(value)]; !ok {
+		return fmt.Errorf("invalid base fee algorithm: %d", value)
+	}
+
+	return nil
+}
+
+// validateBaseFeeBounds validates the min and max base fee. Nil values are
+// allowed for the params stored before the bounds were introduced.
+func validateBaseFeeBounds(minBaseFee, maxBaseFee math.LegacyDec) error {
+	if !minBaseFee.IsNil() && minBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", minBaseFee)
+	}
+
+	if maxBaseFee.IsNil() || maxBaseFee.IsZero() {
+		return nil
+	}
+
+	if maxBaseFee.IsNegative() {
+		return fmt.Errorf("max base fee cannot be negative: %s", maxBaseFee)
+	}
+
+	if !minBaseFee.IsNil() && minBaseFee.GT(maxBaseFee) {
+		return fmt.Errorf("min base fee cannot be greater than max base fee: %s > %s", minBaseFee, maxBaseFee)
+	}
+
+	return nil
+}
+
 func validateMinGasMultiplier(i interface{}) error {
 	v, ok := i.(math.LegacyDec)
 
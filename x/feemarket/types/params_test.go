@@ -57,6 +57,120 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, math.LegacyNewDec(2000000000), int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), math.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"valid: gas used input",
+			func() Params {
+				p := DefaultParams()
+				p.GasInput = GasInputGasUsed
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: unknown gas input",
+			func() Params {
+				p := DefaultParams()
+				p.GasInput = GasInput(2)
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: unknown base fee algorithm",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BaseFeeAlgorithm(2)
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid: AIMD algorithm with default AIMD params",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmAIMD
+				return p
+			}(),
+			false,
+		},
+		{
+			"valid: empty AIMD params with EIP-1559 algorithm",
+			func() Params {
+				p := DefaultParams()
+				p.AIMD = AIMDParams{}
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: AIMD algorithm with empty AIMD params",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmAIMD
+				p.AIMD = AIMDParams{}
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: AIMD window bigger than the base fee inputs history",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmAIMD
+				p.AIMD.Window = MaxBaseFeeInputsHistory + 1
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: AIMD gamma bigger than 0.5",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmAIMD
+				p.AIMD.Gamma = math.LegacyNewDecWithPrec(6, 1)
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: AIMD min learning rate bigger than max learning rate",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BaseFeeAlgorithmAIMD
+				p.AIMD.MinLearningRate = math.LegacyNewDecWithPrec(6, 1)
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid: base fee bounds",
+			func() Params {
+				p := DefaultParams()
+				p.MinBaseFee = math.LegacyNewDec(1)
+				p.MaxBaseFee = math.LegacyNewDec(2)
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: negative min base fee",
+			func() Params {
+				p := DefaultParams()
+				p.MinBaseFee = math.LegacyNewDec(-1)
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: min base fee bigger than max base fee",
+			func() Params {
+				p := DefaultParams()
+				p.MinBaseFee = math.LegacyNewDec(3)
+				p.MaxBaseFee = math.LegacyNewDec(2)
+				return p
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -115,3 +229,14 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestBoundBaseFee() {
+	p := DefaultParams()
+	suite.Require().Equal(math.LegacyNewDec(5), p.BoundBaseFee(math.LegacyNewDec(5)))
+
+	p.MinBaseFee = math.LegacyNewDec(2)
+	p.MaxBaseFee = math.LegacyNewDec(4)
+	suite.Require().Equal(math.LegacyNewDec(2), p.BoundBaseFee(math.LegacyNewDec(1)))
+	suite.Require().Equal(math.LegacyNewDec(3), p.BoundBaseFee(math.LegacyNewDec(3)))
+	suite.Require().Equal(math.LegacyNewDec(4), p.BoundBaseFee(math.LegacyNewDec(5)))
+}
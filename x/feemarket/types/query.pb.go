@@ -275,6 +275,101 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBaseFeeInputsRequest defines the request type for querying the inputs
+// used to calculate the base fee of the last blocks.
+type QueryBaseFeeInputsRequest struct {
+	// blocks is the number of most recent blocks to return. A zero value returns
+	// all the stored blocks.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QueryBaseFeeInputsRequest) Reset()         { *m = QueryBaseFeeInputsRequest{} }
+func (m *QueryBaseFeeInputsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeInputsRequest) ProtoMessage()    {}
+func (*QueryBaseFeeInputsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryBaseFeeInputsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeInputsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeInputsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeInputsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeInputsRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeInputsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeInputsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeInputsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeInputsRequest proto.InternalMessageInfo
+
+func (m *QueryBaseFeeInputsRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// QueryBaseFeeInputsResponse returns the inputs used to calculate the base fee
+// of the last blocks.
+type QueryBaseFeeInputsResponse struct {
+	// inputs are the base fee inputs, ordered from the most recent block.
+	Inputs []BaseFeeInputs `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs"`
+}
+
+func (m *QueryBaseFeeInputsResponse) Reset()         { *m = QueryBaseFeeInputsResponse{} }
+func (m *QueryBaseFeeInputsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeInputsResponse) ProtoMessage()    {}
+func (*QueryBaseFeeInputsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryBaseFeeInputsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeInputsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeInputsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeInputsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeInputsResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeInputsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeInputsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeInputsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeInputsResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeInputsResponse) GetInputs() []BaseFeeInputs {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -282,6 +377,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBaseFeeInputsRequest)(nil), "ethermint.feemarket.v1.QueryBaseFeeInputsRequest")
+	proto.RegisterType((*QueryBaseFeeInputsResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeInputsResponse")
 }
 
 func init() {
@@ -289,37 +386,42 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x18, 0x4c, 0xdb, 0xf1, 0xa2, 0x63, 0x5a, 0x64, 0x8d, 0x1b, 0x59, 0x54, 0xb4, 0xd6,
-	0x1d, 0x1b, 0x6f, 0xde, 0x0c, 0x52, 0x2f, 0x1e, 0x74, 0x4f, 0xe2, 0xa5, 0x4c, 0xd6, 0xd7, 0xcd,
-	0x92, 0xce, 0xbe, 0xed, 0xce, 0x24, 0x98, 0xab, 0xe0, 0xc5, 0x83, 0x08, 0xfe, 0x09, 0x2f, 0x82,
-	0x3f, 0xa3, 0xc7, 0x82, 0x17, 0xf1, 0x50, 0x24, 0x11, 0xfc, 0x1b, 0x92, 0x99, 0xd9, 0xe8, 0x6a,
-	0xa2, 0xb9, 0x0c, 0x8f, 0xb7, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x8f, 0xa5, 0x01, 0xe8, 0x3e, 0x14,
-	0x32, 0xcd, 0x34, 0x3f, 0x00, 0x90, 0xa2, 0x18, 0x80, 0xe6, 0xa3, 0x5d, 0x7e, 0x34, 0x84, 0x62,
-	0x1c, 0xe6, 0x05, 0x6a, 0x64, 0x5b, 0x73, 0x4c, 0x38, 0xc7, 0x84, 0xa3, 0x5d, 0xef, 0x82, 0x90,
-	0x69, 0x86, 0xdc, 0xbc, 0x16, 0xea, 0xdd, 0x58, 0x42, 0xf7, 0x6b, 0xce, 0xe2, 0x9a, 0x09, 0x26,
-	0x68, 0x4a, 0x3e, 0xab, 0x5c, 0xb7, 0x95, 0x20, 0x26, 0x87, 0xc0, 0x45, 0x9e, 0x72, 0x91, 0x65,
-	0xa8, 0x85, 0x4e, 0x31, 0x53, 0xf6, 0x6b, 0xd0, 0xa4, 0xec, 0xe9, 0xcc, 0xd5, 0x13, 0x51, 0x08,
-	0xa9, 0x22, 0x38, 0x1a, 0x82, 0xd2, 0xc1, 0x33, 0x7a, 0xb1, 0xd2, 0x55, 0x39, 0x66, 0x0a, 0xd8,
-	0x03, 0xda, 0xc8, 0x4d, 0xe7, 0x12, 0xb9, 0x4a, 0x6e, 0x9e, 0xeb, 0xf8, 0xe1, 0xe2, 0x25, 0x42,
-	0x3b, 0xd7, 0xdd, 0x38, 0x3e, 0x6d, 0xd7, 0x3e, 0xfc, 0xf8, 0xb4, 0x4d, 0x22, 0x37, 0x18, 0x6c,
-	0x3a, 0xe6, 0xae, 0x50, 0xb0, 0x07, 0x50, 0x0a, 0x46, 0xb4, 0x59, 0x6d, 0x3b, 0xc5, 0xfb, 0x74,
-	0xbd, 0x27, 0x14, 0xec, 0x1f, 0x00, 0x18, 0xcd, 0x8d, 0x6e, 0xfb, 0xeb, 0x69, 0xfb, 0x72, 0x8c,
-	0x4a, 0xa2, 0x52, 0x2f, 0x06, 0x61, 0x8a, 0x5c, 0x0a, 0xdd, 0x0f, 0x1f, 0x43, 0x22, 0xe2, 0xf1,
-	0x43, 0x88, 0xa3, 0xb5, 0x9e, 0xe5, 0x08, 0xb6, 0x4a, 0xce, 0x43, 0x8c, 0x07, 0x8f, 0xc4, 0x7c,
-	0xb9, 0x5b, 0x74, 0xf3, 0x8f, 0xbe, 0x13, 0x3b, 0x4f, 0xeb, 0x89, 0xb0, 0xbb, 0xd5, 0xa3, 0x59,
-	0xd9, 0xf9, 0x58, 0xa7, 0x67, 0x0d, 0x96, 0xbd, 0x26, 0xb4, 0x61, 0xb7, 0x62, 0xdb, 0xcb, 0xb6,
-	0xfe, 0x3b, 0x48, 0xef, 0xf6, 0x4a, 0x58, 0xab, 0x1f, 0x04, 0xaf, 0x3e, 0x7f, 0x7f, 0x7f, 0xa6,
-	0xc5, 0x3c, 0x0e, 0x23, 0x89, 0xaa, 0x7a, 0x6c, 0x9b, 0x1f, 0x7b, 0x43, 0xe8, 0x9a, 0x0b, 0x89,
-	0xfd, 0x9b, 0xbc, 0x9a, 0xb0, 0xb7, 0xb3, 0x1a, 0xd8, 0x59, 0xb9, 0x66, 0xac, 0xf8, 0xac, 0xb5,
-	0xc8, 0x4a, 0x79, 0x11, 0xf6, 0x96, 0xd0, 0xf5, 0x32, 0x45, 0xf6, 0x1f, 0x81, 0xea, 0x11, 0xbc,
-	0x3b, 0x2b, 0xa2, 0x9d, 0x9f, 0xeb, 0xc6, 0x4f, 0x9b, 0x5d, 0x59, 0xe8, 0x67, 0x86, 0xde, 0x4f,
-	0x84, 0xea, 0xee, 0x1d, 0x4f, 0x7c, 0x72, 0x32, 0xf1, 0xc9, 0xb7, 0x89, 0x4f, 0xde, 0x4d, 0xfd,
-	0xda, 0xc9, 0xd4, 0xaf, 0x7d, 0x99, 0xfa, 0xb5, 0xe7, 0x3b, 0x49, 0xaa, 0xfb, 0xc3, 0x5e, 0x18,
-	0xa3, 0x74, 0x14, 0xf6, 0x1d, 0x75, 0xee, 0xf2, 0x97, 0xbf, 0xd1, 0xe9, 0x71, 0x0e, 0xaa, 0xd7,
-	0x30, 0x3f, 0xc7, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x44, 0x02, 0x20, 0xd0, 0xc9, 0x03,
-	0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0x12, 0x41,
+	0x14, 0x67, 0xa4, 0xd2, 0x76, 0x1a, 0x13, 0x1d, 0x29, 0xa9, 0x2b, 0x2e, 0xcd, 0x2a, 0xa6, 0xb6,
+	0x75, 0x47, 0xe8, 0xcd, 0x9b, 0xc4, 0x54, 0x4d, 0x3c, 0xe8, 0x9e, 0x8c, 0x17, 0x32, 0xe0, 0x63,
+	0xd9, 0xd0, 0xdd, 0xd9, 0x32, 0x03, 0x91, 0xab, 0x89, 0x17, 0x0f, 0xc6, 0xc4, 0x8f, 0xe0, 0xc5,
+	0xa3, 0x1f, 0xa3, 0xc7, 0x26, 0xbd, 0x18, 0x0f, 0x8d, 0x01, 0x13, 0xbf, 0x86, 0xd9, 0x99, 0x59,
+	0xec, 0x2a, 0x54, 0xbc, 0x4c, 0x86, 0xc7, 0xef, 0xdf, 0xbc, 0xf7, 0xb2, 0xd8, 0x01, 0xd9, 0x85,
+	0x7e, 0x18, 0x44, 0x92, 0x76, 0x00, 0x42, 0xd6, 0xef, 0x81, 0xa4, 0xc3, 0x1a, 0x3d, 0x1c, 0x40,
+	0x7f, 0xe4, 0xc6, 0x7d, 0x2e, 0x39, 0x29, 0x4d, 0x31, 0xee, 0x14, 0xe3, 0x0e, 0x6b, 0xd6, 0x15,
+	0x16, 0x06, 0x11, 0xa7, 0xea, 0xd4, 0x50, 0xeb, 0xf6, 0x1c, 0xb9, 0xdf, 0x3c, 0x8d, 0x2b, 0xfa,
+	0xdc, 0xe7, 0xea, 0x4a, 0x93, 0x9b, 0xa9, 0x96, 0x7d, 0xce, 0xfd, 0x03, 0xa0, 0x2c, 0x0e, 0x28,
+	0x8b, 0x22, 0x2e, 0x99, 0x0c, 0x78, 0x24, 0xf4, 0xbf, 0x4e, 0x11, 0x93, 0xe7, 0x49, 0xaa, 0x67,
+	0xac, 0xcf, 0x42, 0xe1, 0xc1, 0xe1, 0x00, 0x84, 0x74, 0x5e, 0xe0, 0xab, 0x99, 0xaa, 0x88, 0x79,
+	0x24, 0x80, 0x3c, 0xc0, 0x85, 0x58, 0x55, 0x36, 0xd0, 0x26, 0xda, 0x5a, 0xab, 0xdb, 0xee, 0xec,
+	0x47, 0xb8, 0x9a, 0xd7, 0x58, 0x3d, 0x3a, 0xad, 0xe4, 0x3e, 0xff, 0xfc, 0xb2, 0x8d, 0x3c, 0x43,
+	0x74, 0xd6, 0x8d, 0x72, 0x83, 0x09, 0xd8, 0x07, 0x48, 0x0d, 0x3d, 0x5c, 0xcc, 0x96, 0x8d, 0xe3,
+	0x7d, 0xbc, 0xd2, 0x62, 0x02, 0x9a, 0x1d, 0x00, 0xe5, 0xb9, 0xda, 0xa8, 0x7c, 0x3b, 0xad, 0x5c,
+	0x6f, 0x73, 0x11, 0x72, 0x21, 0x5e, 0xf5, 0xdc, 0x80, 0xd3, 0x90, 0xc9, 0xae, 0xfb, 0x14, 0x7c,
+	0xd6, 0x1e, 0x3d, 0x84, 0xb6, 0xb7, 0xdc, 0xd2, 0x1a, 0x4e, 0x29, 0xd5, 0x3c, 0xe0, 0xed, 0xde,
+	0x23, 0x36, 0x7d, 0xdc, 0x1d, 0xbc, 0xfe, 0x47, 0xdd, 0x98, 0x5d, 0xc6, 0x79, 0x9f, 0xe9, 0xb7,
+	0xe5, 0xbd, 0xe4, 0xea, 0xec, 0xe1, 0x6b, 0x67, 0x63, 0x3d, 0x89, 0xe2, 0x81, 0x4c, 0x75, 0x48,
+	0x09, 0x17, 0x5a, 0x89, 0x84, 0x66, 0x2c, 0x79, 0xe6, 0x97, 0xd3, 0xc1, 0xd6, 0x2c, 0x92, 0x31,
+	0x79, 0x8c, 0x0b, 0x81, 0xaa, 0x6c, 0xa0, 0xcd, 0xfc, 0xd6, 0x5a, 0xbd, 0x3a, 0xaf, 0x87, 0x19,
+	0x7a, 0xa6, 0x95, 0x9a, 0x5f, 0x3f, 0x59, 0xc2, 0x17, 0x95, 0x11, 0x79, 0x8b, 0x70, 0x41, 0xb7,
+	0x9c, 0x6c, 0xcf, 0x93, 0xfb, 0x7b, 0xca, 0xd6, 0xce, 0x42, 0x58, 0x9d, 0xdb, 0x71, 0xde, 0x9c,
+	0xfc, 0xf8, 0x78, 0xa1, 0x4c, 0x2c, 0x0a, 0xc3, 0x90, 0x8b, 0xec, 0x26, 0xea, 0xe1, 0x92, 0x77,
+	0x08, 0x2f, 0x9b, 0xd8, 0xe4, 0x7c, 0xf1, 0xec, 0xf8, 0xad, 0xdd, 0xc5, 0xc0, 0x26, 0xca, 0x2d,
+	0x15, 0xc5, 0x26, 0xe5, 0x59, 0x51, 0xd2, 0x75, 0x21, 0xef, 0x11, 0x5e, 0x49, 0x47, 0x4c, 0xfe,
+	0x61, 0x90, 0xdd, 0x10, 0xeb, 0xee, 0x82, 0x68, 0x93, 0xa7, 0xaa, 0xf2, 0x54, 0xc8, 0x8d, 0x99,
+	0x79, 0x12, 0x74, 0xd3, 0x67, 0x82, 0x7c, 0x42, 0xf8, 0x52, 0x66, 0xa8, 0xa4, 0xb6, 0xc8, 0xb3,
+	0x33, 0x4b, 0x67, 0xd5, 0xff, 0x87, 0x62, 0xf2, 0xed, 0xa8, 0x7c, 0x55, 0x72, 0xf3, 0xbc, 0x7e,
+	0x35, 0xf5, 0x56, 0x35, 0xf6, 0x8f, 0xc6, 0x36, 0x3a, 0x1e, 0xdb, 0xe8, 0xfb, 0xd8, 0x46, 0x1f,
+	0x26, 0x76, 0xee, 0x78, 0x62, 0xe7, 0xbe, 0x4e, 0xec, 0xdc, 0xcb, 0x5d, 0x3f, 0x90, 0xdd, 0x41,
+	0xcb, 0x6d, 0xf3, 0xd0, 0x08, 0xe9, 0x73, 0x58, 0xbf, 0x47, 0x5f, 0x9f, 0x11, 0x95, 0xa3, 0x18,
+	0x44, 0xab, 0xa0, 0xbe, 0x2f, 0x7b, 0xbf, 0x06, 0x00, 0x5e, 0x74, 0x9b, 0xfc, 0x0c, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +442,9 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BaseFeeInputs queries the inputs used to calculate the base fee of the
+	// last blocks.
+	BaseFeeInputs(ctx context.Context, in *QueryBaseFeeInputsRequest, opts ...grpc.CallOption) (*QueryBaseFeeInputsResponse, error)
 }
 
 type queryClient struct {
@@ -377,6 +482,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BaseFeeInputs(ctx context.Context, in *QueryBaseFeeInputsRequest, opts ...grpc.CallOption) (*QueryBaseFeeInputsResponse, error) {
+	out := new(QueryBaseFeeInputsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BaseFeeInputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -385,6 +499,9 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BaseFeeInputs queries the inputs used to calculate the base fee of the
+	// last blocks.
+	BaseFeeInputs(context.Context, *QueryBaseFeeInputsRequest) (*QueryBaseFeeInputsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -400,6 +517,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BaseFeeInputs(ctx context.Context, req *QueryBaseFeeInputsRequest) (*QueryBaseFeeInputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeInputs not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -459,6 +579,25 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BaseFeeInputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeInputs(ctx, req.(*QueryBaseFeeInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -475,6 +614,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BaseFeeInputs",
+			Handler:    _Query_BaseFeeInputs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -645,6 +788,71 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBaseFeeInputsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeInputsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeInputsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeInputsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeInputsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeInputsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -719,6 +927,33 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBaseFeeInputsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeInputsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1348,159 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseFeeInputsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeInputsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeInputsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeInputsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeInputsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeInputsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, BaseFeeInputs{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
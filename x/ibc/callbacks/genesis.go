@@ -0,0 +1,31 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package callbacks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/ibc/callbacks/keeper"
+	"github.com/evmos/evmos/v20/x/ibc/callbacks/types"
+)
+
+// InitGenesis initializes the IBC callbacks module's state from a provided
+// genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, packetSender := range genState.PacketSenders {
+		k.SetPacketSender(
+			ctx,
+			packetSender.PortId,
+			packetSender.ChannelId,
+			packetSender.Sequence,
+			common.HexToAddress(packetSender.Contract),
+		)
+	}
+}
+
+// ExportGenesis returns the IBC callbacks module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllPacketSenders(ctx))
+}
@@ -0,0 +1,33 @@
+package callbacks_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/ibc/callbacks"
+	"github.com/evmos/evmos/v20/x/ibc/callbacks/types"
+)
+
+func TestInitExportGenesis(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.IBCCallbacksKeeper
+
+	require.Empty(t, callbacks.ExportGenesis(ctx, k).PacketSenders)
+
+	contract := common.BytesToAddress([]byte("contract"))
+	genState := types.NewGenesisState([]types.PacketSender{
+		{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Contract: contract.Hex()},
+		{PortId: "transfer", ChannelId: "channel-1", Sequence: 5, Contract: contract.Hex()},
+	})
+	callbacks.InitGenesis(ctx, k, *genState)
+
+	sender, found := k.GetPacketSender(ctx, "transfer", "channel-1", 5)
+	require.True(t, found)
+	require.Equal(t, contract, sender)
+
+	require.Equal(t, genState, callbacks.ExportGenesis(ctx, k))
+}
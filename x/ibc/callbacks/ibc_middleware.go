@@ -0,0 +1,78 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package callbacks
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	"github.com/evmos/evmos/v20/ibc"
+	"github.com/evmos/evmos/v20/x/ibc/callbacks/keeper"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the callbacks middleware
+// given the callbacks keeper and the underlying application. It calls back the
+// contracts that sent ICS-20 transfers once the packet lifecycle completes,
+// following the ADR-8 source callbacks model.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper keeper.Keeper
+	// maxCallbackGas defines the maximum amount of gas that a callback can consume
+	maxCallbackGas uint64
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper, the underlying
+// application and the maximum amount of gas of the callbacks.
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule, maxCallbackGas uint64) IBCMiddleware {
+	return IBCMiddleware{
+		Module:         ibc.NewModule(app),
+		keeper:         k,
+		maxCallbackGas: maxCallbackGas,
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// It processes the acknowledgement through the underlying application, which
+// refunds the tokens on a failed transfer, and then calls the onIBCAck callback
+// on the sender contract.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	if err := im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnAcknowledgementPacket(ctx, packet, ack.Success(), im.maxCallbackGas)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// It processes the timeout through the underlying application, which refunds
+// the tokens, and then calls the onIBCTimeout callback on the sender contract.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutPacket(ctx, packet, im.maxCallbackGas)
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/evmos/evmos/v20/x/ibc/callbacks/types"
+)
+
+// OnAcknowledgementPacket calls the onIBCAck callback on the contract that sent
+// the given packet, if any.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, success bool, gasLimit uint64) {
+	k.executeCallback(
+		ctx, packet, types.AttributeValueCallbackTypeAcknowledgement, gasLimit,
+		types.OnAcknowledgementCallback, packet.SourceChannel, packet.Sequence, success,
+	)
+}
+
+// OnTimeoutPacket calls the onIBCTimeout callback on the contract that sent
+// the given packet, if any.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, gasLimit uint64) {
+	k.executeCallback(
+		ctx, packet, types.AttributeValueCallbackTypeTimeout, gasLimit,
+		types.OnTimeoutCallback, packet.SourceChannel, packet.Sequence,
+	)
+}
+
+// executeCallback calls the given method on the contract that sent the packet
+// with at most the given amount of gas. The state changes of the callback are
+// only committed if the call succeeds and a failed callback never fails the
+// packet lifecycle, so that a misbehaving contract cannot block the refunds.
+func (k Keeper) executeCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	callbackType string,
+	gasLimit uint64,
+	method string,
+	args ...interface{},
+) {
+	contract, found := k.GetPacketSender(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	// the packet lifecycle is complete, so the sender is no longer needed
+	k.DeletePacketSender(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyCallbackType, callbackType),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, contract.String()),
+		sdk.NewAttribute(types.AttributeKeyCallbackGasLimit, strconv.FormatUint(gasLimit, 10)),
+		sdk.NewAttribute(types.AttributeKeySourcePortID, packet.SourcePort),
+		sdk.NewAttribute(types.AttributeKeySourceChannelID, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+	}
+
+	if err := k.callContract(ctx, contract, gasLimit, method, args...); err != nil {
+		attrs = append(
+			attrs,
+			sdk.NewAttribute(types.AttributeKeyCallbackResult, types.AttributeValueCallbackFailure),
+			sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()),
+		)
+		k.Logger(ctx).Error(
+			"IBC callback failed",
+			"contract", contract.String(),
+			"callback", method,
+			"error", err.Error(),
+		)
+	} else {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCallbackResult, types.AttributeValueCallbackSuccess))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSourceCallback, attrs...))
+}
+
+// callContract packs the callback data and calls the contract from the ICS-20
+// precompile address with at most the given amount of gas, which is charged to
+// the relayer.
+func (k Keeper) callContract(ctx sdk.Context, contract common.Address, gasLimit uint64, method string, args ...interface{}) error {
+	data, err := k.callbacksABI.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.evmKeeper.CallEVMWithGasLimit(
+		ctx,
+		common.HexToAddress(evmtypes.ICS20PrecompileAddress),
+		contract,
+		data,
+		gasLimit,
+	)
+	return err
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/ibc/callbacks/types"
+)
+
+// callbackEvents returns the source callback events emitted in the given context.
+func callbackEvents(ctx sdk.Context) []sdk.Event {
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSourceCallback {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (suite *KeeperTestSuite) TestExecuteCallbacks() {
+	packet := channeltypes.Packet{Sequence: sequence, SourcePort: portID, SourceChannel: channelID}
+
+	testCases := []struct {
+		name          string
+		malleate      func(ctx sdk.Context)
+		callback      func(ctx sdk.Context)
+		expEvent      bool
+		expResult     string
+		expStored     bool
+		expCallbackTy string
+	}{
+		{
+			"no callback - packet not sent by a contract",
+			func(sdk.Context) {},
+			func(ctx sdk.Context) {
+				suite.keeper.OnAcknowledgementPacket(ctx, packet, true, types.DefaultMaxCallbackGas)
+			},
+			false,
+			"",
+			false,
+			"",
+		},
+		{
+			"acknowledgement - callback succeeds",
+			func(ctx sdk.Context) {
+				suite.setCode(ctx, suite.contract, storeCode)
+				suite.keeper.SetPacketSender(ctx, portID, channelID, sequence, suite.contract)
+			},
+			func(ctx sdk.Context) {
+				suite.keeper.OnAcknowledgementPacket(ctx, packet, true, types.DefaultMaxCallbackGas)
+			},
+			true,
+			types.AttributeValueCallbackSuccess,
+			true,
+			types.AttributeValueCallbackTypeAcknowledgement,
+		},
+		{
+			"acknowledgement - callback reverts",
+			func(ctx sdk.Context) {
+				suite.setCode(ctx, suite.contract, revertCode)
+				suite.keeper.SetPacketSender(ctx, portID, channelID, sequence, suite.contract)
+			},
+			func(ctx sdk.Context) {
+				suite.keeper.OnAcknowledgementPacket(ctx, packet, false, types.DefaultMaxCallbackGas)
+			},
+			true,
+			types.AttributeValueCallbackFailure,
+			false,
+			types.AttributeValueCallbackTypeAcknowledgement,
+		},
+		{
+			"acknowledgement - callback runs out of gas",
+			func(ctx sdk.Context) {
+				suite.setCode(ctx, suite.contract, storeCode)
+				suite.keeper.SetPacketSender(ctx, portID, channelID, sequence, suite.contract)
+			},
+			func(ctx sdk.Context) {
+				suite.keeper.OnAcknowledgementPacket(ctx, packet, true, 25_000)
+			},
+			true,
+			types.AttributeValueCallbackFailure,
+			false,
+			types.AttributeValueCallbackTypeAcknowledgement,
+		},
+		{
+			"timeout - callback succeeds",
+			func(ctx sdk.Context) {
+				suite.setCode(ctx, suite.contract, storeCode)
+				suite.keeper.SetPacketSender(ctx, portID, channelID, sequence, suite.contract)
+			},
+			func(ctx sdk.Context) {
+				suite.keeper.OnTimeoutPacket(ctx, packet, types.DefaultMaxCallbackGas)
+			},
+			true,
+			types.AttributeValueCallbackSuccess,
+			true,
+			types.AttributeValueCallbackTypeTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext().WithEventManager(sdk.NewEventManager())
+			tc.malleate(ctx)
+
+			tc.callback(ctx)
+
+			events := callbackEvents(ctx)
+			if !tc.expEvent {
+				suite.Require().Empty(events)
+				return
+			}
+
+			suite.Require().Len(events, 1)
+			result, found := events[0].GetAttribute(types.AttributeKeyCallbackResult)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expResult, result.Value)
+			callbackType, found := events[0].GetAttribute(types.AttributeKeyCallbackType)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expCallbackTy, callbackType.Value)
+
+			stored := suite.network.App.EvmKeeper.GetState(ctx, suite.contract, common.Hash{})
+			suite.Require().Equal(tc.expStored, stored == common.BigToHash(common.Big1))
+
+			// the packet sender is removed once the callback is executed
+			_, found = suite.keeper.GetPacketSender(ctx, portID, channelID, sequence)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCallbackGasCharge() {
+	packet := channeltypes.Packet{Sequence: sequence, SourcePort: portID, SourceChannel: channelID}
+
+	testCases := []struct {
+		name     string
+		code     []byte
+		gasLimit uint64
+		expExact bool
+	}{
+		{
+			"callback succeeds - charged the gas used",
+			storeCode,
+			types.DefaultMaxCallbackGas,
+			false,
+		},
+		{
+			"callback runs out of gas - charged the gas limit once",
+			storeCode,
+			25_000,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			// the store gas is not charged so that only the callback gas is measured
+			ctx := suite.network.GetContext().
+				WithEventManager(sdk.NewEventManager()).
+				WithGasMeter(storetypes.NewGasMeter(10_000_000)).
+				WithKVGasConfig(storetypes.GasConfig{}).
+				WithTransientKVGasConfig(storetypes.GasConfig{})
+			suite.setCode(ctx, suite.contract, tc.code)
+			suite.keeper.SetPacketSender(ctx, portID, channelID, sequence, suite.contract)
+
+			gasBefore := ctx.GasMeter().GasConsumed()
+			suite.keeper.OnAcknowledgementPacket(ctx, packet, true, tc.gasLimit)
+			gasCharged := ctx.GasMeter().GasConsumed() - gasBefore
+
+			if tc.expExact {
+				suite.Require().Equal(tc.gasLimit, gasCharged)
+			} else {
+				suite.Require().NotZero(gasCharged)
+				suite.Require().Less(gasCharged, tc.gasLimit)
+			}
+		})
+	}
+}
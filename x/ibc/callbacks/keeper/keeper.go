@@ -0,0 +1,107 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/precompiles/ics20"
+	"github.com/evmos/evmos/v20/x/ibc/callbacks/types"
+)
+
+var _ ics20.CallbacksKeeper = Keeper{}
+
+// Keeper defines the IBC callbacks keeper. It stores the contracts that send
+// packets through the ICS-20 precompile and executes their callbacks on the
+// packet acknowledgement or timeout.
+type Keeper struct {
+	storeKey     storetypes.StoreKey
+	evmKeeper    types.EVMKeeper
+	callbacksABI abi.ABI
+}
+
+// NewKeeper creates a new IBC callbacks Keeper instance
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	evmKeeper types.EVMKeeper,
+) Keeper {
+	callbacksABI, err := ics20.LoadCallbacksABI()
+	if err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:     storeKey,
+		evmKeeper:    evmKeeper,
+		callbacksABI: callbacksABI,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetPacketSender returns the contract that sent the packet with the given
+// source port, source channel and sequence.
+func (k Keeper) GetPacketSender(ctx sdk.Context, portID, channelID string, sequence uint64) (common.Address, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PacketSenderKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// SetPacketSender stores the contract that sent the packet with the given
+// source port, source channel and sequence.
+func (k Keeper) SetPacketSender(ctx sdk.Context, portID, channelID string, sequence uint64, contract common.Address) {
+	ctx.KVStore(k.storeKey).Set(types.PacketSenderKey(portID, channelID, sequence), contract.Bytes())
+}
+
+// DeletePacketSender removes the contract that sent the packet with the given
+// source port, source channel and sequence.
+func (k Keeper) DeletePacketSender(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.PacketSenderKey(portID, channelID, sequence))
+}
+
+// IteratePacketSenders iterates over all the stored packet senders and calls
+// the given callback on each of them until it returns true.
+func (k Keeper) IteratePacketSenders(ctx sdk.Context, cb func(packetSender types.PacketSender) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketSender)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, sequence, err := types.ParsePacketSenderKey(iterator.Key())
+		if err != nil {
+			// should never happen since the keys are only written by SetPacketSender
+			panic(err)
+		}
+
+		packetSender := types.PacketSender{
+			PortId:    portID,
+			ChannelId: channelID,
+			Sequence:  sequence,
+			Contract:  common.BytesToAddress(iterator.Value()).Hex(),
+		}
+		if cb(packetSender) {
+			break
+		}
+	}
+}
+
+// GetAllPacketSenders returns all the stored packet senders.
+func (k Keeper) GetAllPacketSenders(ctx sdk.Context) []types.PacketSender {
+	packetSenders := []types.PacketSender{}
+	k.IteratePacketSenders(ctx, func(packetSender types.PacketSender) bool {
+		packetSenders = append(packetSenders, packetSender)
+		return false
+	})
+	return packetSenders
+}
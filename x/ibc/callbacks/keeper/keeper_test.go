@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	"github.com/evmos/evmos/v20/x/ibc/callbacks/keeper"
+	"github.com/evmos/evmos/v20/x/ibc/callbacks/types"
+)
+
+const (
+	portID    = "transfer"
+	channelID = "channel-0"
+	sequence  = uint64(1)
+)
+
+var (
+	// storeCode is the runtime code of a contract that stores 1 in the slot 0 on any call.
+	storeCode = common.FromHex("0x600160005500")
+	// revertCode is the runtime code of a contract that reverts on any call.
+	revertCode = common.FromHex("0x60006000fd")
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring keyring.Keyring
+	keeper  keeper.Keeper
+
+	contract common.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	keys := keyring.New(2)
+	suite.network = network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keys.GetAllAccAddrs()...),
+	)
+	suite.keyring = keys
+	suite.contract = common.BytesToAddress([]byte("callbacks contract"))
+	suite.keeper = keeper.NewKeeper(
+		suite.network.App.GetKey(types.StoreKey),
+		suite.network.App.EvmKeeper,
+	)
+}
+
+// setCode sets the given runtime code to the account of the given address.
+func (suite *KeeperTestSuite) setCode(ctx sdk.Context, addr common.Address, code []byte) {
+	codeHash := crypto.Keccak256Hash(code)
+	suite.network.App.EvmKeeper.SetCode(ctx, codeHash.Bytes(), code)
+	account := statedb.NewEmptyAccount()
+	account.CodeHash = codeHash.Bytes()
+	suite.Require().NoError(suite.network.App.EvmKeeper.SetAccount(ctx, addr, *account))
+}
+
+func (suite *KeeperTestSuite) TestPacketSender() {
+	ctx := suite.network.GetContext()
+
+	_, found := suite.keeper.GetPacketSender(ctx, portID, channelID, sequence)
+	suite.Require().False(found)
+
+	suite.keeper.SetPacketSender(ctx, portID, channelID, sequence, suite.contract)
+	contract, found := suite.keeper.GetPacketSender(ctx, portID, channelID, sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.contract, contract)
+
+	// other sequences are not affected
+	_, found = suite.keeper.GetPacketSender(ctx, portID, channelID, sequence+1)
+	suite.Require().False(found)
+
+	suite.keeper.DeletePacketSender(ctx, portID, channelID, sequence)
+	_, found = suite.keeper.GetPacketSender(ctx, portID, channelID, sequence)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetAllPacketSenders() {
+	ctx := suite.network.GetContext()
+	suite.Require().Empty(suite.keeper.GetAllPacketSenders(ctx))
+
+	other := common.BytesToAddress([]byte("other contract"))
+	suite.keeper.SetPacketSender(ctx, portID, channelID, sequence, suite.contract)
+	suite.keeper.SetPacketSender(ctx, portID, "channel-1", sequence+1, other)
+
+	expPacketSenders := []types.PacketSender{
+		{PortId: portID, ChannelId: channelID, Sequence: sequence, Contract: suite.contract.Hex()},
+		{PortId: portID, ChannelId: "channel-1", Sequence: sequence + 1, Contract: other.Hex()},
+	}
+	suite.Require().Equal(expPacketSenders, suite.keeper.GetAllPacketSenders(ctx))
+}
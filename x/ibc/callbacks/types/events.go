@@ -0,0 +1,23 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// IBC callbacks events, following the ADR-8 source callback events
+const (
+	EventTypeSourceCallback = "ibc_src_callback"
+
+	AttributeKeyCallbackType     = "callback_type"
+	AttributeKeyContractAddress  = "callback_address"
+	AttributeKeyCallbackGasLimit = "callback_exec_gas_limit"
+	AttributeKeySourcePortID     = "packet_src_port"
+	AttributeKeySourceChannelID  = "packet_src_channel"
+	AttributeKeySequence         = "packet_sequence"
+	AttributeKeyCallbackResult   = "callback_result"
+	AttributeKeyCallbackError    = "callback_error"
+
+	AttributeValueCallbackTypeAcknowledgement = "acknowledgement_packet"
+	AttributeValueCallbackTypeTimeout         = "timeout_packet"
+	AttributeValueCallbackSuccess             = "success"
+	AttributeValueCallbackFailure             = "failure"
+)
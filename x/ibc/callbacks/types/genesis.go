@@ -0,0 +1,61 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/evmos/evmos/v20/types"
+)
+
+// NewGenesisState creates a new genesis state instance
+func NewGenesisState(packetSenders []PacketSender) *GenesisState {
+	return &GenesisState{
+		PacketSenders: packetSenders,
+	}
+}
+
+// DefaultGenesisState returns the default IBC callbacks genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]PacketSender{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenPackets := make(map[string]bool)
+
+	for _, packetSender := range gs.PacketSenders {
+		if err := packetSender.Validate(); err != nil {
+			return err
+		}
+
+		key := string(PacketSenderKey(packetSender.PortId, packetSender.ChannelId, packetSender.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf(
+				"duplicated packet sender for port %s, channel %s and sequence %d",
+				packetSender.PortId, packetSender.ChannelId, packetSender.Sequence,
+			)
+		}
+		seenPackets[key] = true
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the packet sender fields.
+func (ps PacketSender) Validate() error {
+	if err := host.PortIdentifierValidator(ps.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(ps.ChannelId); err != nil {
+		return err
+	}
+	if ps.Sequence == 0 {
+		return fmt.Errorf("packet sequence cannot be 0")
+	}
+	return types.ValidateNonZeroAddress(ps.Contract)
+}
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/ibc/callbacks/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	contract := common.BytesToAddress([]byte("contract")).Hex()
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"pass - default genesis",
+			types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"pass - packet senders",
+			types.NewGenesisState([]types.PacketSender{
+				{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Contract: contract},
+				{PortId: "transfer", ChannelId: "channel-0", Sequence: 2, Contract: contract},
+			}),
+			true,
+		},
+		{
+			"fail - duplicated packet sender",
+			types.NewGenesisState([]types.PacketSender{
+				{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Contract: contract},
+				{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Contract: contract},
+			}),
+			false,
+		},
+		{
+			"fail - invalid port",
+			types.NewGenesisState([]types.PacketSender{
+				{PortId: "transfer/port", ChannelId: "channel-0", Sequence: 1, Contract: contract},
+			}),
+			false,
+		},
+		{
+			"fail - invalid channel",
+			types.NewGenesisState([]types.PacketSender{
+				{PortId: "transfer", ChannelId: "", Sequence: 1, Contract: contract},
+			}),
+			false,
+		},
+		{
+			"fail - zero sequence",
+			types.NewGenesisState([]types.PacketSender{
+				{PortId: "transfer", ChannelId: "channel-0", Sequence: 0, Contract: contract},
+			}),
+			false,
+		},
+		{
+			"fail - invalid contract",
+			types.NewGenesisState([]types.PacketSender{
+				{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Contract: "contract"},
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestParsePacketSenderKey(t *testing.T) {
+	key := types.PacketSenderKey("transfer", "channel-7", 42)
+
+	portID, channelID, sequence, err := types.ParsePacketSenderKey(key[len(types.KeyPrefixPacketSender):])
+	require.NoError(t, err)
+	require.Equal(t, "transfer", portID)
+	require.Equal(t, "channel-7", channelID)
+	require.Equal(t, uint64(42), sequence)
+
+	_, _, _, err = types.ParsePacketSenderKey([]byte("short"))
+	require.Error(t, err)
+}
@@ -0,0 +1,17 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// EVMKeeper defines the expected EVM keeper interface used to execute the
+// callbacks of the contracts that send packets.
+type EVMKeeper interface {
+	CallEVMWithGasLimit(ctx sdk.Context, from, contract common.Address, data []byte, gasLimit uint64) (*evmtypes.MsgEthereumTxResponse, error)
+}
@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "callbacks"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// DefaultMaxCallbackGas defines the default maximum amount of gas that a
+	// contract callback can consume
+	DefaultMaxCallbackGas uint64 = 1_000_000
+)
+
+// prefix bytes for the IBC callbacks persistent store
+const (
+	prefixPacketSender = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixPacketSender = []byte{prefixPacketSender}
+)
+
+// PacketSenderKey returns the store key of the contract that sent the packet
+// with the given source port, source channel and sequence.
+func PacketSenderKey(portID, channelID string, sequence uint64) []byte {
+	key := append([]byte{}, KeyPrefixPacketSender...)
+	key = append(key, []byte(fmt.Sprintf("%s/%s/", portID, channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// ParsePacketSenderKey returns the source port, source channel and sequence of
+// the given packet sender store key, without the store prefix.
+func ParsePacketSenderKey(key []byte) (portID, channelID string, sequence uint64, err error) {
+	if len(key) <= 8 {
+		return "", "", 0, fmt.Errorf("invalid packet sender key length %d", len(key))
+	}
+
+	path := strings.TrimSuffix(string(key[:len(key)-8]), "/")
+	identifiers := strings.Split(path, "/")
+	if len(identifiers) != 2 {
+		return "", "", 0, fmt.Errorf("invalid packet sender key path %s", path)
+	}
+
+	return identifiers[0], identifiers[1], sdk.BigEndianToUint64(key[len(key)-8:]), nil
+}
+
+// callback methods of the IICS20Callbacks interface
+const (
+	// OnAcknowledgementCallback defines the ABI method name of the callback
+	// called on the sender contract when a packet is acknowledged.
+	OnAcknowledgementCallback = "onIBCAck"
+	// OnTimeoutCallback defines the ABI method name of the callback called on
+	// the sender contract when a packet times out.
+	OnTimeoutCallback = "onIBCTimeout"
+)